@@ -26,6 +26,7 @@ import (
 	"net/http/pprof"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -138,8 +139,9 @@ var (
 	}
 	cfg = operator.Config{}
 
-	rawTLSCipherSuites string
-	serverTLS          bool
+	rawTLSCipherSuites     string
+	serverTLS              bool
+	rawDefaultSendResolved string
 
 	flagset = flag.CommandLine
 )
@@ -159,6 +161,7 @@ func init() {
 		" Values are from tls package constants (https://golang.org/pkg/crypto/tls/#pkg-constants)."+
 		"If omitted, the default Go cipher suites will be used."+
 		"Note that TLS 1.3 ciphersuites are not configurable.")
+	flagset.BoolVar(&cfg.EnableAlertmanagerConfigRendering, "web.enable-alertmanager-config-rendering", false, "Expose the configuration generated for Alertmanager resources at /apis/monitoring.coreos.com/v1/namespaces/<namespace>/alertmanagers/<name>/config. A POST request renders the configuration with the AlertmanagerConfig object of the request body merged. The credentials are redacted.")
 	flagset.StringVar(&cfg.Host, "apiserver", "", "API Server addr, e.g. ' - NOT RECOMMENDED FOR PRODUCTION - http://127.0.0.1:8080'. Omit parameter to run in on-cluster mode and utilize the service account token.")
 	flagset.StringVar(&cfg.TLSConfig.CertFile, "cert-file", "", " - NOT RECOMMENDED FOR PRODUCTION - Path to public TLS certificate file.")
 	flagset.StringVar(&cfg.TLSConfig.KeyFile, "key-file", "", "- NOT RECOMMENDED FOR PRODUCTION - Path to private TLS certificate file.")
@@ -172,6 +175,8 @@ func init() {
 	flagset.StringVar(&cfg.ReloaderConfig.Image, "prometheus-config-reloader", operator.DefaultPrometheusConfigReloaderImage, "Prometheus config reloader image")
 	flagset.StringVar(&cfg.ReloaderConfig.CPU, "config-reloader-cpu", "100m", "Config Reloader CPU request & limit. Value \"0\" disables it and causes no request/limit to be configured.")
 	flagset.StringVar(&cfg.ReloaderConfig.Memory, "config-reloader-memory", "50Mi", "Config Reloader Memory request & limit. Value \"0\" disables it and causes no request/limit to be configured.")
+	flagset.DurationVar(&cfg.ReloaderConfig.DelayInterval, "config-reloader-delay-interval", 0, "How long the config reloader waits without detecting new changes before reloading. Successive changes within the interval are coalesced into a single reload. If omitted, the config reloader's default applies.")
+	flagset.IntVar(&cfg.ReloaderConfig.MaxConcurrentReloads, "config-reloader-max-concurrent-reloads", 0, "Maximum number of replicas of the same StatefulSet reloading their configuration at the same time. Value \"0\" means no limit.")
 	flagset.StringVar(&cfg.AlertmanagerDefaultBaseImage, "alertmanager-default-base-image", operator.DefaultAlertmanagerBaseImage, "Alertmanager default base image (path without tag/version)")
 	flagset.StringVar(&cfg.PrometheusDefaultBaseImage, "prometheus-default-base-image", operator.DefaultPrometheusBaseImage, "Prometheus default base image (path without tag/version)")
 	flagset.StringVar(&cfg.ThanosDefaultBaseImage, "thanos-default-base-image", operator.DefaultThanosBaseImage, "Thanos default base image (path without tag/version)")
@@ -189,6 +194,8 @@ func init() {
 	flagset.StringVar(&cfg.AlertManagerSelector, "alertmanager-instance-selector", "", "Label selector to filter AlertManager Custom Resources to watch.")
 	flagset.StringVar(&cfg.ThanosRulerSelector, "thanos-ruler-instance-selector", "", "Label selector to filter ThanosRuler Custom Resources to watch.")
 	flagset.StringVar(&cfg.SecretListWatchSelector, "secret-field-selector", "", "Field selector to filter Secrets to watch")
+	flagset.BoolVar(&cfg.AlertmanagerConfigAllowCrossNamespaceSecrets, "alertmanager-config-allow-cross-namespace-secrets", false, "Allow AlertmanagerConfig receivers to reference Secrets from any namespace. When disabled, the target namespace needs the \"monitoring.coreos.com/allow-cross-namespace-secrets\" annotation set to \"true\".")
+	flagset.StringVar(&rawDefaultSendResolved, "alertmanager-config-default-send-resolved", "", "Default value of send_resolved for the receivers generated from AlertmanagerConfig resources when neither the receiver nor the Alertmanager resource set it. Possible values: true, false. If omitted, the Alertmanager defaults apply.")
 }
 
 func Main() int {
@@ -243,6 +250,20 @@ func Main() int {
 		cfg.Namespaces.AllowList[v1.NamespaceAll] = struct{}{}
 	}
 
+	if cfg.ReloaderConfig.DelayInterval < 0 || cfg.ReloaderConfig.MaxConcurrentReloads < 0 {
+		fmt.Fprint(os.Stderr, "--config-reloader-delay-interval and --config-reloader-max-concurrent-reloads can't be negative.\n")
+		return 1
+	}
+
+	if rawDefaultSendResolved != "" {
+		sendResolved, err := strconv.ParseBool(rawDefaultSendResolved)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid value %q for --alertmanager-config-default-send-resolved: %v\n", rawDefaultSendResolved, err)
+			return 1
+		}
+		cfg.AlertmanagerConfigDefaultSendResolved = &sendResolved
+	}
+
 	cfg.Namespaces.DenyList = deniedNs
 	cfg.Namespaces.PrometheusAllowList = prometheusNs
 	cfg.Namespaces.AlertmanagerAllowList = alertmanagerNs
@@ -296,6 +317,9 @@ func Main() int {
 	}
 	admit := admission.New(log.With(logger, "component", "admissionwebhook"))
 
+	if cfg.EnableAlertmanagerConfigRendering {
+		web.EnableAlertmanagerConfigRendering(ao)
+	}
 	web.Register(mux)
 	admit.Register(mux)
 	l, err := net.Listen("tcp", cfg.ListenAddress)
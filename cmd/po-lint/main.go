@@ -162,8 +162,36 @@ func main() {
 			if err != nil {
 				log.Fatalf("alertmanagerConfig is invalid: %v", err)
 			}
+		case v1alpha1.ClusterAlertmanagerConfigKind:
+			j, err := yaml.YAMLToJSON(content)
+			if err != nil {
+				log.Fatalf("unable to convert YAML to JSON: %v", err)
+			}
+
+			decoder := json.NewDecoder(bytes.NewBuffer(j))
+			decoder.DisallowUnknownFields()
+
+			var clusterAlertmanagerConfig v1alpha1.ClusterAlertmanagerConfig
+			err = decoder.Decode(&clusterAlertmanagerConfig)
+			if err != nil {
+				log.Fatalf("clusterAlertmanagerConfig is invalid: %v", err)
+			}
+		case v1alpha1.PrometheusAgentsKind:
+			j, err := yaml.YAMLToJSON(content)
+			if err != nil {
+				log.Fatalf("unable to convert YAML to JSON: %v", err)
+			}
+
+			decoder := json.NewDecoder(bytes.NewBuffer(j))
+			decoder.DisallowUnknownFields()
+
+			var prometheusAgent v1alpha1.PrometheusAgent
+			err = decoder.Decode(&prometheusAgent)
+			if err != nil {
+				log.Fatalf("prometheusAgent is invalid: %v", err)
+			}
 		default:
-			log.Fatal("MetaType is unknown to linter. Not in Alertmanager, Prometheus, PrometheusRule, ServiceMonitor, PodMonitor, Probe, ThanosRuler, AlertmanagerConfig")
+			log.Fatal("MetaType is unknown to linter. Not in Alertmanager, Prometheus, PrometheusRule, ServiceMonitor, PodMonitor, Probe, ThanosRuler, AlertmanagerConfig, ClusterAlertmanagerConfig, PrometheusAgent")
 		}
 	}
 }
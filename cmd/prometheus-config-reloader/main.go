@@ -16,11 +16,17 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
@@ -45,6 +51,9 @@ const (
 	logLevelError = "error"
 	logLevelNone  = "none"
 
+	reloadMethodHTTP   = "http"
+	reloadMethodSignal = "signal"
+
 	defaultWatchInterval = 3 * time.Minute // 3 minutes was the value previously hardcoded in github.com/thanos-io/thanos/pkg/reloader.
 	defaultDelayInterval = 1 * time.Second // 1 second seems a reasonable amount of time for the kubelet to update the secrets/configmaps.
 	defaultRetryInterval = 5 * time.Second // 5 seconds was the value previously hardcoded in github.com/thanos-io/thanos/pkg/reloader.
@@ -75,8 +84,9 @@ func main() {
 	cfgSubstFile := app.Flag("config-envsubst-file", "output file for environment variable substituted config file").
 		String()
 
-	watchInterval := app.Flag("watch-interval", "how often the reloader re-reads the configuration file and directories").Default(defaultWatchInterval.String()).Duration()
+	watchInterval := app.Flag("watch-interval", "how often the reloader re-reads the configuration file and directories (0 means that the configuration file is written once and the reloader exits)").Default(defaultWatchInterval.String()).Duration()
 	delayInterval := app.Flag("delay-interval", "how long the reloader waits before reloading after it has detected a change").Default(defaultDelayInterval.String()).Duration()
+	maxConcurrentReloads := app.Flag("max-concurrent-reloads", "maximum number of statefulset replicas reloading at the same time, the replicas being staggered by delay interval based on their ordinal number (0 means no limit)").Default("0").Int()
 	retryInterval := app.Flag("retry-interval", "how long the reloader waits before retrying in case the endpoint returned an error").Default(defaultRetryInterval.String()).Duration()
 
 	watchedDir := app.Flag("watched-dir", "directory to watch non-recursively").Strings()
@@ -104,6 +114,14 @@ func main() {
 	reloadURL := app.Flag("reload-url", "reload URL to trigger Prometheus reload on").
 		Default("http://127.0.0.1:9090/-/reload").URL()
 
+	reloadMethod := app.Flag(
+		"reload-method",
+		fmt.Sprintf("method used to reload the configuration. Possible values: %s, %s (requires a shared process namespace)", reloadMethodHTTP, reloadMethodSignal)).
+		Default(reloadMethodHTTP).Enum(reloadMethodHTTP, reloadMethodSignal)
+
+	processExecutableName := app.Flag("process-executable-name", "executable name of the process to signal when the reload method is signal").
+		Default("prometheus").String()
+
 	versionutil.RegisterIntoKingpinFlags(app)
 
 	if _, err := app.Parse(os.Args[1:]); err != nil {
@@ -147,6 +165,25 @@ func main() {
 	level.Info(logger).Log("msg", "Starting prometheus-config-reloader", "version", version.Info())
 	level.Info(logger).Log("build_context", version.BuildContext())
 
+	if *watchInterval == 0 {
+		// Run as an init container: write the configuration file once so
+		// that it exists when the main container starts.
+		if err := writeConfigOnce(logger, *cfgFile, *cfgSubstFile); err != nil {
+			level.Error(logger).Log("msg", "failed to write the configuration file", "err", err)
+			os.Exit(1)
+		}
+		level.Info(logger).Log("msg", "configuration file written", "out", *cfgSubstFile)
+		os.Exit(0)
+	}
+
+	// The reload endpoint is served over HTTPS when the web server of the
+	// reloaded process is configured with TLS. The certificate is issued for
+	// the service's names and not for the local address used by the reloader
+	// hence it isn't verified.
+	if (*reloadURL).Scheme == "https" {
+		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
 	r := prometheus.NewRegistry()
 	r.MustRegister(
 		prometheus.NewGoCollector(),
@@ -154,6 +191,35 @@ func main() {
 	)
 
 	var g run.Group
+
+	if *reloadMethod == reloadMethodSignal {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		*reloadURL = &url.URL{
+			Scheme: "http",
+			Host:   l.Addr().String(),
+			Path:   "/-/reload",
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/-/reload", &processSignaler{
+			logger:         logger,
+			procDir:        "/proc",
+			executableName: *processExecutableName,
+		})
+
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "Reloading the configuration with a process signal", "executable", *processExecutableName)
+			return http.Serve(l, mux)
+		}, func(error) {
+			l.Close()
+		})
+	}
+
 	{
 		ctx, cancel := context.WithCancel(context.Background())
 		rel := reloader.New(
@@ -164,7 +230,7 @@ func main() {
 				CfgFile:       *cfgFile,
 				CfgOutputFile: *cfgSubstFile,
 				WatchedDirs:   *watchedDir,
-				DelayInterval: *delayInterval,
+				DelayInterval: staggeredDelayInterval(*delayInterval, *maxConcurrentReloads, os.Getenv(statefulsetOrdinalEnvvar)),
 				WatchInterval: *watchInterval,
 				RetryInterval: *retryInterval,
 			},
@@ -193,8 +259,83 @@ func main() {
 	}
 }
 
+// writeConfigOnce lets the reloader decompress and expand the configuration
+// file into the output file, then it stops the reloader. The reload triggered
+// by the reloader once the file is written is served by a local endpoint
+// because there's no process to reload yet.
+func writeConfigOnce(logger log.Logger, cfgFile, cfgSubstFile string) error {
+	if cfgFile == "" || cfgSubstFile == "" {
+		return errors.New("both the config file and the envsubst file must be set")
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	var (
+		once     sync.Once
+		reloaded = make(chan struct{})
+	)
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			once.Do(func() { close(reloaded) })
+		}),
+	}
+	go srv.Serve(l)
+	defer srv.Close()
+
+	rel := reloader.New(
+		logger,
+		nil,
+		&reloader.Options{
+			ReloadURL:     &url.URL{Scheme: "http", Host: l.Addr().String(), Path: "/-/reload"},
+			CfgFile:       cfgFile,
+			CfgOutputFile: cfgSubstFile,
+			WatchInterval: defaultWatchInterval,
+			RetryInterval: defaultRetryInterval,
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- rel.Watch(ctx)
+	}()
+
+	select {
+	case <-reloaded:
+		// The reload is only triggered after the file has been written.
+		cancel()
+		<-errc
+		return nil
+	case err := <-errc:
+		return err
+	}
+}
+
 func createOrdinalEnvvar(fromName string) error {
 	reg := regexp.MustCompile(`\d+$`)
 	val := reg.FindString(os.Getenv(fromName))
 	return os.Setenv(statefulsetOrdinalEnvvar, val)
 }
+
+// staggeredDelayInterval returns the delay interval of the replica with the
+// given ordinal number. The replicas are split into groups of
+// maxConcurrentReloads which wait for one more delay interval than the
+// previous group, spreading the reloads triggered by the same change over
+// time.
+func staggeredDelayInterval(delay time.Duration, maxConcurrentReloads int, ordinal string) time.Duration {
+	if maxConcurrentReloads <= 0 {
+		return delay
+	}
+
+	n, err := strconv.Atoi(ordinal)
+	if err != nil {
+		return delay
+	}
+
+	return delay * time.Duration(1+n/maxConcurrentReloads)
+}
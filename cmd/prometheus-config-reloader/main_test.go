@@ -15,8 +15,15 @@
 package main
 
 import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
 )
 
 var cases = []struct {
@@ -42,3 +49,128 @@ func TestCreateOrdinalEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessSignalerFindProcess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for pid, comm := range map[string]string{
+		"1":    "pause\n",
+		"12":   "prometheus-con\n",
+		"42":   "prometheus\n",
+		"self": "prometheus-con\n",
+	} {
+		if err := os.Mkdir(filepath.Join(dir, pid), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, pid, "comm"), []byte(comm), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := &processSignaler{logger: log.NewNopLogger(), procDir: dir, executableName: "prometheus"}
+	pid, err := s.findProcess()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if pid != 42 {
+		t.Fatalf("expected pid 42, got %d", pid)
+	}
+
+	s.executableName = "alertmanager"
+	if _, err := s.findProcess(); err == nil {
+		t.Fatal("expected an error for a missing process")
+	}
+}
+
+func TestStaggeredDelayInterval(t *testing.T) {
+	for _, tc := range []struct {
+		max      int
+		ordinal  string
+		expected time.Duration
+	}{
+		{max: 0, ordinal: "5", expected: time.Second},
+		{max: 2, ordinal: "", expected: time.Second},
+		{max: 2, ordinal: "0", expected: time.Second},
+		{max: 2, ordinal: "1", expected: time.Second},
+		{max: 2, ordinal: "2", expected: 2 * time.Second},
+		{max: 2, ordinal: "5", expected: 3 * time.Second},
+	} {
+		if got := staggeredDelayInterval(time.Second, tc.max, tc.ordinal); got != tc.expected {
+			t.Errorf("max %d, ordinal %q: got %v, want %v", tc.max, tc.ordinal, got, tc.expected)
+		}
+	}
+}
+
+func TestWriteConfigOnce(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv("TEST_POD_NAME", "alertmanager-main-0")
+	defer os.Unsetenv("TEST_POD_NAME")
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte("name: $(TEST_POD_NAME)\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		in       []byte
+		expected string
+		err      bool
+	}{
+		{
+			name:     "plain",
+			in:       []byte("name: $(TEST_POD_NAME)\n"),
+			expected: "name: alertmanager-main-0\n",
+		},
+		{
+			name:     "gzipped",
+			in:       buf.Bytes(),
+			expected: "name: alertmanager-main-0\n",
+		},
+		{
+			name: "unset variable",
+			in:   []byte("name: $(TEST_UNSET_VARIABLE)\n"),
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			in := filepath.Join(dir, tc.name+".in")
+			out := filepath.Join(dir, tc.name+".out")
+			if err := ioutil.WriteFile(in, tc.in, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			err := writeConfigOnce(log.NewNopLogger(), in, out)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			b, err := ioutil.ReadFile(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, string(b))
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+// processSignaler sends a SIGHUP signal to the process matching the
+// executable name whenever it receives a reload request. The Thanos reloader
+// only knows how to reload over HTTP so it's pointed at a local endpoint
+// served by the processSignaler.
+//
+// The reloader and the reloaded process must share the same process
+// namespace.
+type processSignaler struct {
+	logger         log.Logger
+	procDir        string
+	executableName string
+}
+
+func (s *processSignaler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST requests allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	pid, err := s.findProcess()
+	if err != nil {
+		level.Error(s.logger).Log("msg", "failed to find the process to reload", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := syscall.Kill(pid, syscall.SIGHUP); err != nil {
+		level.Error(s.logger).Log("msg", "failed to send the reload signal", "pid", pid, "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	level.Debug(s.logger).Log("msg", "reload signal sent", "pid", pid, "executable", s.executableName)
+}
+
+// findProcess returns the PID of the first process whose executable name
+// matches.
+func (s *processSignaler) findProcess() (int, error) {
+	entries, err := ioutil.ReadDir(s.procDir)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil || !e.IsDir() {
+			continue
+		}
+
+		comm, err := ioutil.ReadFile(filepath.Join(s.procDir, e.Name(), "comm"))
+		if err != nil {
+			// The process may have exited in the meantime.
+			continue
+		}
+
+		if strings.TrimSpace(string(comm)) == s.executableName {
+			return pid, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no process found with executable name %q", s.executableName)
+}
@@ -6,6 +6,9 @@ local defaults = {
   image: error 'must provide image',
   configReloaderImage: error 'must provide configReloaderImage',
   port: 8080,
+  // Field of the Prometheus resource mapped by the scale subresource, either
+  // 'shards' or 'replicas'.
+  prometheusScaleSubresource: 'shards',
   resources: {
     limits: { cpu: '200m', memory: '200Mi' },
     requests: { cpu: '100m', memory: '100Mi' },
@@ -26,10 +29,28 @@ function(params) {
   local po = self,
   config:: defaults + params,
 
+  assert std.member(['shards', 'replicas'], po.config.prometheusScaleSubresource) : 'prometheusScaleSubresource must be either shards or replicas',
+
   // Prefixing with 0 to ensure these manifests are listed and therefore created first.
   '0alertmanagerCustomResourceDefinition': import 'alertmanager-crd.libsonnet',
   '0alertmanagerConfigCustomResourceDefinition': import 'alertmanagerconfig-crd.libsonnet',
-  '0prometheusCustomResourceDefinition': import 'prometheus-crd.libsonnet',
+  '0clusterAlertmanagerConfigCustomResourceDefinition': import 'clusteralertmanagerconfig-crd.libsonnet',
+  '0prometheusCustomResourceDefinition': (import 'prometheus-crd.libsonnet') + {
+    spec+: {
+      versions: [
+        v {
+          subresources+: {
+            scale+: {
+              specReplicasPath: '.spec.' + po.config.prometheusScaleSubresource,
+              statusReplicasPath: '.status.' + po.config.prometheusScaleSubresource,
+            },
+          },
+        }
+        for v in super.versions
+      ],
+    },
+  },
+  '0prometheusagentCustomResourceDefinition': import 'prometheusagent-crd.libsonnet',
   '0servicemonitorCustomResourceDefinition': import 'servicemonitor-crd.libsonnet',
   '0podmonitorCustomResourceDefinition': import 'podmonitor-crd.libsonnet',
   '0probeCustomResourceDefinition': import 'probe-crd.libsonnet',
@@ -68,9 +89,16 @@ function(params) {
         resources: [
           'alertmanagers',
           'alertmanagers/finalizers',
+          'alertmanagers/status',
           'alertmanagerconfigs',
+          'alertmanagerconfigs/status',
+          'clusteralertmanagerconfigs',
+          'clusteralertmanagerconfigs/status',
           'prometheuses',
           'prometheuses/finalizers',
+          'prometheuses/status',
+          'prometheusagents',
+          'prometheusagents/finalizers',
           'thanosrulers',
           'thanosrulers/finalizers',
           'servicemonitors',
@@ -82,7 +110,7 @@ function(params) {
       },
       {
         apiGroups: ['apps'],
-        resources: ['statefulsets'],
+        resources: ['statefulsets', 'daemonsets'],
         verbs: ['*'],
       },
       {
@@ -104,6 +132,11 @@ function(params) {
         ],
         verbs: ['get', 'create', 'update', 'delete'],
       },
+      {
+        apiGroups: [''],
+        resources: ['events'],
+        verbs: ['create', 'patch'],
+      },
       {
         apiGroups: [''],
         resources: ['nodes'],
@@ -119,6 +152,11 @@ function(params) {
         resources: ['ingresses'],
         verbs: ['get', 'list', 'watch'],
       },
+      {
+        apiGroups: ['policy'],
+        resources: ['poddisruptionbudgets'],
+        verbs: ['get', 'list', 'create', 'update', 'delete'],
+      },
     ],
   },
 
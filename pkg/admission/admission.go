@@ -25,6 +25,7 @@ import (
 	"github.com/go-kit/kit/log/level"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/rulefmt"
 	v1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -48,7 +49,7 @@ var (
 )
 
 // Admission is a validating and mutating webhook that ensures PrometheusRules pushed into the cluster will be
-// valid when loaded by a Prometheus
+// valid when loaded by a Prometheus. It also serves the conversion webhook for AlertmanagerConfig resources.
 type Admission struct {
 	validationErrorsCounter    prometheus.Counter
 	validationTriggeredCounter prometheus.Counter
@@ -62,6 +63,7 @@ func New(logger log.Logger) *Admission {
 func (a *Admission) Register(mux *http.ServeMux) {
 	mux.HandleFunc("/admission-prometheusrules/validate", a.servePrometheusRulesValidate)
 	mux.HandleFunc("/admission-prometheusrules/mutate", a.servePrometheusRulesMutate)
+	mux.HandleFunc(alertmanagerConfigConvertPath, a.serveConvert)
 }
 
 func (a *Admission) RegisterMetrics(validationTriggeredCounter, validationErrorsCounter prometheus.Counter) {
@@ -208,7 +210,14 @@ func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.Admission
 		return toAdmissionResponseFailure(errUnmarshalAdmission, []error{err})
 	}
 
-	_, errors := rulefmt.Parse(rules.Spec.Raw)
+	content, err := removeQueryOffsets(rules.Spec.Raw)
+	if err != nil {
+		level.Info(a.logger).Log("msg", "Invalid rule", "err", err)
+		a.validationErrorsCounter.Inc()
+		return toAdmissionResponseFailure("Rules are not valid", []error{err})
+	}
+
+	_, errors := rulefmt.Parse(content)
 	if len(errors) != 0 {
 		const m = "Invalid rule"
 		level.Debug(a.logger).Log("msg", m, "content", rules.Spec.Raw)
@@ -222,3 +231,47 @@ func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.Admission
 
 	return &v1.AdmissionResponse{Allowed: true}
 }
+
+// removeQueryOffsets validates the query_offset fields of the rule groups and
+// removes them from the content because the rule parser doesn't support them.
+func removeQueryOffsets(content []byte) ([]byte, error) {
+	var spec map[string]interface{}
+	if err := json.Unmarshal(content, &spec); err != nil {
+		return nil, err
+	}
+
+	groups, ok := spec["groups"].([]interface{})
+	if !ok {
+		return content, nil
+	}
+
+	var found bool
+	for i, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := group["query_offset"]
+		if !ok {
+			continue
+		}
+
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("group %d: query_offset must be a string", i)
+		}
+		if _, err := model.ParseDuration(s); err != nil {
+			return nil, fmt.Errorf("group %d: invalid query_offset: %w", i, err)
+		}
+
+		delete(group, "query_offset")
+		found = true
+	}
+
+	if !found {
+		return content, nil
+	}
+
+	return json.Marshal(spec)
+}
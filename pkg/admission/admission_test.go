@@ -125,6 +125,48 @@ func TestAdmitBadRuleWithBooleanInAnnotations(t *testing.T) {
 	}
 }
 
+func TestRemoveQueryOffsets(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		content  string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "no query offset",
+			content:  `{"groups":[{"name":"test","rules":[]}]}`,
+			expected: `{"groups":[{"name":"test","rules":[]}]}`,
+		},
+		{
+			name:     "valid query offset",
+			content:  `{"groups":[{"name":"test","query_offset":"1m","rules":[]}]}`,
+			expected: `{"groups":[{"name":"test","rules":[]}]}`,
+		},
+		{
+			name:    "invalid query offset",
+			content: `{"groups":[{"name":"test","query_offset":"1 minute","rules":[]}]}`,
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := removeQueryOffsets([]byte(tc.content))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if string(content) != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, content)
+			}
+		})
+	}
+}
+
 func TestMutateNonStringsToStrings(t *testing.T) {
 	request := nonStringsInLabelsAnnotations
 	ts := server(api().servePrometheusRulesMutate)
@@ -0,0 +1,156 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/go-kit/kit/log/level"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const alertmanagerConfigConvertPath = "/convert"
+
+func (a *Admission) serveConvert(w http.ResponseWriter, r *http.Request) {
+	var body []byte
+	if r.Body != nil {
+		if data, err := ioutil.ReadAll(r.Body); err == nil {
+			body = data
+		}
+	}
+
+	if len(body) == 0 {
+		level.Warn(a.logger).Log("msg", "request has no body")
+		http.Error(w, "request has no body", http.StatusBadRequest)
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if contentType != "application/json" {
+		level.Warn(a.logger).Log("msg", fmt.Sprintf("invalid Content-Type %s, want `application/json`", contentType))
+		http.Error(w, "invalid Content-Type, want `application/json`", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	review := apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(body, &review); err != nil {
+		level.Warn(a.logger).Log("msg", "Unable to deserialize conversion request", "err", err)
+		http.Error(w, fmt.Sprintf("unable to deserialize conversion request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if review.Request == nil {
+		level.Warn(a.logger).Log("msg", "conversion request is empty")
+		http.Error(w, "conversion request is empty", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = a.convertObjects(review.Request)
+	review.Request = nil
+
+	respBytes, err := json.Marshal(review)
+	if err != nil {
+		level.Error(a.logger).Log("msg", "Cannot serialize response", "err", err)
+		http.Error(w, fmt.Sprintf("could not serialize response: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	level.Debug(a.logger).Log("msg", "sending response", "content", string(respBytes))
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(respBytes); err != nil {
+		level.Error(a.logger).Log("msg", "Cannot write response", "err", err)
+	}
+}
+
+func (a *Admission) convertObjects(req *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	resp := &apiextensionsv1.ConversionResponse{
+		UID: req.UID,
+	}
+
+	for i, obj := range req.Objects {
+		converted, err := convertAlertmanagerConfig(obj.Raw, req.DesiredAPIVersion)
+		if err != nil {
+			level.Info(a.logger).Log("msg", "Unable to convert object", "err", err)
+			resp.ConvertedObjects = nil
+			resp.Result = metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: fmt.Sprintf("object %d: %v", i, err),
+			}
+			return resp
+		}
+
+		resp.ConvertedObjects = append(resp.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+
+	resp.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return resp
+}
+
+// convertAlertmanagerConfig converts the serialized AlertmanagerConfig
+// object to the desired API version.
+func convertAlertmanagerConfig(raw []byte, desiredAPIVersion string) ([]byte, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+
+	if typeMeta.Kind != monitoringv1alpha1.AlertmanagerConfigKind {
+		return nil, fmt.Errorf("unexpected kind %q", typeMeta.Kind)
+	}
+
+	if typeMeta.APIVersion == desiredAPIVersion {
+		return raw, nil
+	}
+
+	var out interface{}
+	switch {
+	case typeMeta.APIVersion == monitoringv1alpha1.SchemeGroupVersion.String() && desiredAPIVersion == monitoringv1beta1.SchemeGroupVersion.String():
+		src := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := json.Unmarshal(raw, src); err != nil {
+			return nil, err
+		}
+
+		dst := &monitoringv1beta1.AlertmanagerConfig{}
+		if err := src.ConvertTo(dst); err != nil {
+			return nil, err
+		}
+		out = dst
+
+	case typeMeta.APIVersion == monitoringv1beta1.SchemeGroupVersion.String() && desiredAPIVersion == monitoringv1alpha1.SchemeGroupVersion.String():
+		src := &monitoringv1beta1.AlertmanagerConfig{}
+		if err := json.Unmarshal(raw, src); err != nil {
+			return nil, err
+		}
+
+		dst := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := dst.ConvertFrom(src); err != nil {
+			return nil, err
+		}
+		out = dst
+
+	default:
+		return nil, fmt.Errorf("unsupported conversion from %q to %q", typeMeta.APIVersion, desiredAPIVersion)
+	}
+
+	return json.Marshal(out)
+}
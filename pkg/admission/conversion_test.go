@@ -0,0 +1,301 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestConvertAlertmanagerConfig(t *testing.T) {
+	ts := server(api().serveConvert)
+	defer ts.Close()
+
+	for _, tc := range []struct {
+		name              string
+		request           []byte
+		desiredAPIVersion string
+		check             func(t *testing.T, raw []byte)
+	}{
+		{
+			name:              "v1alpha1 to v1beta1",
+			request:           alertmanagerConfigV1alpha1,
+			desiredAPIVersion: "monitoring.coreos.com/v1beta1",
+			check: func(t *testing.T, raw []byte) {
+				amc := monitoringv1beta1.AlertmanagerConfig{}
+				if err := json.Unmarshal(raw, &amc); err != nil {
+					t.Fatal(err)
+				}
+
+				if amc.APIVersion != "monitoring.coreos.com/v1beta1" {
+					t.Fatalf("expected apiVersion %q, got %q", "monitoring.coreos.com/v1beta1", amc.APIVersion)
+				}
+
+				if amc.Spec.Route.Matchers[0].MatchType != monitoringv1beta1.MatchRegexp {
+					t.Fatalf("expected match type %q, got %q", monitoringv1beta1.MatchRegexp, amc.Spec.Route.Matchers[0].MatchType)
+				}
+
+				if amc.Spec.Receivers[0].SlackConfigs[0].APIURL.Name != "slack" {
+					t.Fatalf("expected secret name %q, got %q", "slack", amc.Spec.Receivers[0].SlackConfigs[0].APIURL.Name)
+				}
+			},
+		},
+		{
+			name:              "v1beta1 to v1alpha1",
+			request:           alertmanagerConfigV1beta1,
+			desiredAPIVersion: "monitoring.coreos.com/v1alpha1",
+			check: func(t *testing.T, raw []byte) {
+				amc := monitoringv1alpha1.AlertmanagerConfig{}
+				if err := json.Unmarshal(raw, &amc); err != nil {
+					t.Fatal(err)
+				}
+
+				if amc.APIVersion != "monitoring.coreos.com/v1alpha1" {
+					t.Fatalf("expected apiVersion %q, got %q", "monitoring.coreos.com/v1alpha1", amc.APIVersion)
+				}
+
+				if amc.Spec.Route.Matchers[0].Type() != monitoringv1alpha1.MatchNotEqual {
+					t.Fatalf("expected match type %q, got %q", monitoringv1alpha1.MatchNotEqual, amc.Spec.Route.Matchers[0].Type())
+				}
+
+				children, err := amc.Spec.Route.ChildRoutes()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if children[0].Matchers[0].Type() != monitoringv1alpha1.MatchRegexp {
+					t.Fatalf("expected match type %q, got %q", monitoringv1alpha1.MatchRegexp, children[0].Matchers[0].Type())
+				}
+
+				if amc.Spec.Receivers[0].SlackConfigs[0].APIURL.Name != "slack" {
+					t.Fatalf("expected secret name %q, got %q", "slack", amc.Spec.Receivers[0].SlackConfigs[0].APIURL.Name)
+				}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			review := apiextensionsv1.ConversionReview{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConversionReview",
+					APIVersion: "apiextensions.k8s.io/v1",
+				},
+				Request: &apiextensionsv1.ConversionRequest{
+					UID:               "87c5df7f-5090-11e9-b9b4-02425473f309",
+					DesiredAPIVersion: tc.desiredAPIVersion,
+					Objects:           []runtime.RawExtension{{Raw: tc.request}},
+				},
+			}
+
+			b, err := json.Marshal(review)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := http.Post(ts.URL, "application/json", bytes.NewReader(b))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := apiextensionsv1.ConversionReview{}
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Response.UID != review.Request.UID {
+				t.Fatalf("expected UID %q, got %q", review.Request.UID, got.Response.UID)
+			}
+
+			if got.Response.Result.Status != metav1.StatusSuccess {
+				t.Fatalf("expected conversion to succeed, got %q", got.Response.Result.Message)
+			}
+
+			if len(got.Response.ConvertedObjects) != 1 {
+				t.Fatalf("expected 1 converted object, got %d", len(got.Response.ConvertedObjects))
+			}
+
+			tc.check(t, got.Response.ConvertedObjects[0].Raw)
+		})
+	}
+}
+
+var alertmanagerConfigV1alpha1 = []byte(`{
+  "apiVersion": "monitoring.coreos.com/v1alpha1",
+  "kind": "AlertmanagerConfig",
+  "metadata": {
+    "name": "example",
+    "namespace": "default"
+  },
+  "spec": {
+    "route": {
+      "receiver": "slack",
+      "matchers": [{"name": "severity", "value": "critical|warning", "regex": true}]
+    },
+    "receivers": [{
+      "name": "slack",
+      "slackConfigs": [{"apiURL": {"name": "slack", "key": "url"}}]
+    }]
+  }
+}`)
+
+var alertmanagerConfigV1beta1 = []byte(`{
+  "apiVersion": "monitoring.coreos.com/v1beta1",
+  "kind": "AlertmanagerConfig",
+  "metadata": {
+    "name": "example",
+    "namespace": "default"
+  },
+  "spec": {
+    "route": {
+      "receiver": "slack",
+      "matchers": [{"name": "severity", "value": "info", "matchType": "!="}],
+      "routes": [{
+        "receiver": "slack",
+        "matchers": [{"name": "team", "value": "frontend|backend", "matchType": "=~"}]
+      }]
+    },
+    "receivers": [{
+      "name": "slack",
+      "slackConfigs": [{"apiURL": {"name": "slack", "key": "url"}}]
+    }]
+  }
+}`)
+
+var (
+	fieldsType         = reflect.TypeOf(metav1.FieldsV1{})
+	jsonType           = reflect.TypeOf(apiextensionsv1.JSON{})
+	matcherType        = reflect.TypeOf(monitoringv1alpha1.Matcher{})
+	secretSelectorType = reflect.TypeOf(v1.SecretKeySelector{})
+	timeType           = reflect.TypeOf(metav1.Time{})
+)
+
+// populate sets every exported field reachable from v to a non-zero value.
+// Slices and maps get a single element so that all the nested types are
+// populated as well.
+func populate(t *testing.T, v reflect.Value, path string) {
+	switch v.Type() {
+	case fieldsType:
+		v.Set(reflect.ValueOf(metav1.FieldsV1{Raw: []byte(`{"f:spec":{}}`)}))
+		return
+	case jsonType:
+		// Child routes are stored as raw JSON.
+		child := monitoringv1alpha1.Route{}
+		populate(t, reflect.ValueOf(&child.Receiver).Elem(), path+".Receiver")
+		populate(t, reflect.ValueOf(&child.Matchers).Elem(), path+".Matchers")
+		b, err := json.Marshal(child)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v.Set(reflect.ValueOf(apiextensionsv1.JSON{Raw: b}))
+		return
+	case matcherType:
+		// The deprecated regex field is translated into the match type.
+		v.Set(reflect.ValueOf(monitoringv1alpha1.Matcher{Name: "name", Value: "value", MatchType: monitoringv1alpha1.MatchRegexp}))
+		return
+	case secretSelectorType:
+		// The v1beta1 selectors can't be optional.
+		v.Set(reflect.ValueOf(v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: path},
+			Key:                  "key",
+		}))
+		return
+	case timeType:
+		v.Set(reflect.ValueOf(metav1.Unix(1, 0)))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		populate(t, v.Elem(), path)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			populate(t, v.Field(i), path+"."+v.Type().Field(i).Name)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(path))
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		populate(t, v.Index(0), path+"[0]")
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		k := reflect.New(v.Type().Key()).Elem()
+		populate(t, k, path+".key")
+		e := reflect.New(v.Type().Elem()).Elem()
+		populate(t, e, path+".value")
+		v.SetMapIndex(k, e)
+	case reflect.String:
+		v.SetString(path)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	default:
+		t.Fatalf("%s: unsupported kind %s", path, v.Kind())
+	}
+}
+
+func TestAlertmanagerConfigConversionRoundTrip(t *testing.T) {
+	in := &monitoringv1alpha1.AlertmanagerConfig{}
+	populate(t, reflect.ValueOf(in).Elem(), "AlertmanagerConfig")
+	in.APIVersion = monitoringv1alpha1.SchemeGroupVersion.String()
+
+	if in.Spec.Receivers[0].SecretNamespace == "" {
+		t.Fatal("expected the secret namespace to be populated")
+	}
+	if in.Status == nil || len(in.Status.Conditions) == 0 {
+		t.Fatal("expected the status to be populated")
+	}
+
+	var hub monitoringv1beta1.AlertmanagerConfig
+	if err := in.DeepCopy().ConvertTo(&hub); err != nil {
+		t.Fatalf("conversion to v1beta1 failed: %v", err)
+	}
+
+	out := &monitoringv1alpha1.AlertmanagerConfig{}
+	if err := out.ConvertFrom(&hub); err != nil {
+		t.Fatalf("conversion from v1beta1 failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(in, out) {
+		expected, _ := json.MarshalIndent(in, "", "  ")
+		got, _ := json.MarshalIndent(out, "", "  ")
+		t.Fatalf("round-trip conversion mismatch\nexpected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -18,19 +18,25 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/url"
 	"path"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
 	"github.com/pkg/errors"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	"github.com/prometheus/alertmanager/config"
 	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
 	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -55,60 +61,228 @@ func (c alertmanagerConfig) String() string {
 	return string(b)
 }
 
+// secretToken replaces the credentials of redacted configurations. It is the
+// same value as the one used by Alertmanager to marshal its Secret type.
+const secretToken = "<secret>"
+
+// redactConfig returns the given Alertmanager configuration with all
+// credentials replaced by secretToken.
+func redactConfig(b []byte) ([]byte, error) {
+	cfg := &alertmanagerConfig{}
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the Alertmanager configuration")
+	}
+
+	cfg.redactSecrets()
+
+	return yaml.Marshal(cfg)
+}
+
+func redact(s *string) {
+	if *s != "" {
+		*s = secretToken
+	}
+}
+
+func (c *alertmanagerConfig) redactSecrets() {
+	if g := c.Global; g != nil {
+		g.HTTPConfig.redactSecrets()
+		redact(&g.SMTPAuthPassword)
+		redact(&g.SMTPAuthSecret)
+		redact(&g.HipchatAuthToken)
+		redact(&g.OpsGenieAPIKey)
+		redact(&g.WeChatAPISecret)
+		redact(&g.VictorOpsAPIKey)
+		if g.SlackAPIURL != nil {
+			// An opaque URL is marshaled as-is.
+			g.SlackAPIURL = &config.URL{URL: &url.URL{Opaque: secretToken}}
+		}
+	}
+
+	for _, r := range c.Receivers {
+		for _, cfg := range r.OpsgenieConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.APIKey)
+		}
+		for _, cfg := range r.PagerdutyConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.ServiceKey)
+			redact(&cfg.RoutingKey)
+		}
+		for _, cfg := range r.SlackConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.APIURL)
+		}
+		for _, cfg := range r.WebhookConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.URL)
+		}
+		for _, cfg := range r.WeChatConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.APISecret)
+		}
+		for _, cfg := range r.EmailConfigs {
+			redact(&cfg.AuthPassword)
+			redact(&cfg.AuthSecret)
+		}
+		for _, cfg := range r.PushoverConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.UserKey)
+			redact(&cfg.Token)
+		}
+		for _, cfg := range r.VictorOpsConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.APIKey)
+		}
+		for _, cfg := range r.SNSConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			if cfg.Sigv4 != nil {
+				redact(&cfg.Sigv4.SecretKey)
+			}
+		}
+		for _, cfg := range r.TelegramConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.BotToken)
+		}
+		for _, cfg := range r.DiscordConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.WebhookURL)
+		}
+		for _, cfg := range r.MSTeamsConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.WebhookURL)
+		}
+		for _, cfg := range r.MSTeamsV2Configs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.WebhookURL)
+		}
+		for _, cfg := range r.RocketChatConfigs {
+			cfg.HTTPConfig.redactSecrets()
+			redact(&cfg.Token)
+			redact(&cfg.TokenID)
+		}
+		for _, cfg := range r.JiraConfigs {
+			cfg.HTTPConfig.redactSecrets()
+		}
+	}
+}
+
+func (hc *httpClientConfig) redactSecrets() {
+	if hc == nil {
+		return
+	}
+
+	redact(&hc.BearerToken)
+	if hc.BasicAuth != nil {
+		redact(&hc.BasicAuth.Password)
+	}
+	if hc.Authorization != nil {
+		redact(&hc.Authorization.Credentials)
+	}
+	if hc.OAuth2 != nil {
+		redact(&hc.OAuth2.ClientSecret)
+	}
+}
+
 type configGenerator struct {
-	logger log.Logger
-	store  *assets.Store
+	logger          log.Logger
+	amVersion       semver.Version
+	store           *assets.Store
+	matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy
+	amNamespace     string
+	credentialsMode monitoringv1.AlertmanagerConfigCredentialsMode
+	// defaultSendResolved is the value of send_resolved for the receivers
+	// which don't set it. If nil, the Alertmanager defaults apply.
+	defaultSendResolved *bool
+	// credentials holds the secret values referenced by *_file fields of the
+	// generated configuration, indexed by file name.
+	credentials map[string][]byte
 }
 
-func newConfigGenerator(logger log.Logger, store *assets.Store) *configGenerator {
+func newConfigGenerator(logger log.Logger, store *assets.Store, am *monitoringv1.Alertmanager, defaultSendResolved *bool) (*configGenerator, error) {
+	amVersion, err := semver.ParseTolerant(operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse Alertmanager version")
+	}
+
 	cg := &configGenerator{
-		logger: logger,
-		store:  store,
+		logger:          logger,
+		amVersion:       amVersion,
+		store:           store,
+		matcherStrategy: am.Spec.AlertmanagerConfigMatcherStrategy,
+		amNamespace:     am.Namespace,
+		credentialsMode: am.Spec.AlertmanagerConfigCredentialsMode,
+		credentials:     map[string][]byte{},
 	}
-	return cg
+
+	// The Alertmanager resource takes precedence over the operator's default.
+	cg.defaultSendResolved = defaultSendResolved
+	if am.Spec.AlertmanagerConfigDefaultSendResolved != nil {
+		cg.defaultSendResolved = am.Spec.AlertmanagerConfigDefaultSendResolved
+	}
+
+	return cg, nil
+}
+
+// sendResolved returns the value of send_resolved for a receiver
+// configuration, falling back to the default value if not set.
+func (cg *configGenerator) sendResolved(in *bool) *bool {
+	if in != nil {
+		return in
+	}
+
+	return cg.defaultSendResolved
 }
 
+// enforceNamespaceForMatchers returns true if the routes and inhibition
+// rules of the given AlertmanagerConfig object should only match alerts from
+// the object's namespace.
+func (cg *configGenerator) enforceNamespaceForMatchers(crKey types.NamespacedName) bool {
+	switch cg.matcherStrategy.Type {
+	case monitoringv1.NoneConfigMatcherStrategyType:
+		return false
+	case monitoringv1.OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType:
+		return crKey.Namespace != cg.amNamespace
+	default:
+		return true
+	}
+}
+
+// generateConfig merges the ClusterAlertmanagerConfig objects (converted to
+// AlertmanagerConfig objects) and the AlertmanagerConfig objects into the
+// base configuration. The routes of the ClusterAlertmanagerConfig objects
+// come first and they don't enforce the namespace matcher.
 func (cg *configGenerator) generateConfig(
 	ctx context.Context,
 	baseConfig alertmanagerConfig,
+	clusterConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig,
 	amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig,
 ) ([]byte, error) {
-	// amConfigIdentifiers is a sorted slice of keys from
-	// amConfigs map, used to always generate the config in the
-	// same order.
-	amConfigIdentifiers := make([]string, len(amConfigs))
-	i := 0
-	for k := range amConfigs {
-		amConfigIdentifiers[i] = k
-		i++
-	}
-	sort.Strings(amConfigIdentifiers)
+	cg.credentials = map[string][]byte{}
 
-	subRoutes := make([]*route, 0, len(amConfigs))
-	for _, amConfigIdentifier := range amConfigIdentifiers {
-		crKey := types.NamespacedName{
-			Name:      amConfigs[amConfigIdentifier].Name,
-			Namespace: amConfigs[amConfigIdentifier].Namespace,
+	subRoutes := make([]*route, 0, len(clusterConfigs)+len(amConfigs))
+	for _, amc := range sortedAlertmanagerConfigs(clusterConfigs) {
+		r, err := cg.appendAlertmanagerConfig(ctx, &baseConfig, amc, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ClusterAlertmanagerConfig %s", amc.Name)
 		}
-
-		// Add inhibitRules to baseConfig.InhibitRules.
-		for _, inhibitRule := range amConfigs[amConfigIdentifier].Spec.InhibitRules {
-			baseConfig.InhibitRules = append(baseConfig.InhibitRules, convertInhibitRule(&inhibitRule, crKey))
+		if r != nil {
+			subRoutes = append(subRoutes, r)
 		}
+	}
 
-		// Skip early if there's no route definition.
-		if amConfigs[amConfigIdentifier].Spec.Route == nil {
-			continue
+	for _, amc := range sortedAlertmanagerConfigs(amConfigs) {
+		crKey := types.NamespacedName{
+			Name:      amc.Name,
+			Namespace: amc.Namespace,
 		}
 
-		subRoutes = append(subRoutes, convertRoute(amConfigs[amConfigIdentifier].Spec.Route, crKey, true))
-
-		for _, receiver := range amConfigs[amConfigIdentifier].Spec.Receivers {
-			receivers, err := cg.convertReceiver(ctx, &receiver, crKey)
-			if err != nil {
-				return nil, errors.Wrapf(err, "AlertmanagerConfig %s", crKey.String())
-			}
-			baseConfig.Receivers = append(baseConfig.Receivers, receivers)
+		r, err := cg.appendAlertmanagerConfig(ctx, &baseConfig, amc, cg.enforceNamespaceForMatchers(crKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "AlertmanagerConfig %s", crKey.String())
+		}
+		if r != nil {
+			subRoutes = append(subRoutes, r)
 		}
 	}
 
@@ -121,35 +295,286 @@ func (cg *configGenerator) generateConfig(
 	return yaml.Marshal(baseConfig)
 }
 
-func convertRoute(in *monitoringv1alpha1.Route, crKey types.NamespacedName, firstLevelRoute bool) *route {
-	// Enforce "continue" to be true for the top-level route.
-	cont := in.Continue
-	if firstLevelRoute {
-		cont = true
+// appendAlertmanagerConfig adds the inhibition rules, time intervals,
+// templates and receivers of the AlertmanagerConfig object to the
+// configuration. It returns the first-level route of the object or nil if it
+// has no route.
+func (cg *configGenerator) appendAlertmanagerConfig(ctx context.Context, cfg *alertmanagerConfig, amc *monitoringv1alpha1.AlertmanagerConfig, enforceNamespace bool) (*route, error) {
+	crKey := types.NamespacedName{
+		Name:      amc.Name,
+		Namespace: amc.Namespace,
 	}
 
-	match := map[string]string{}
-	matchRE := map[string]string{}
+	// Add inhibitRules to baseConfig.InhibitRules.
+	for _, inhibitRule := range amc.Spec.InhibitRules {
+		cfg.InhibitRules = append(cfg.InhibitRules, cg.convertInhibitRule(&inhibitRule, crKey, enforceNamespace))
+	}
 
-	for _, matcher := range in.Matchers {
-		if matcher.Regex {
-			matchRE[matcher.Name] = matcher.Value
-		} else {
-			match[matcher.Name] = matcher.Value
+	// Add muteTimeIntervals to baseConfig.MuteTimeIntervals.
+	for _, mti := range amc.Spec.MuteTimeIntervals {
+		cfg.MuteTimeIntervals = append(cfg.MuteTimeIntervals, convertMuteTimeInterval(&mti, crKey))
+	}
+
+	// Add templates to baseConfig.Templates.
+	for _, tmpl := range amc.Spec.Templates {
+		cfg.Templates = append(cfg.Templates, path.Join(alertmanagerConfigDir, templateFileName(tmpl, crKey)))
+	}
+
+	// Skip early if there's no route definition.
+	if amc.Spec.Route == nil {
+		return nil, nil
+	}
+
+	for _, receiver := range amc.Spec.Receivers {
+		receivers, err := cg.convertReceiver(ctx, &receiver, crKey)
+		if err != nil {
+			return nil, err
 		}
+		cfg.Receivers = append(cfg.Receivers, receivers)
 	}
-	if firstLevelRoute {
-		match["namespace"] = crKey.Namespace
-		delete(matchRE, "namespace")
+
+	return cg.convertRoute(amc.Spec.Route, crKey, true, enforceNamespace), nil
+}
+
+// sortedAlertmanagerConfigs returns the AlertmanagerConfig objects sorted by
+// namespace and name. Processing the objects in the same order always
+// generates the same configuration. Otherwise the generated secret could
+// change on every reconciliation and trigger needless reloads of
+// Alertmanager.
+func sortedAlertmanagerConfigs(amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) []*monitoringv1alpha1.AlertmanagerConfig {
+	keys := sortedAlertmanagerConfigKeys(amConfigs)
+	out := make([]*monitoringv1alpha1.AlertmanagerConfig, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, amConfigs[k])
 	}
 
-	// Set to nil if empty so that it doesn't show up in the resulting yaml.
-	if len(match) == 0 {
-		match = nil
+	return out
+}
+
+// sortedAlertmanagerConfigKeys returns the keys of the AlertmanagerConfig
+// objects sorted by namespace and name.
+func sortedAlertmanagerConfigKeys(amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) []string {
+	keys := make([]string, 0, len(amConfigs))
+	for k := range amConfigs {
+		keys = append(keys, k)
 	}
-	// Set to nil if empty so that it doesn't show up in the resulting yaml.
-	if len(matchRE) == 0 {
-		matchRE = nil
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := amConfigs[keys[i]], amConfigs[keys[j]]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	return keys
+}
+
+// generateGlobalConfig converts the given AlertmanagerConfig object into a
+// base Alertmanager configuration. Its route becomes the top-level route and
+// no namespace matcher is enforced on the routes and inhibition rules.
+func (cg *configGenerator) generateGlobalConfig(ctx context.Context, amConfiguration *monitoringv1.AlertmanagerConfiguration, amConfig *monitoringv1alpha1.AlertmanagerConfig) (*alertmanagerConfig, error) {
+	crKey := types.NamespacedName{
+		Name:      amConfig.Name,
+		Namespace: amConfig.Namespace,
+	}
+
+	if amConfig.Spec.Route == nil {
+		return nil, errors.Errorf("AlertmanagerConfig %s: missing top-level route", crKey.String())
+	}
+
+	cfg := &alertmanagerConfig{
+		Route:     cg.convertRoute(amConfig.Spec.Route, crKey, false, false),
+		Templates: []string{},
+	}
+
+	if amConfiguration != nil && amConfiguration.Global != nil {
+		global, err := cg.convertGlobalConfig(ctx, amConfiguration.Global, crKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid global configuration")
+		}
+		cfg.Global = global
+	}
+
+	for _, inhibitRule := range amConfig.Spec.InhibitRules {
+		cfg.InhibitRules = append(cfg.InhibitRules, cg.convertInhibitRule(&inhibitRule, crKey, false))
+	}
+
+	for _, mti := range amConfig.Spec.MuteTimeIntervals {
+		cfg.MuteTimeIntervals = append(cfg.MuteTimeIntervals, convertMuteTimeInterval(&mti, crKey))
+	}
+
+	for _, tmpl := range amConfig.Spec.Templates {
+		cfg.Templates = append(cfg.Templates, path.Join(alertmanagerConfigDir, templateFileName(tmpl, crKey)))
+	}
+
+	for _, receiver := range amConfig.Spec.Receivers {
+		r, err := cg.convertReceiver(ctx, &receiver, crKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "AlertmanagerConfig %s", crKey.String())
+		}
+		cfg.Receivers = append(cfg.Receivers, r)
+	}
+
+	return cfg, nil
+}
+
+// overrideConfig returns the base configuration overridden by the global
+// configuration generated from the AlertmanagerConfig object:
+// * the global parameters and the top-level route of the override take
+// precedence.
+// * the receivers and mute time intervals of the override replace the base
+// ones with the same name.
+// * the inhibition rules and templates of both configurations are kept.
+func overrideConfig(base, override *alertmanagerConfig) *alertmanagerConfig {
+	out := &alertmanagerConfig{
+		Global:       base.Global,
+		Route:        override.Route,
+		InhibitRules: append(append([]*inhibitRule{}, base.InhibitRules...), override.InhibitRules...),
+		Templates:    append(append([]string{}, base.Templates...), override.Templates...),
+	}
+
+	if override.Global != nil {
+		out.Global = override.Global
+	}
+
+	receivers := make(map[string]struct{}, len(override.Receivers))
+	for _, r := range override.Receivers {
+		receivers[r.Name] = struct{}{}
+	}
+	for _, r := range base.Receivers {
+		if _, found := receivers[r.Name]; !found {
+			out.Receivers = append(out.Receivers, r)
+		}
+	}
+	out.Receivers = append(out.Receivers, override.Receivers...)
+
+	muteTimeIntervals := make(map[string]struct{}, len(override.MuteTimeIntervals))
+	for _, mti := range override.MuteTimeIntervals {
+		muteTimeIntervals[mti.Name] = struct{}{}
+	}
+	for _, mti := range base.MuteTimeIntervals {
+		if _, found := muteTimeIntervals[mti.Name]; !found {
+			out.MuteTimeIntervals = append(out.MuteTimeIntervals, mti)
+		}
+	}
+	out.MuteTimeIntervals = append(out.MuteTimeIntervals, override.MuteTimeIntervals...)
+
+	return out
+}
+
+// convertGlobalConfig converts the global parameters defined in the
+// Alertmanager resource. Secrets are read from the namespace of crKey.
+func (cg *configGenerator) convertGlobalConfig(ctx context.Context, in *monitoringv1.AlertmanagerGlobalConfig, crKey types.NamespacedName) (*globalConfig, error) {
+	out := &globalConfig{
+		ResolveTimeout: model.Duration(5 * time.Minute),
+	}
+
+	if in.ResolveTimeout != "" {
+		resolveTimeout, err := model.ParseDuration(in.ResolveTimeout)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid resolveTimeout")
+		}
+		out.ResolveTimeout = resolveTimeout
+	}
+
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, monitoringv1alpha1.HTTPConfig(*in.HTTPConfig), crKey)
+		if err != nil {
+			return nil, err
+		}
+		out.HTTPConfig = httpConfig
+	}
+
+	if in.SMTPConfig != nil {
+		if err := cg.convertGlobalSMTPConfig(ctx, out, in.SMTPConfig, crKey); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.SlackAPIURL != nil {
+		slackAPIURL, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.SlackAPIURL)
+		if err != nil {
+			return nil, errors.Errorf("failed to get Slack API URL key %q from secret %q", in.SlackAPIURL.Key, in.SlackAPIURL.Name)
+		}
+
+		u, err := url.Parse(strings.TrimSpace(slackAPIURL))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid Slack API URL")
+		}
+		out.SlackAPIURL = &config.URL{URL: u}
+	}
+
+	if in.OpsGenieAPIKey != nil {
+		opsGenieAPIKey, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.OpsGenieAPIKey)
+		if err != nil {
+			return nil, errors.Errorf("failed to get OpsGenie API key %q from secret %q", in.OpsGenieAPIKey.Key, in.OpsGenieAPIKey.Name)
+		}
+		out.OpsGenieAPIKey = opsGenieAPIKey
+	}
+
+	if in.PagerdutyURL != "" {
+		u, err := url.Parse(in.PagerdutyURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid Pagerduty URL")
+		}
+		out.PagerdutyURL = &config.URL{URL: u}
+	}
+
+	return out, nil
+}
+
+func (cg *configGenerator) convertGlobalSMTPConfig(ctx context.Context, out *globalConfig, in *monitoringv1.GlobalSMTPConfig, crKey types.NamespacedName) error {
+	out.SMTPFrom = in.From
+	out.SMTPHello = in.Hello
+	out.SMTPAuthUsername = in.AuthUsername
+	out.SMTPAuthIdentity = in.AuthIdentity
+	out.SMTPRequireTLS = in.RequireTLS
+
+	if in.SmartHost != nil {
+		out.SMTPSmarthost = config.HostPort{
+			Host: in.SmartHost.Host,
+			Port: in.SmartHost.Port,
+		}
+	}
+
+	if in.AuthPassword != nil {
+		authPassword, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthPassword)
+		if err != nil {
+			return errors.Errorf("failed to get SMTP auth password key %q from secret %q", in.AuthPassword.Key, in.AuthPassword.Name)
+		}
+		out.SMTPAuthPassword = authPassword
+	}
+
+	if in.AuthSecret != nil {
+		authSecret, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthSecret)
+		if err != nil {
+			return errors.Errorf("failed to get SMTP auth secret key %q from secret %q", in.AuthSecret.Key, in.AuthSecret.Name)
+		}
+		out.SMTPAuthSecret = authSecret
+	}
+
+	return nil
+}
+
+// convertRoute converts the given route and its children. The namespace
+// matcher is only enforced on the first-level route when enforceNamespace is
+// true.
+func (cg *configGenerator) convertRoute(in *monitoringv1alpha1.Route, crKey types.NamespacedName, firstLevelRoute, enforceNamespace bool) *route {
+	// Enforce "continue" to be true for the top-level route.
+	cont := in.Continue
+	if firstLevelRoute {
+		cont = true
+	}
+
+	var (
+		matchers       []string
+		match, matchRE map[string]string
+	)
+	if cg.amVersion.GTE(semver.MustParse("0.22.0")) {
+		matchers = convertMatchers(in.Matchers, crKey, firstLevelRoute && enforceNamespace)
+	} else {
+		match, matchRE = convertLegacyMatchers(in.Matchers, crKey, firstLevelRoute && enforceNamespace)
 	}
 
 	var routes []*route
@@ -163,32 +588,51 @@ func convertRoute(in *monitoringv1alpha1.Route, crKey types.NamespacedName, firs
 			panic(err)
 		}
 		for i := range children {
-			routes[i] = convertRoute(&children[i], crKey, false)
+			routes[i] = cg.convertRoute(&children[i], crKey, false, false)
 		}
 	}
 
-	receiver := prefixReceiverName(in.Receiver, crKey)
+	receiver := makeNamespacedString(in.Receiver, crKey)
+
+	var muteTimeIntervals []string
+	for _, mti := range in.MuteTimeIntervals {
+		muteTimeIntervals = append(muteTimeIntervals, makeNamespacedString(mti, crKey))
+	}
+
+	var activeTimeIntervals []string
+	for _, ati := range in.ActiveTimeIntervals {
+		activeTimeIntervals = append(activeTimeIntervals, makeNamespacedString(ati, crKey))
+	}
 
 	return &route{
-		Receiver:       receiver,
-		GroupByStr:     in.GroupBy,
-		GroupWait:      in.GroupWait,
-		GroupInterval:  in.GroupInterval,
-		RepeatInterval: in.RepeatInterval,
-		Continue:       cont,
-		Match:          match,
-		MatchRE:        matchRE,
-		Routes:         routes,
+		Receiver:            receiver,
+		GroupByStr:          in.GroupBy,
+		GroupWait:           in.GroupWait,
+		GroupInterval:       in.GroupInterval,
+		RepeatInterval:      in.RepeatInterval,
+		Continue:            cont,
+		Match:               match,
+		MatchRE:             matchRE,
+		Matchers:            matchers,
+		Routes:              routes,
+		MuteTimeIntervals:   muteTimeIntervals,
+		ActiveTimeIntervals: activeTimeIntervals,
 	}
 }
 
 func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1alpha1.Receiver, crKey types.NamespacedName) (*receiver, error) {
+	// The Secrets referenced by the receiver may live in another namespace.
+	secretKey := types.NamespacedName{
+		Name:      crKey.Name,
+		Namespace: receiverSecretNamespace(crKey.Namespace, in),
+	}
+
 	var pagerdutyConfigs []*pagerdutyConfig
 
 	if l := len(in.PagerDutyConfigs); l > 0 {
 		pagerdutyConfigs = make([]*pagerdutyConfig, l)
 		for i := range in.PagerDutyConfigs {
-			receiver, err := cg.convertPagerdutyConfig(ctx, in.PagerDutyConfigs[i], crKey)
+			receiver, err := cg.convertPagerdutyConfig(ctx, in.PagerDutyConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "PagerDutyConfig[%d]", i)
 			}
@@ -200,7 +644,7 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 	if l := len(in.SlackConfigs); l > 0 {
 		slackConfigs = make([]*slackConfig, l)
 		for i := range in.SlackConfigs {
-			receiver, err := cg.convertSlackConfig(ctx, in.SlackConfigs[i], crKey)
+			receiver, err := cg.convertSlackConfig(ctx, in.SlackConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "SlackConfig[%d]", i)
 			}
@@ -212,7 +656,7 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 	if l := len(in.WebhookConfigs); l > 0 {
 		webhookConfigs = make([]*webhookConfig, l)
 		for i := range in.WebhookConfigs {
-			receiver, err := cg.convertWebhookConfig(ctx, in.WebhookConfigs[i], crKey)
+			receiver, err := cg.convertWebhookConfig(ctx, in.WebhookConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "WebhookConfig[%d]", i)
 			}
@@ -224,7 +668,7 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 	if l := len(in.OpsGenieConfigs); l > 0 {
 		opsgenieConfigs = make([]*opsgenieConfig, l)
 		for i := range in.OpsGenieConfigs {
-			receiver, err := cg.convertOpsgenieConfig(ctx, in.OpsGenieConfigs[i], crKey)
+			receiver, err := cg.convertOpsgenieConfig(ctx, in.OpsGenieConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "OpsGenieConfigs[%d]", i)
 			}
@@ -236,7 +680,7 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 	if l := len(in.WeChatConfigs); l > 0 {
 		weChatConfigs = make([]*weChatConfig, l)
 		for i := range in.WeChatConfigs {
-			receiver, err := cg.convertWeChatConfig(ctx, in.WeChatConfigs[i], crKey)
+			receiver, err := cg.convertWeChatConfig(ctx, in.WeChatConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "WeChatConfig[%d]", i)
 			}
@@ -248,7 +692,7 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 	if l := len(in.EmailConfigs); l > 0 {
 		emailConfigs = make([]*emailConfig, l)
 		for i := range in.EmailConfigs {
-			receiver, err := cg.convertEmailConfig(ctx, in.EmailConfigs[i], crKey)
+			receiver, err := cg.convertEmailConfig(ctx, in.EmailConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "EmailConfig[%d]", i)
 			}
@@ -260,7 +704,7 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 	if l := len(in.VictorOpsConfigs); l > 0 {
 		victorOpsConfigs = make([]*victorOpsConfig, l)
 		for i := range in.VictorOpsConfigs {
-			receiver, err := cg.convertVictorOpsConfig(ctx, in.VictorOpsConfigs[i], crKey)
+			receiver, err := cg.convertVictorOpsConfig(ctx, in.VictorOpsConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "VictorOpsConfig[%d]", i)
 			}
@@ -272,7 +716,7 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 	if l := len(in.PushoverConfigs); l > 0 {
 		pushoverConfigs = make([]*pushoverConfig, l)
 		for i := range in.PushoverConfigs {
-			receiver, err := cg.convertPushoverConfig(ctx, in.PushoverConfigs[i], crKey)
+			receiver, err := cg.convertPushoverConfig(ctx, in.PushoverConfigs[i], secretKey)
 			if err != nil {
 				return nil, errors.Wrapf(err, "PushoverConfig[%d]", i)
 			}
@@ -280,22 +724,122 @@ func (cg *configGenerator) convertReceiver(ctx context.Context, in *monitoringv1
 		}
 	}
 
+	var snsConfigs []*snsConfig
+	if l := len(in.SNSConfigs); l > 0 {
+		snsConfigs = make([]*snsConfig, l)
+		for i := range in.SNSConfigs {
+			receiver, err := cg.convertSNSConfig(ctx, in.SNSConfigs[i], secretKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "SNSConfig[%d]", i)
+			}
+			snsConfigs[i] = receiver
+		}
+	}
+
+	var telegramConfigs []*telegramConfig
+	if l := len(in.TelegramConfigs); l > 0 {
+		telegramConfigs = make([]*telegramConfig, l)
+		for i := range in.TelegramConfigs {
+			receiver, err := cg.convertTelegramConfig(ctx, in.TelegramConfigs[i], secretKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "TelegramConfig[%d]", i)
+			}
+			telegramConfigs[i] = receiver
+		}
+	}
+
+	var discordConfigs []*discordConfig
+	if l := len(in.DiscordConfigs); l > 0 {
+		discordConfigs = make([]*discordConfig, l)
+		for i := range in.DiscordConfigs {
+			receiver, err := cg.convertDiscordConfig(ctx, in.DiscordConfigs[i], secretKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "DiscordConfig[%d]", i)
+			}
+			discordConfigs[i] = receiver
+		}
+	}
+
+	var msTeamsConfigs []*msTeamsConfig
+	if l := len(in.MSTeamsConfigs); l > 0 {
+		msTeamsConfigs = make([]*msTeamsConfig, l)
+		for i := range in.MSTeamsConfigs {
+			receiver, err := cg.convertMSTeamsConfig(ctx, in.MSTeamsConfigs[i], secretKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "MSTeamsConfig[%d]", i)
+			}
+			msTeamsConfigs[i] = receiver
+		}
+	}
+
+	var msTeamsV2Configs []*msTeamsV2Config
+	if l := len(in.MSTeamsV2Configs); l > 0 {
+		msTeamsV2Configs = make([]*msTeamsV2Config, l)
+		for i := range in.MSTeamsV2Configs {
+			receiver, err := cg.convertMSTeamsV2Config(ctx, in.MSTeamsV2Configs[i], secretKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "MSTeamsV2Config[%d]", i)
+			}
+			msTeamsV2Configs[i] = receiver
+		}
+	}
+
+	var rocketChatConfigs []*rocketChatConfig
+	if l := len(in.RocketChatConfigs); l > 0 {
+		rocketChatConfigs = make([]*rocketChatConfig, l)
+		for i := range in.RocketChatConfigs {
+			receiver, err := cg.convertRocketChatConfig(ctx, in.RocketChatConfigs[i], secretKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "RocketChatConfig[%d]", i)
+			}
+			rocketChatConfigs[i] = receiver
+		}
+	}
+
+	var jiraConfigs []*jiraConfig
+	if l := len(in.JiraConfigs); l > 0 {
+		jiraConfigs = make([]*jiraConfig, l)
+		for i := range in.JiraConfigs {
+			receiver, err := cg.convertJiraConfig(ctx, in.JiraConfigs[i], secretKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "JiraConfig[%d]", i)
+			}
+			jiraConfigs[i] = receiver
+		}
+	}
+
 	return &receiver{
-		Name:             prefixReceiverName(in.Name, crKey),
-		OpsgenieConfigs:  opsgenieConfigs,
-		PagerdutyConfigs: pagerdutyConfigs,
-		SlackConfigs:     slackConfigs,
-		WebhookConfigs:   webhookConfigs,
-		WeChatConfigs:    weChatConfigs,
-		EmailConfigs:     emailConfigs,
-		VictorOpsConfigs: victorOpsConfigs,
-		PushoverConfigs:  pushoverConfigs,
+		Name:              makeNamespacedString(in.Name, crKey),
+		OpsgenieConfigs:   opsgenieConfigs,
+		PagerdutyConfigs:  pagerdutyConfigs,
+		SlackConfigs:      slackConfigs,
+		WebhookConfigs:    webhookConfigs,
+		WeChatConfigs:     weChatConfigs,
+		EmailConfigs:      emailConfigs,
+		VictorOpsConfigs:  victorOpsConfigs,
+		PushoverConfigs:   pushoverConfigs,
+		SNSConfigs:        snsConfigs,
+		TelegramConfigs:   telegramConfigs,
+		DiscordConfigs:    discordConfigs,
+		MSTeamsConfigs:    msTeamsConfigs,
+		MSTeamsV2Configs:  msTeamsV2Configs,
+		RocketChatConfigs: rocketChatConfigs,
+		JiraConfigs:       jiraConfigs,
 	}, nil
 }
 
+// receiverSecretNamespace returns the namespace of the Secrets referenced by
+// the receiver.
+func receiverSecretNamespace(namespace string, in *monitoringv1alpha1.Receiver) string {
+	if in.SecretNamespace != "" {
+		return in.SecretNamespace
+	}
+	return namespace
+}
+
 func (cg *configGenerator) convertWebhookConfig(ctx context.Context, in monitoringv1alpha1.WebhookConfig, crKey types.NamespacedName) (*webhookConfig, error) {
 	out := &webhookConfig{
-		VSendResolved: in.SendResolved,
+		VSendResolved: cg.sendResolved(in.SendResolved),
 	}
 
 	if in.URLSecret != nil {
@@ -320,12 +864,24 @@ func (cg *configGenerator) convertWebhookConfig(ctx context.Context, in monitori
 		out.MaxAlerts = in.MaxAlerts
 	}
 
+	if in.Timeout != "" {
+		if cg.amVersion.LT(semver.MustParse("0.28.0")) {
+			level.Warn(cg.logger).Log(
+				"msg", "ignoring webhook timeout since it requires Alertmanager >= 0.28.0",
+				"alertmanagerconfig", crKey.String(),
+				"version", cg.amVersion.String(),
+			)
+		} else {
+			out.Timeout = in.Timeout
+		}
+	}
+
 	return out, nil
 }
 
 func (cg *configGenerator) convertSlackConfig(ctx context.Context, in monitoringv1alpha1.SlackConfig, crKey types.NamespacedName) (*slackConfig, error) {
 	out := &slackConfig{
-		VSendResolved: in.SendResolved,
+		VSendResolved: cg.sendResolved(in.SendResolved),
 		Channel:       in.Channel,
 		Username:      in.Username,
 		Color:         in.Color,
@@ -350,7 +906,11 @@ func (cg *configGenerator) convertSlackConfig(ctx context.Context, in monitoring
 		if err != nil {
 			return nil, errors.Errorf("failed to get key %q from secret %q", in.APIURL.Key, in.APIURL.Name)
 		}
-		out.APIURL = url
+		if f := cg.credentialFile(*in.APIURL, url, "api_url", "0.22.0", crKey); f != "" {
+			out.APIURLFile = f
+		} else {
+			out.APIURL = url
+		}
 	}
 
 	var actions []slackAction
@@ -410,7 +970,7 @@ func (cg *configGenerator) convertSlackConfig(ctx context.Context, in monitoring
 
 func (cg *configGenerator) convertPagerdutyConfig(ctx context.Context, in monitoringv1alpha1.PagerDutyConfig, crKey types.NamespacedName) (*pagerdutyConfig, error) {
 	out := &pagerdutyConfig{
-		VSendResolved: in.SendResolved,
+		VSendResolved: cg.sendResolved(in.SendResolved),
 		Class:         in.Class,
 		Client:        in.Client,
 		ClientURL:     in.ClientURL,
@@ -426,7 +986,11 @@ func (cg *configGenerator) convertPagerdutyConfig(ctx context.Context, in monito
 		if err != nil {
 			return nil, errors.Errorf("failed to get routing key %q from secret %q", in.RoutingKey.Key, in.RoutingKey.Name)
 		}
-		out.RoutingKey = routingKey
+		if f := cg.credentialFile(*in.RoutingKey, routingKey, "routing_key", "0.25.0", crKey); f != "" {
+			out.RoutingKeyFile = f
+		} else {
+			out.RoutingKey = routingKey
+		}
 	}
 
 	if in.ServiceKey != nil {
@@ -434,7 +998,11 @@ func (cg *configGenerator) convertPagerdutyConfig(ctx context.Context, in monito
 		if err != nil {
 			return nil, errors.Errorf("failed to get service key %q from secret %q", in.ServiceKey.Key, in.ServiceKey.Name)
 		}
-		out.ServiceKey = serviceKey
+		if f := cg.credentialFile(*in.ServiceKey, serviceKey, "service_key", "0.25.0", crKey); f != "" {
+			out.ServiceKeyFile = f
+		} else {
+			out.ServiceKey = serviceKey
+		}
 	}
 
 	var details map[string]string
@@ -444,7 +1012,32 @@ func (cg *configGenerator) convertPagerdutyConfig(ctx context.Context, in monito
 			details[d.Key] = d.Value
 		}
 	}
-	out.Details = details
+	out.Details = details
+
+	var images []pagerdutyImage
+	if l := len(in.PagerDutyImageConfigs); l > 0 {
+		images = make([]pagerdutyImage, l)
+		for i, img := range in.PagerDutyImageConfigs {
+			images[i] = pagerdutyImage{
+				Src:  img.Src,
+				Alt:  img.Alt,
+				Href: img.Href,
+			}
+		}
+	}
+	out.Images = images
+
+	var links []pagerdutyLink
+	if l := len(in.PagerDutyLinkConfigs); l > 0 {
+		links = make([]pagerdutyLink, l)
+		for i, link := range in.PagerDutyLinkConfigs {
+			links[i] = pagerdutyLink{
+				Href: link.Href,
+				Text: link.Text,
+			}
+		}
+	}
+	out.Links = links
 
 	if in.HTTPConfig != nil {
 		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
@@ -459,7 +1052,7 @@ func (cg *configGenerator) convertPagerdutyConfig(ctx context.Context, in monito
 
 func (cg *configGenerator) convertOpsgenieConfig(ctx context.Context, in monitoringv1alpha1.OpsGenieConfig, crKey types.NamespacedName) (*opsgenieConfig, error) {
 	out := &opsgenieConfig{
-		VSendResolved: in.SendResolved,
+		VSendResolved: cg.sendResolved(in.SendResolved),
 		APIURL:        in.APIURL,
 		Message:       in.Message,
 		Description:   in.Description,
@@ -474,7 +1067,11 @@ func (cg *configGenerator) convertOpsgenieConfig(ctx context.Context, in monitor
 		if err != nil {
 			return nil, errors.Errorf("failed to get api key %q from secret %q", in.APIKey.Key, in.APIKey.Name)
 		}
-		out.APIKey = apiKey
+		if f := cg.credentialFile(*in.APIKey, apiKey, "api_key", "0.24.0", crKey); f != "" {
+			out.APIKeyFile = f
+		} else {
+			out.APIKey = apiKey
+		}
 	}
 
 	var details map[string]string
@@ -515,7 +1112,7 @@ func (cg *configGenerator) convertOpsgenieConfig(ctx context.Context, in monitor
 func (cg *configGenerator) convertWeChatConfig(ctx context.Context, in monitoringv1alpha1.WeChatConfig, crKey types.NamespacedName) (*weChatConfig, error) {
 
 	out := &weChatConfig{
-		VSendResolved: in.SendResolved,
+		VSendResolved: cg.sendResolved(in.SendResolved),
 		APIURL:        in.APIURL,
 		CorpID:        in.CorpID,
 		AgentID:       in.AgentID,
@@ -529,7 +1126,7 @@ func (cg *configGenerator) convertWeChatConfig(ctx context.Context, in monitorin
 	if in.APISecret != nil {
 		apiSecret, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.APISecret)
 		if err != nil {
-			return nil, errors.Errorf("failed to get secret %q", in.APISecret)
+			return nil, errors.Errorf("failed to get api secret key %q from secret %q", in.APISecret.Key, in.APISecret.Name)
 		}
 		out.APISecret = apiSecret
 	}
@@ -547,7 +1144,7 @@ func (cg *configGenerator) convertWeChatConfig(ctx context.Context, in monitorin
 
 func (cg *configGenerator) convertEmailConfig(ctx context.Context, in monitoringv1alpha1.EmailConfig, crKey types.NamespacedName) (*emailConfig, error) {
 	out := &emailConfig{
-		VSendResolved: in.SendResolved,
+		VSendResolved: cg.sendResolved(in.SendResolved),
 		To:            in.To,
 		From:          in.From,
 		Hello:         in.Hello,
@@ -574,15 +1171,19 @@ func (cg *configGenerator) convertEmailConfig(ctx context.Context, in monitoring
 	if in.AuthPassword != nil {
 		authPassword, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthPassword)
 		if err != nil {
-			return nil, errors.Errorf("failed to get secret %q", in.AuthPassword)
+			return nil, errors.Errorf("failed to get auth password key %q from secret %q", in.AuthPassword.Key, in.AuthPassword.Name)
+		}
+		if f := cg.credentialFile(*in.AuthPassword, authPassword, "auth_password", "0.25.0", crKey); f != "" {
+			out.AuthPasswordFile = f
+		} else {
+			out.AuthPassword = authPassword
 		}
-		out.AuthPassword = authPassword
 	}
 
 	if in.AuthSecret != nil {
 		authSecret, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthSecret)
 		if err != nil {
-			return nil, errors.Errorf("failed to get secret %q", in.AuthSecret)
+			return nil, errors.Errorf("failed to get auth secret key %q from secret %q", in.AuthSecret.Key, in.AuthSecret.Name)
 		}
 		out.AuthSecret = authSecret
 	}
@@ -591,9 +1192,8 @@ func (cg *configGenerator) convertEmailConfig(ctx context.Context, in monitoring
 	if l := len(in.Headers); l > 0 {
 		headers = make(map[string]string, l)
 
-		var key string
 		for _, d := range in.Headers {
-			key = strings.Title(key)
+			key := strings.Title(d.Key)
 			if _, ok := headers[key]; ok {
 				return nil, errors.Errorf("duplicate header %q in email config", key)
 			}
@@ -611,7 +1211,7 @@ func (cg *configGenerator) convertEmailConfig(ctx context.Context, in monitoring
 
 func (cg *configGenerator) convertVictorOpsConfig(ctx context.Context, in monitoringv1alpha1.VictorOpsConfig, crKey types.NamespacedName) (*victorOpsConfig, error) {
 	out := &victorOpsConfig{
-		VSendResolved:     in.SendResolved,
+		VSendResolved:     cg.sendResolved(in.SendResolved),
 		APIURL:            in.APIURL,
 		RoutingKey:        in.RoutingKey,
 		MessageType:       in.MessageType,
@@ -623,9 +1223,13 @@ func (cg *configGenerator) convertVictorOpsConfig(ctx context.Context, in monito
 	if in.APIKey != nil {
 		apiKey, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.APIKey)
 		if err != nil {
-			return nil, errors.Errorf("failed to get secret %q", in.APIKey)
+			return nil, errors.Errorf("failed to get api key %q from secret %q", in.APIKey.Key, in.APIKey.Name)
+		}
+		if f := cg.credentialFile(*in.APIKey, apiKey, "api_key", "0.25.0", crKey); f != "" {
+			out.APIKeyFile = f
+		} else {
+			out.APIKey = apiKey
 		}
-		out.APIKey = apiKey
 	}
 	if in.RoutingKey == "" {
 		return nil, errors.New("missing Routing key in VictorOps config")
@@ -665,11 +1269,12 @@ func (cg *configGenerator) convertVictorOpsConfig(ctx context.Context, in monito
 
 func (cg *configGenerator) convertPushoverConfig(ctx context.Context, in monitoringv1alpha1.PushoverConfig, crKey types.NamespacedName) (*pushoverConfig, error) {
 	out := &pushoverConfig{
-		VSendResolved: in.SendResolved,
+		VSendResolved: cg.sendResolved(in.SendResolved),
 		Title:         in.Title,
 		Message:       in.Message,
 		URL:           in.URL,
 		URLTitle:      in.URLTitle,
+		Sound:         in.Sound,
 		Priority:      in.Priority,
 		HTML:          in.HTML,
 	}
@@ -680,7 +1285,7 @@ func (cg *configGenerator) convertPushoverConfig(ctx context.Context, in monitor
 		}
 		userKey, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.UserKey)
 		if err != nil {
-			return nil, errors.Errorf("failed to get secret %q", in.UserKey)
+			return nil, errors.Errorf("failed to get user key %q from secret %q", in.UserKey.Key, in.UserKey.Name)
 		}
 		if userKey == "" {
 			return nil, errors.Errorf("mandatory field %q is empty", "userKey")
@@ -694,7 +1299,7 @@ func (cg *configGenerator) convertPushoverConfig(ctx context.Context, in monitor
 		}
 		token, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.Token)
 		if err != nil {
-			return nil, errors.Errorf("failed to get secret %q", in.Token)
+			return nil, errors.Errorf("failed to get token key %q from secret %q", in.Token.Key, in.Token.Name)
 		}
 		if token == "" {
 			return nil, errors.Errorf("mandatory field %q is empty", "token")
@@ -705,7 +1310,7 @@ func (cg *configGenerator) convertPushoverConfig(ctx context.Context, in monitor
 	if in.Retry != "" {
 		retry, err := time.ParseDuration(in.Retry)
 		if err != nil {
-			return nil, errors.Errorf("failed to parse Retry duration: %s", err)
+			return nil, errors.Wrap(err, "failed to parse retry duration")
 		}
 		out.Retry = duration(retry)
 	}
@@ -713,7 +1318,7 @@ func (cg *configGenerator) convertPushoverConfig(ctx context.Context, in monitor
 	if in.Expire != "" {
 		expire, err := time.ParseDuration(in.Expire)
 		if err != nil {
-			return nil, errors.Errorf("failed to parse Expire duration: %s", err)
+			return nil, errors.Wrap(err, "failed to parse expire duration")
 		}
 		out.Expire = duration(expire)
 	}
@@ -729,48 +1334,276 @@ func (cg *configGenerator) convertPushoverConfig(ctx context.Context, in monitor
 	return out, nil
 }
 
-func convertInhibitRule(in *monitoringv1alpha1.InhibitRule, crKey types.NamespacedName) *inhibitRule {
-	sourceMatch := map[string]string{}
-	sourceMatchRE := map[string]string{}
-	for _, sm := range in.SourceMatch {
-		if sm.Regex {
-			sourceMatchRE[sm.Name] = sm.Value
-		} else {
-			sourceMatch[sm.Name] = sm.Value
+func (cg *configGenerator) convertSNSConfig(ctx context.Context, in monitoringv1alpha1.SNSConfig, crKey types.NamespacedName) (*snsConfig, error) {
+	out := &snsConfig{
+		VSendResolved: cg.sendResolved(in.SendResolved),
+		APIUrl:        in.APIURL,
+		TopicARN:      in.TopicARN,
+		PhoneNumber:   in.PhoneNumber,
+		TargetARN:     in.TargetARN,
+		Subject:       in.Subject,
+		Message:       in.Message,
+		Attributes:    in.Attributes,
+	}
+
+	if in.Sigv4 != nil {
+		out.Sigv4 = &sigV4Config{
+			Region:  in.Sigv4.Region,
+			Profile: in.Sigv4.Profile,
+			RoleARN: in.Sigv4.RoleArn,
+		}
+
+		if in.Sigv4.AccessKey != nil {
+			accessKey, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.Sigv4.AccessKey)
+			if err != nil {
+				return nil, errors.Errorf("failed to get access key %q from secret %q", in.Sigv4.AccessKey.Key, in.Sigv4.AccessKey.Name)
+			}
+			out.Sigv4.AccessKey = accessKey
 		}
+
+		if in.Sigv4.SecretKey != nil {
+			secretKey, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.Sigv4.SecretKey)
+			if err != nil {
+				return nil, errors.Errorf("failed to get secret key %q from secret %q", in.Sigv4.SecretKey.Key, in.Sigv4.SecretKey.Name)
+			}
+			out.Sigv4.SecretKey = secretKey
+		}
+	}
+
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
+		if err != nil {
+			return nil, err
+		}
+		out.HTTPConfig = httpConfig
 	}
 
-	sourceMatch["namespace"] = crKey.Namespace
-	delete(sourceMatchRE, "namespace")
+	return out, nil
+}
 
-	// Set to nil if empty so that it doesn't show up in resulting yaml
-	if len(sourceMatchRE) == 0 {
-		sourceMatchRE = nil
+func (cg *configGenerator) convertTelegramConfig(ctx context.Context, in monitoringv1alpha1.TelegramConfig, crKey types.NamespacedName) (*telegramConfig, error) {
+	out := &telegramConfig{
+		VSendResolved: cg.sendResolved(in.SendResolved),
+		APIUrl:        in.APIURL,
+		ChatID:        in.ChatID,
+		Message:       in.Message,
+		ParseMode:     in.ParseMode,
 	}
 
-	targetMatch := map[string]string{}
-	targetMatchRE := map[string]string{}
-	for _, tm := range in.TargetMatch {
-		if tm.Regex {
-			targetMatchRE[tm.Name] = tm.Value
-		} else {
-			targetMatch[tm.Name] = tm.Value
+	if in.DisableNotifications != nil {
+		out.DisableNotifications = *in.DisableNotifications
+	}
+
+	if in.BotToken != nil {
+		botToken, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.BotToken)
+		if err != nil {
+			return nil, errors.Errorf("failed to get bot token key %q from secret %q", in.BotToken.Key, in.BotToken.Name)
+		}
+		out.BotToken = botToken
+	}
+
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
+		if err != nil {
+			return nil, err
+		}
+		out.HTTPConfig = httpConfig
+	}
+
+	return out, nil
+}
+
+func (cg *configGenerator) convertDiscordConfig(ctx context.Context, in monitoringv1alpha1.DiscordConfig, crKey types.NamespacedName) (*discordConfig, error) {
+	out := &discordConfig{
+		VSendResolved: cg.sendResolved(in.SendResolved),
+		Title:         in.Title,
+		Message:       in.Message,
+	}
+
+	webhookURL, err := cg.store.GetSecretKey(ctx, crKey.Namespace, in.APIURL)
+	if err != nil {
+		return nil, errors.Errorf("failed to get webhook URL key %q from secret %q", in.APIURL.Key, in.APIURL.Name)
+	}
+	out.WebhookURL = webhookURL
+
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
+		if err != nil {
+			return nil, err
+		}
+		out.HTTPConfig = httpConfig
+	}
+
+	return out, nil
+}
+
+func (cg *configGenerator) convertMSTeamsConfig(ctx context.Context, in monitoringv1alpha1.MSTeamsConfig, crKey types.NamespacedName) (*msTeamsConfig, error) {
+	out := &msTeamsConfig{
+		VSendResolved: cg.sendResolved(in.SendResolved),
+		Title:         in.Title,
+		Summary:       in.Summary,
+		Text:          in.Text,
+	}
+
+	webhookURL, err := cg.store.GetSecretKey(ctx, crKey.Namespace, in.WebhookURL)
+	if err != nil {
+		return nil, errors.Errorf("failed to get webhook URL key %q from secret %q", in.WebhookURL.Key, in.WebhookURL.Name)
+	}
+	out.WebhookURL = webhookURL
+
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
+		if err != nil {
+			return nil, err
+		}
+		out.HTTPConfig = httpConfig
+	}
+
+	return out, nil
+}
+
+func (cg *configGenerator) convertMSTeamsV2Config(ctx context.Context, in monitoringv1alpha1.MSTeamsV2Config, crKey types.NamespacedName) (*msTeamsV2Config, error) {
+	out := &msTeamsV2Config{
+		VSendResolved: cg.sendResolved(in.SendResolved),
+		Title:         in.Title,
+		Text:          in.Text,
+	}
+
+	webhookURL, err := cg.store.GetSecretKey(ctx, crKey.Namespace, in.WebhookURL)
+	if err != nil {
+		return nil, errors.Errorf("failed to get webhook URL key %q from secret %q", in.WebhookURL.Key, in.WebhookURL.Name)
+	}
+	out.WebhookURL = webhookURL
+
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
+		if err != nil {
+			return nil, err
+		}
+		out.HTTPConfig = httpConfig
+	}
+
+	return out, nil
+}
+
+func (cg *configGenerator) convertRocketChatConfig(ctx context.Context, in monitoringv1alpha1.RocketChatConfig, crKey types.NamespacedName) (*rocketChatConfig, error) {
+	out := &rocketChatConfig{
+		VSendResolved: cg.sendResolved(in.SendResolved),
+		Channel:       in.Channel,
+		Color:         in.Color,
+		Emoji:         in.Emoji,
+		IconURL:       in.IconURL,
+		Text:          in.Text,
+		Title:         in.Title,
+		TitleLink:     in.TitleLink,
+		ShortFields:   in.ShortFields,
+		ImageURL:      in.ImageURL,
+		ThumbURL:      in.ThumbURL,
+		LinkNames:     in.LinkNames,
+	}
+
+	if in.APIURL != nil {
+		apiURL, err := cg.store.GetSecretKey(ctx, crKey.Namespace, *in.APIURL)
+		if err != nil {
+			return nil, errors.Errorf("failed to get API URL key %q from secret %q", in.APIURL.Key, in.APIURL.Name)
+		}
+		out.APIURL = apiURL
+	}
+
+	token, err := cg.store.GetSecretKey(ctx, crKey.Namespace, in.Token)
+	if err != nil {
+		return nil, errors.Errorf("failed to get token key %q from secret %q", in.Token.Key, in.Token.Name)
+	}
+	if f := cg.credentialFile(in.Token, token, "token", "0.28.0", crKey); f != "" {
+		out.TokenFile = f
+	} else {
+		out.Token = token
+	}
+
+	tokenID, err := cg.store.GetSecretKey(ctx, crKey.Namespace, in.TokenID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get token ID key %q from secret %q", in.TokenID.Key, in.TokenID.Name)
+	}
+	if f := cg.credentialFile(in.TokenID, tokenID, "token_id", "0.28.0", crKey); f != "" {
+		out.TokenIDFile = f
+	} else {
+		out.TokenID = tokenID
+	}
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, &rocketChatField{
+			Title: f.Title,
+			Value: f.Value,
+			Short: f.Short,
+		})
+	}
+
+	for _, a := range in.Actions {
+		out.Actions = append(out.Actions, &rocketChatAction{
+			Text: a.Text,
+			URL:  a.URL,
+			Msg:  a.Msg,
+		})
+	}
+
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
+		if err != nil {
+			return nil, err
 		}
+		out.HTTPConfig = httpConfig
 	}
 
-	targetMatch["namespace"] = crKey.Namespace
-	delete(targetMatchRE, "namespace")
+	return out, nil
+}
+
+func (cg *configGenerator) convertJiraConfig(ctx context.Context, in monitoringv1alpha1.JiraConfig, crKey types.NamespacedName) (*jiraConfig, error) {
+	out := &jiraConfig{
+		VSendResolved:     cg.sendResolved(in.SendResolved),
+		APIURL:            in.APIURL,
+		Project:           in.Project,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		IssueType:         in.IssueType,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+	}
 
-	// Set to nil if empty so that it doesn't show up in resulting yaml
-	if len(targetMatchRE) == 0 {
-		targetMatchRE = nil
+	if in.HTTPConfig != nil {
+		httpConfig, err := cg.convertHTTPConfig(ctx, *in.HTTPConfig, crKey)
+		if err != nil {
+			return nil, err
+		}
+		out.HTTPConfig = httpConfig
 	}
 
+	return out, nil
+}
+
+// convertInhibitRule converts the given inhibition rule. When
+// enforceNamespace is true, the source and target matchers are restricted to
+// the namespace of the AlertmanagerConfig object.
+func (cg *configGenerator) convertInhibitRule(in *monitoringv1alpha1.InhibitRule, crKey types.NamespacedName, enforceNamespace bool) *inhibitRule {
 	equal := in.Equal
 	if len(equal) == 0 {
 		equal = nil
 	}
 
+	if cg.amVersion.GTE(semver.MustParse("0.22.0")) {
+		return &inhibitRule{
+			SourceMatchers: convertMatchers(in.SourceMatch, crKey, enforceNamespace),
+			TargetMatchers: convertMatchers(in.TargetMatch, crKey, enforceNamespace),
+			Equal:          equal,
+		}
+	}
+
+	sourceMatch, sourceMatchRE := convertLegacyMatchers(in.SourceMatch, crKey, enforceNamespace)
+	targetMatch, targetMatchRE := convertLegacyMatchers(in.TargetMatch, crKey, enforceNamespace)
+
 	return &inhibitRule{
 		SourceMatch:   sourceMatch,
 		SourceMatchRE: sourceMatchRE,
@@ -780,11 +1613,200 @@ func convertInhibitRule(in *monitoringv1alpha1.InhibitRule, crKey types.Namespac
 	}
 }
 
-func prefixReceiverName(receiverName string, crKey types.NamespacedName) string {
-	if receiverName == "" {
+// convertLegacyMatchers returns the equality and regexp matchers using the
+// match/match_re syntax (Alertmanager < 0.22.0). When enforceNamespace is
+// true, any matcher on the namespace label is replaced by an equality matcher
+// on the namespace of the AlertmanagerConfig object.
+func convertLegacyMatchers(in []monitoringv1alpha1.Matcher, crKey types.NamespacedName, enforceNamespace bool) (map[string]string, map[string]string) {
+	match := map[string]string{}
+	matchRE := map[string]string{}
+
+	for _, m := range in {
+		if m.IsRegex() {
+			matchRE[m.Name] = m.Value
+		} else {
+			match[m.Name] = m.Value
+		}
+	}
+
+	if enforceNamespace {
+		match["namespace"] = crKey.Namespace
+		delete(matchRE, "namespace")
+	}
+
+	// Set to nil if empty so that it doesn't show up in the resulting yaml.
+	if len(match) == 0 {
+		match = nil
+	}
+	// Set to nil if empty so that it doesn't show up in the resulting yaml.
+	if len(matchRE) == 0 {
+		matchRE = nil
+	}
+
+	return match, matchRE
+}
+
+func convertMuteTimeInterval(in *monitoringv1alpha1.MuteTimeInterval, crKey types.NamespacedName) *muteTimeInterval {
+	out := &muteTimeInterval{
+		Name:          makeNamespacedString(in.Name, crKey),
+		TimeIntervals: make([]timeInterval, 0, len(in.TimeIntervals)),
+	}
+
+	for _, ti := range in.TimeIntervals {
+		var t timeInterval
+
+		for _, tr := range ti.Times {
+			t.Times = append(t.Times, timeRange{
+				StartTime: string(tr.StartTime),
+				EndTime:   string(tr.EndTime),
+			})
+		}
+
+		for _, wr := range ti.Weekdays {
+			t.Weekdays = append(t.Weekdays, string(wr))
+		}
+
+		for _, dr := range ti.DaysOfMonth {
+			t.DaysOfMonth = append(t.DaysOfMonth, fmt.Sprintf("%d:%d", dr.Start, dr.End))
+		}
+
+		for _, mr := range ti.Months {
+			t.Months = append(t.Months, string(mr))
+		}
+
+		for _, yr := range ti.Years {
+			t.Years = append(t.Years, string(yr))
+		}
+
+		out.TimeIntervals = append(out.TimeIntervals, t)
+	}
+
+	return out
+}
+
+// convertMatchers returns the matchers using the Alertmanager matcher syntax
+// (requires Alertmanager >= 0.22.0). When enforceNamespace is true, any
+// matcher on the namespace label is replaced by an equality matcher on the
+// namespace of the AlertmanagerConfig object.
+func convertMatchers(in []monitoringv1alpha1.Matcher, crKey types.NamespacedName, enforceNamespace bool) []string {
+	var matchers []string
+
+	if enforceNamespace {
+		matchers = append(matchers, monitoringv1alpha1.Matcher{Name: "namespace", Value: crKey.Namespace}.String())
+	}
+
+	for _, m := range in {
+		if enforceNamespace && m.Name == "namespace" {
+			continue
+		}
+		matchers = append(matchers, m.String())
+	}
+
+	return matchers
+}
+
+// makeNamespacedString prefixes the given name (receiver, mute time
+// interval, ...) with the namespace and name of the AlertmanagerConfig object
+// to avoid collisions between resources.
+func makeNamespacedString(in string, crKey types.NamespacedName) string {
+	if in == "" {
+		return ""
+	}
+	return crKey.Namespace + "-" + crKey.Name + "-" + in
+}
+
+// templateFileName returns the name of the file holding the template
+// referenced by the AlertmanagerConfig object. The file lives in the
+// generated configuration secret next to the Alertmanager configuration.
+func templateFileName(in monitoringv1.SecretOrConfigMap, crKey types.NamespacedName) string {
+	if in.Secret != nil {
+		return makeNamespacedString("secret-"+in.Secret.Name+"-"+in.Secret.Key, crKey)
+	}
+
+	return makeNamespacedString("configmap-"+in.ConfigMap.Name+"-"+in.ConfigMap.Key, crKey)
+}
+
+// templateConfigMapFileName returns the name of the file holding the key of
+// a ConfigMap selected by the template ConfigMap selector. The file lives in
+// the generated configuration secret next to the Alertmanager configuration.
+func templateConfigMapFileName(cm, key string) string {
+	return "template-configmap-" + cm + "-" + key
+}
+
+// makeTemplateFiles returns the template files built from the keys of the
+// given ConfigMaps.
+func makeTemplateFiles(cms []*corev1.ConfigMap) map[string][]byte {
+	files := make(map[string][]byte)
+	for _, cm := range cms {
+		for k, v := range cm.Data {
+			files[templateConfigMapFileName(cm.Name, k)] = []byte(v)
+		}
+	}
+
+	return files
+}
+
+// appendTemplateFiles returns a copy of templates with the paths of the
+// given template files appended in lexicographic order. Paths which are
+// already present aren't added twice.
+func appendTemplateFiles(templates []string, files map[string][]byte) []string {
+	if len(files) == 0 {
+		return templates
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]string, 0, len(templates)+len(names))
+	out = append(out, templates...)
+
+	existing := make(map[string]struct{}, len(templates))
+	for _, t := range templates {
+		existing[t] = struct{}{}
+	}
+
+	for _, name := range names {
+		p := path.Join(alertmanagerConfigDir, name)
+		if _, ok := existing[p]; ok {
+			continue
+		}
+		out = append(out, p)
+	}
+
+	return out
+}
+
+// credentialFileName returns the name of the file holding the secret key
+// referenced by the AlertmanagerConfig object. The file lives in the
+// generated configuration secret next to the Alertmanager configuration.
+func credentialFileName(in corev1.SecretKeySelector, crKey types.NamespacedName) string {
+	return makeNamespacedString("credential-"+in.Name+"-"+in.Key, crKey)
+}
+
+// credentialFile returns the path of the file holding the secret value when
+// the Alertmanager resource writes credentials to files. It returns an empty
+// string when the value should be inlined in the configuration instead.
+func (cg *configGenerator) credentialFile(in corev1.SecretKeySelector, value string, field string, minVersion string, crKey types.NamespacedName) string {
+	if cg.credentialsMode != monitoringv1.FileCredentialsMode {
 		return ""
 	}
-	return crKey.Namespace + "-" + crKey.Name + "-" + receiverName
+
+	if cg.amVersion.LT(semver.MustParse(minVersion)) {
+		level.Warn(cg.logger).Log(
+			"msg", fmt.Sprintf("inlining %s since %s_file requires Alertmanager >= %s", field, field, minVersion),
+			"alertmanagerconfig", crKey.String(),
+			"version", cg.amVersion.String(),
+		)
+		return ""
+	}
+
+	name := credentialFileName(in, crKey)
+	cg.credentials[name] = []byte(value)
+
+	return path.Join(alertmanagerConfigDir, name)
 }
 
 func (cg *configGenerator) convertHTTPConfig(ctx context.Context, in monitoringv1alpha1.HTTPConfig, crKey types.NamespacedName) (*httpClientConfig, error) {
@@ -792,6 +1814,46 @@ func (cg *configGenerator) convertHTTPConfig(ctx context.Context, in monitoringv
 		ProxyURL: in.ProxyURL,
 	}
 
+	if in.FollowRedirects != nil {
+		if cg.amVersion.LT(semver.MustParse("0.22.0")) {
+			level.Warn(cg.logger).Log(
+				"msg", "ignoring followRedirects since it requires Alertmanager >= 0.22.0",
+				"alertmanagerconfig", crKey.String(),
+				"version", cg.amVersion.String(),
+			)
+		} else {
+			out.FollowRedirects = in.FollowRedirects
+		}
+	}
+
+	if in.OAuth2 != nil {
+		if cg.amVersion.LT(semver.MustParse("0.22.0")) {
+			level.Warn(cg.logger).Log(
+				"msg", "ignoring oauth2 since it requires Alertmanager >= 0.22.0",
+				"alertmanagerconfig", crKey.String(),
+				"version", cg.amVersion.String(),
+			)
+		} else {
+			clientID, err := cg.store.GetKey(ctx, crKey.Namespace, in.OAuth2.ClientID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get OAuth2 client id")
+			}
+
+			clientSecret, err := cg.store.GetSecretKey(ctx, crKey.Namespace, in.OAuth2.ClientSecret)
+			if err != nil {
+				return nil, errors.Errorf("failed to get OAuth2 client secret key %q from secret %q", in.OAuth2.ClientSecret.Key, in.OAuth2.ClientSecret.Name)
+			}
+
+			out.OAuth2 = &oauth2{
+				ClientID:       clientID,
+				ClientSecret:   clientSecret,
+				Scopes:         in.OAuth2.Scopes,
+				TokenURL:       in.OAuth2.TokenURL,
+				EndpointParams: in.OAuth2.EndpointParams,
+			}
+		}
+	}
+
 	if in.BasicAuth != nil {
 		username, err := cg.store.GetSecretKey(ctx, crKey.Namespace, in.BasicAuth.Username)
 		if err != nil {
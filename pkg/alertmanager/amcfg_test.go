@@ -19,24 +19,42 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/blang/semver/v4"
+	"github.com/go-kit/kit/log"
 	"github.com/google/go-cmp/cmp"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
 	"github.com/prometheus/alertmanager/config"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 )
 
 func TestGenerateConfig(t *testing.T) {
 	type testCase struct {
-		name       string
-		kclient    kubernetes.Interface
-		baseConfig alertmanagerConfig
-		amConfigs  map[string]*monitoringv1alpha1.AlertmanagerConfig
-		expected   string
+		name            string
+		kclient         kubernetes.Interface
+		baseConfig      alertmanagerConfig
+		clusterConfigs  map[string]*monitoringv1alpha1.AlertmanagerConfig
+		amConfigs       map[string]*monitoringv1alpha1.AlertmanagerConfig
+		amVersion       *semver.Version
+		matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy
+		credentialsMode monitoringv1.AlertmanagerConfigCredentialsMode
+		// Default send_resolved values at the operator and Alertmanager
+		// levels.
+		defaultSendResolved   *bool
+		amDefaultSendResolved *bool
+		expected              string
+		// Credentials which should be written to files, indexed by file
+		// name.
+		expectedCredentials map[string]string
+		// Set when the expected configuration uses integrations which
+		// aren't known by the vendored Alertmanager package.
+		skipConfigLoad bool
 	}
 
 	globalSlackAPIURL, err := url.Parse("http://slack.example.com")
@@ -44,6 +62,9 @@ func TestGenerateConfig(t *testing.T) {
 		t.Fatal("Could not parse slack API URL")
 	}
 
+	followRedirects := false
+	trueVal, falseVal := true, false
+
 	testCases := []testCase{
 		{
 			name:    "skeleton base, no CRs",
@@ -118,6 +139,141 @@ receivers:
 - name: "null"
 - name: mynamespace-myamc-test
 templates: []
+`,
+		},
+		{
+			name:    "skeleton base, cluster CR merged before CR",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			clusterConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"org-wide": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "org-wide",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "pager",
+							Matchers: []monitoringv1alpha1.Matcher{
+								{Name: "severity", Value: "critical"},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "pager"}},
+						InhibitRules: []monitoringv1alpha1.InhibitRule{
+							{
+								SourceMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "critical"}},
+								TargetMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "warning"}},
+							},
+						},
+					},
+				},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-org-wide-pager
+    match:
+      severity: critical
+    continue: true
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+inhibit_rules:
+- target_match:
+    severity: warning
+  source_match:
+    severity: critical
+receivers:
+- name: "null"
+- name: mynamespace-org-wide-pager
+- name: mynamespace-myamc-test
+templates: []
+`,
+		},
+		{
+			name:    "skeleton base, multiple CRs sorted by namespace and name",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"ns-a/amc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amc",
+						Namespace: "ns-a",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+				"ns/amc-b": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amc-b",
+						Namespace: "ns",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+				"ns/amc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amc",
+						Namespace: "ns",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: ns-amc-test
+    match:
+      namespace: ns
+    continue: true
+  - receiver: ns-amc-b-test
+    match:
+      namespace: ns
+    continue: true
+  - receiver: ns-a-amc-test
+    match:
+      namespace: ns-a
+    continue: true
+receivers:
+- name: "null"
+- name: ns-amc-test
+- name: ns-amc-b-test
+- name: ns-a-amc-test
+templates: []
 `,
 		},
 		{
@@ -267,8 +423,20 @@ templates: []
 `,
 		},
 		{
-			name:    "CR with Webhook Receiver",
-			kclient: fake.NewSimpleClientset(),
+			name:            "CR with Pagerduty Receiver and file credentials",
+			amVersion:       &semver.Version{Major: 0, Minor: 25},
+			credentialsMode: monitoringv1.FileCredentialsMode,
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-pd-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"routingKey": []byte("1234abc"),
+					},
+				},
+			),
 			baseConfig: alertmanagerConfig{
 				Route: &route{
 					Receiver: "null",
@@ -283,14 +451,17 @@ templates: []
 					},
 					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
 						Route: &monitoringv1alpha1.Route{
-							Receiver: "test",
+							Receiver: "test-pd",
 						},
 						Receivers: []monitoringv1alpha1.Receiver{{
-							Name: "test",
-							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
-								URL: func(s string) *string {
-									return &s
-								}("http://test.url"),
+							Name: "test-pd",
+							PagerDutyConfigs: []monitoringv1alpha1.PagerDutyConfig{{
+								RoutingKey: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-pd-test-receiver",
+									},
+									Key: "routingKey",
+								},
 							}},
 						}},
 					},
@@ -299,28 +470,33 @@ templates: []
 			expected: `route:
   receiver: "null"
   routes:
-  - receiver: mynamespace-myamc-test
-    match:
-      namespace: mynamespace
+  - receiver: mynamespace-myamc-test-pd
+    matchers:
+    - namespace="mynamespace"
     continue: true
 receivers:
 - name: "null"
-- name: mynamespace-myamc-test
-  webhook_configs:
-  - url: http://test.url
+- name: mynamespace-myamc-test-pd
+  pagerduty_configs:
+  - routing_key_file: /etc/alertmanager/config/mynamespace-myamc-credential-am-pd-test-receiver-routingKey
 templates: []
 `,
+			expectedCredentials: map[string]string{
+				"mynamespace-myamc-credential-am-pd-test-receiver-routingKey": "1234abc",
+			},
+			skipConfigLoad: true,
 		},
 		{
-			name: "CR with Opsgenie Receiver",
+			name:            "CR with Pagerduty Receiver and file credentials unsupported by Alertmanager",
+			credentialsMode: monitoringv1.FileCredentialsMode,
 			kclient: fake.NewSimpleClientset(
 				&corev1.Secret{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "am-og-test-receiver",
+						Name:      "am-pd-test-receiver",
 						Namespace: "mynamespace",
 					},
 					Data: map[string][]byte{
-						"apiKey": []byte("1234abc"),
+						"routingKey": []byte("1234abc"),
 					},
 				},
 			),
@@ -338,16 +514,16 @@ templates: []
 					},
 					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
 						Route: &monitoringv1alpha1.Route{
-							Receiver: "test",
+							Receiver: "test-pd",
 						},
 						Receivers: []monitoringv1alpha1.Receiver{{
-							Name: "test",
-							OpsGenieConfigs: []monitoringv1alpha1.OpsGenieConfig{{
-								APIKey: &corev1.SecretKeySelector{
+							Name: "test-pd",
+							PagerDutyConfigs: []monitoringv1alpha1.PagerDutyConfig{{
+								RoutingKey: &corev1.SecretKeySelector{
 									LocalObjectReference: corev1.LocalObjectReference{
-										Name: "am-og-test-receiver",
+										Name: "am-pd-test-receiver",
 									},
-									Key: "apiKey",
+									Key: "routingKey",
 								},
 							}},
 						}},
@@ -357,28 +533,28 @@ templates: []
 			expected: `route:
   receiver: "null"
   routes:
-  - receiver: mynamespace-myamc-test
+  - receiver: mynamespace-myamc-test-pd
     match:
       namespace: mynamespace
     continue: true
 receivers:
 - name: "null"
-- name: mynamespace-myamc-test
-  opsgenie_configs:
-  - api_key: 1234abc
+- name: mynamespace-myamc-test-pd
+  pagerduty_configs:
+  - routing_key: 1234abc
 templates: []
 `,
 		},
 		{
-			name: "CR with Opsgenie Team Responder",
+			name: "CR with Pagerduty Receiver referencing a secret from another namespace",
 			kclient: fake.NewSimpleClientset(
 				&corev1.Secret{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "am-og-test-receiver",
-						Namespace: "mynamespace",
+						Name:      "am-pd-test-receiver",
+						Namespace: "central",
 					},
 					Data: map[string][]byte{
-						"apiKey": []byte("1234abc"),
+						"routingKey": []byte("1234abc"),
 					},
 				},
 			),
@@ -396,21 +572,18 @@ templates: []
 					},
 					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
 						Route: &monitoringv1alpha1.Route{
-							Receiver: "test",
+							Receiver: "test-pd",
 						},
 						Receivers: []monitoringv1alpha1.Receiver{{
-							Name: "test",
-							OpsGenieConfigs: []monitoringv1alpha1.OpsGenieConfig{{
-								APIKey: &corev1.SecretKeySelector{
+							Name:            "test-pd",
+							SecretNamespace: "central",
+							PagerDutyConfigs: []monitoringv1alpha1.PagerDutyConfig{{
+								RoutingKey: &corev1.SecretKeySelector{
 									LocalObjectReference: corev1.LocalObjectReference{
-										Name: "am-og-test-receiver",
+										Name: "am-pd-test-receiver",
 									},
-									Key: "apiKey",
+									Key: "routingKey",
 								},
-								Responders: []monitoringv1alpha1.OpsGenieConfigResponder{{
-									Name: "myname",
-									Type: "team",
-								}},
 							}},
 						}},
 					},
@@ -419,34 +592,21 @@ templates: []
 			expected: `route:
   receiver: "null"
   routes:
-  - receiver: mynamespace-myamc-test
+  - receiver: mynamespace-myamc-test-pd
     match:
       namespace: mynamespace
     continue: true
 receivers:
 - name: "null"
-- name: mynamespace-myamc-test
-  opsgenie_configs:
-  - api_key: 1234abc
-    responders:
-    - name: myname
-      type: team
+- name: mynamespace-myamc-test-pd
+  pagerduty_configs:
+  - routing_key: 1234abc
 templates: []
 `,
 		},
 		{
-			name: "CR with WeChat Receiver",
-			kclient: fake.NewSimpleClientset(
-				&corev1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "am-wechat-test-receiver",
-						Namespace: "mynamespace",
-					},
-					Data: map[string][]byte{
-						"apiSecret": []byte("wechatsecret"),
-					},
-				},
-			),
+			name:    "CR with Webhook Receiver",
+			kclient: fake.NewSimpleClientset(),
 			baseConfig: alertmanagerConfig{
 				Route: &route{
 					Receiver: "null",
@@ -465,14 +625,10 @@ templates: []
 						},
 						Receivers: []monitoringv1alpha1.Receiver{{
 							Name: "test",
-							WeChatConfigs: []monitoringv1alpha1.WeChatConfig{{
-								APISecret: &corev1.SecretKeySelector{
-									LocalObjectReference: corev1.LocalObjectReference{
-										Name: "am-wechat-test-receiver",
-									},
-									Key: "apiSecret",
-								},
-								CorpID: "wechatcorpid",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL: func(s string) *string {
+									return &s
+								}("http://test.url"),
 							}},
 						}},
 					},
@@ -488,20 +644,16 @@ templates: []
 receivers:
 - name: "null"
 - name: mynamespace-myamc-test
-  wechat_configs:
-  - api_secret: wechatsecret
-    corp_id: wechatcorpid
+  webhook_configs:
+  - url: http://test.url
 templates: []
 `,
 		},
 		{
-
-			name:    "CR with Slack Receiver",
-			kclient: fake.NewSimpleClientset(),
+			name:                "CR with default send_resolved from the operator",
+			defaultSendResolved: &trueVal,
+			kclient:             fake.NewSimpleClientset(),
 			baseConfig: alertmanagerConfig{
-				Global: &globalConfig{
-					SlackAPIURL: &config.URL{URL: globalSlackAPIURL},
-				},
 				Route: &route{
 					Receiver: "null",
 				},
@@ -519,28 +671,464 @@ templates: []
 						},
 						Receivers: []monitoringv1alpha1.Receiver{{
 							Name: "test",
-							SlackConfigs: []monitoringv1alpha1.SlackConfig{{
-								Actions: []monitoringv1alpha1.SlackAction{
-									{
-										Type: "type",
-										Text: "text",
-										Name: "my-action",
-										ConfirmField: &monitoringv1alpha1.SlackConfirmationField{
-											Text: "text",
-										},
-									},
-								},
-								Fields: []monitoringv1alpha1.SlackField{
-									{
-										Title: "title",
-										Value: "value",
-									},
-								},
-							}},
-						}},
-					},
-				},
-			},
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL: func(s string) *string {
+									return &s
+								}("http://test.url"),
+							}, {
+								URL: func(s string) *string {
+									return &s
+								}("http://other.url"),
+								SendResolved: &falseVal,
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - send_resolved: true
+    url: http://test.url
+  - send_resolved: false
+    url: http://other.url
+templates: []
+`,
+		},
+		{
+			name:                  "CR with default send_resolved from the Alertmanager resource",
+			defaultSendResolved:   &falseVal,
+			amDefaultSendResolved: &trueVal,
+			kclient:               fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL: func(s string) *string {
+									return &s
+								}("http://test.url"),
+							}, {
+								URL: func(s string) *string {
+									return &s
+								}("http://other.url"),
+								SendResolved: &falseVal,
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - send_resolved: true
+    url: http://test.url
+  - send_resolved: false
+    url: http://other.url
+templates: []
+`,
+		},
+		{
+			name: "CR with Opsgenie Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-og-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiKey": []byte("1234abc"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							OpsGenieConfigs: []monitoringv1alpha1.OpsGenieConfig{{
+								APIKey: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-og-test-receiver",
+									},
+									Key: "apiKey",
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  opsgenie_configs:
+  - api_key: 1234abc
+templates: []
+`,
+		},
+		{
+			name: "CR with Opsgenie Receiver and details",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-og-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiKey": []byte("1234abc"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							OpsGenieConfigs: []monitoringv1alpha1.OpsGenieConfig{{
+								APIKey: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-og-test-receiver",
+									},
+									Key: "apiKey",
+								},
+								APIURL:   "https://api.eu.opsgenie.com/",
+								Tags:     "tag1,tag2",
+								Priority: "P2",
+								Details: []monitoringv1alpha1.KeyValue{
+									{Key: "team", Value: "infra"},
+									{Key: "env", Value: "prod"},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  opsgenie_configs:
+  - api_key: 1234abc
+    api_url: https://api.eu.opsgenie.com/
+    details:
+      env: prod
+      team: infra
+    tags: tag1,tag2
+    priority: P2
+templates: []
+`,
+		},
+		{
+			name: "CR with Opsgenie Team Responder",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-og-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiKey": []byte("1234abc"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							OpsGenieConfigs: []monitoringv1alpha1.OpsGenieConfig{{
+								APIKey: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-og-test-receiver",
+									},
+									Key: "apiKey",
+								},
+								Responders: []monitoringv1alpha1.OpsGenieConfigResponder{{
+									Name: "myname",
+									Type: "team",
+								}},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  opsgenie_configs:
+  - api_key: 1234abc
+    responders:
+    - name: myname
+      type: team
+templates: []
+`,
+		},
+		{
+			name: "CR with WeChat Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-wechat-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiSecret": []byte("wechatsecret"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WeChatConfigs: []monitoringv1alpha1.WeChatConfig{{
+								APISecret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-wechat-test-receiver",
+									},
+									Key: "apiSecret",
+								},
+								CorpID: "wechatcorpid",
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  wechat_configs:
+  - api_secret: wechatsecret
+    corp_id: wechatcorpid
+templates: []
+`,
+		},
+		{
+			name: "CR with WeChat Receiver and recipients",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-wechat-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiSecret": []byte("wechatsecret"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WeChatConfigs: []monitoringv1alpha1.WeChatConfig{{
+								APISecret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-wechat-test-receiver",
+									},
+									Key: "apiSecret",
+								},
+								CorpID:      "wechatcorpid",
+								AgentID:     "1000002",
+								ToUser:      "user1|user2",
+								ToParty:     "party1",
+								ToTag:       "tag1",
+								MessageType: "markdown",
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  wechat_configs:
+  - api_secret: wechatsecret
+    corp_id: wechatcorpid
+    agent_id: "1000002"
+    to_user: user1|user2
+    to_party: party1
+    to_tag: tag1
+    message_type: markdown
+templates: []
+`,
+		},
+		{
+
+			name:    "CR with Slack Receiver",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Global: &globalConfig{
+					SlackAPIURL: &config.URL{URL: globalSlackAPIURL},
+				},
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							SlackConfigs: []monitoringv1alpha1.SlackConfig{{
+								Actions: []monitoringv1alpha1.SlackAction{
+									{
+										Type: "type",
+										Text: "text",
+										Name: "my-action",
+										ConfirmField: &monitoringv1alpha1.SlackConfirmationField{
+											Text: "text",
+										},
+									},
+								},
+								Fields: []monitoringv1alpha1.SlackField{
+									{
+										Title: "title",
+										Value: "value",
+									},
+								},
+							}},
+						}},
+					},
+				},
+			},
 			expected: `global:
   resolve_timeout: 0s
   slack_api_url: http://slack.example.com
@@ -554,17 +1142,1797 @@ route:
 receivers:
 - name: "null"
 - name: mynamespace-myamc-test
-  slack_configs:
-  - fields:
-    - title: title
-      value: value
-    actions:
-    - type: type
-      text: text
-      name: my-action
-      confirm:
-        text: text
+  slack_configs:
+  - fields:
+    - title: title
+      value: value
+    actions:
+    - type: type
+      text: text
+      name: my-action
+      confirm:
+        text: text
+templates: []
+`,
+		},
+		{
+			name: "CR with Slack Receiver and API URL from Secret",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-slack-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiURL": []byte("https://hooks.slack.com/services/abc"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							SlackConfigs: []monitoringv1alpha1.SlackConfig{{
+								APIURL: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-slack-test-receiver",
+									},
+									Key: "apiURL",
+								},
+								Channel: "#alerts",
+								Title:   `{{ template "slack.default.title" . }}`,
+								Text:    `{{ template "slack.default.text" . }}`,
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  slack_configs:
+  - api_url: https://hooks.slack.com/services/abc
+    channel: '#alerts'
+    title: '{{ template "slack.default.title" . }}'
+    text: '{{ template "slack.default.text" . }}'
+templates: []
+`,
+		},
+		{
+			name: "CR with Email Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-email-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"password": []byte("secretpassword"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							EmailConfigs: []monitoringv1alpha1.EmailConfig{{
+								To:           "team@example.com",
+								From:         "alertmanager@example.com",
+								Smarthost:    "smtp.example.com:587",
+								AuthUsername: "alertmanager",
+								AuthPassword: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-email-test-receiver",
+									},
+									Key: "password",
+								},
+								Headers: []monitoringv1alpha1.KeyValue{
+									{Key: "subject", Value: "Alert"},
+									{Key: "x-team", Value: "infra"},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  email_configs:
+  - to: team@example.com
+    from: alertmanager@example.com
+    smarthost: smtp.example.com:587
+    auth_username: alertmanager
+    auth_password: secretpassword
+    headers:
+      Subject: Alert
+      X-Team: infra
+templates: []
+`,
+		},
+		{
+			name: "CR with VictorOps Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-victorops-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiKey": []byte("victoropskey"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							VictorOpsConfigs: []monitoringv1alpha1.VictorOpsConfig{{
+								APIKey: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-victorops-test-receiver",
+									},
+									Key: "apiKey",
+								},
+								RoutingKey:   "team-infra",
+								MessageType:  "CRITICAL",
+								StateMessage: "{{ .CommonAnnotations.summary }}",
+								CustomFields: []monitoringv1alpha1.KeyValue{
+									{Key: "runbook", Value: "https://runbooks.example.com"},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  victorops_configs:
+  - api_key: victoropskey
+    routing_key: team-infra
+    message_type: CRITICAL
+    state_message: '{{ .CommonAnnotations.summary }}'
+    custom_fields:
+      runbook: https://runbooks.example.com
+templates: []
+`,
+		},
+		{
+			name: "CR with Pushover Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-pushover-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"userKey": []byte("userkey"),
+						"token":   []byte("token"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							PushoverConfigs: []monitoringv1alpha1.PushoverConfig{{
+								UserKey: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-pushover-test-receiver",
+									},
+									Key: "userKey",
+								},
+								Token: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-pushover-test-receiver",
+									},
+									Key: "token",
+								},
+								Title:    "title",
+								Sound:    "siren",
+								Priority: "2",
+								Retry:    "1m",
+								Expire:   "1h",
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  pushover_configs:
+  - user_key: userkey
+    token: token
+    title: title
+    sound: siren
+    priority: "2"
+    retry: 1m0s
+    expire: 1h0m0s
+templates: []
+`,
+		},
+		{
+			name: "CR with SNS Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-sns-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"accessKey": []byte("accesskey"),
+						"secretKey": []byte("secretkey"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							SNSConfigs: []monitoringv1alpha1.SNSConfig{{
+								APIURL: "https://sns.us-east-2.amazonaws.com",
+								Sigv4: &monitoringv1.Sigv4{
+									Region: "us-east-2",
+									AccessKey: &corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "am-sns-test-receiver",
+										},
+										Key: "accessKey",
+									},
+									SecretKey: &corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "am-sns-test-receiver",
+										},
+										Key: "secretKey",
+									},
+								},
+								TopicARN: "arn:aws:sns:us-east-2:123456789012:My-Topic",
+								Subject:  "alert",
+								Attributes: map[string]string{
+									"severity": "critical",
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  sns_configs:
+  - api_url: https://sns.us-east-2.amazonaws.com
+    sigv4:
+      region: us-east-2
+      access_key: accesskey
+      secret_key: secretkey
+    topic_arn: arn:aws:sns:us-east-2:123456789012:My-Topic
+    subject: alert
+    attributes:
+      severity: critical
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name: "CR with Telegram Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-telegram-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"botToken": []byte("bottoken"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							TelegramConfigs: []monitoringv1alpha1.TelegramConfig{{
+								BotToken: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-telegram-test-receiver",
+									},
+									Key: "botToken",
+								},
+								ChatID:    12345,
+								ParseMode: "HTML",
+								Message:   "{{ template \"telegram.default.message\" . }}",
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  telegram_configs:
+  - bot_token: bottoken
+    chat_id: 12345
+    message: '{{ template "telegram.default.message" . }}'
+    parse_mode: HTML
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name: "CR with Discord Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-discord-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"webhookURL": []byte("https://discord.com/api/webhooks/123/abc"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							DiscordConfigs: []monitoringv1alpha1.DiscordConfig{{
+								APIURL: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-discord-test-receiver",
+									},
+									Key: "webhookURL",
+								},
+								Title:   "{{ template \"discord.default.title\" . }}",
+								Message: "{{ template \"discord.default.message\" . }}",
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  discord_configs:
+  - webhook_url: https://discord.com/api/webhooks/123/abc
+    title: '{{ template "discord.default.title" . }}'
+    message: '{{ template "discord.default.message" . }}'
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name: "CR with MSTeams Receivers",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-msteams-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"webhookURL": []byte("https://example.webhook.office.com/webhookb2/abc"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							MSTeamsConfigs: []monitoringv1alpha1.MSTeamsConfig{{
+								WebhookURL: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-msteams-test-receiver",
+									},
+									Key: "webhookURL",
+								},
+								Title:   "title",
+								Summary: "summary",
+								Text:    "text",
+							}},
+							MSTeamsV2Configs: []monitoringv1alpha1.MSTeamsV2Config{{
+								WebhookURL: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-msteams-test-receiver",
+									},
+									Key: "webhookURL",
+								},
+								Title: "title",
+								Text:  "text",
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  msteams_configs:
+  - webhook_url: https://example.webhook.office.com/webhookb2/abc
+    title: title
+    summary: summary
+    text: text
+  msteamsv2_configs:
+  - webhook_url: https://example.webhook.office.com/webhookb2/abc
+    title: title
+    text: text
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name: "CR with Rocket.Chat Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-rocketchat-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"apiURL":  []byte("https://rocketchat.example.com"),
+						"token":   []byte("token"),
+						"tokenID": []byte("token-id"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							RocketChatConfigs: []monitoringv1alpha1.RocketChatConfig{{
+								APIURL: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-rocketchat-test-receiver",
+									},
+									Key: "apiURL",
+								},
+								Token: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-rocketchat-test-receiver",
+									},
+									Key: "token",
+								},
+								TokenID: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-rocketchat-test-receiver",
+									},
+									Key: "tokenID",
+								},
+								Channel: "#alerts",
+								Emoji:   ":fire:",
+								Fields: []monitoringv1alpha1.RocketChatFieldConfig{{
+									Title: "severity",
+									Value: "{{ .CommonLabels.severity }}",
+									Short: &trueVal,
+								}},
+								Actions: []monitoringv1alpha1.RocketChatActionConfig{{
+									Text: "Runbook",
+									URL:  "https://runbooks.example.com",
+								}},
+							}},
+						}},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 28},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  rocketchat_configs:
+  - api_url: https://rocketchat.example.com
+    channel: '#alerts'
+    token: token
+    token_id: token-id
+    emoji: ':fire:'
+    fields:
+    - title: severity
+      value: '{{ .CommonLabels.severity }}'
+      short: true
+    actions:
+    - text: Runbook
+      url: https://runbooks.example.com
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name: "CR with Jira Receiver",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-jira-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"username": []byte("jira-user"),
+						"token":    []byte("jira-token"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							JiraConfigs: []monitoringv1alpha1.JiraConfig{{
+								APIURL:            "https://jira.example.com",
+								Project:           "OPS",
+								IssueType:         "Bug",
+								Priority:          "High",
+								Labels:            []string{"alertmanager"},
+								ResolveTransition: "Done",
+								ReopenTransition:  "To Do",
+								ReopenDuration:    "1h",
+								HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+									BasicAuth: &monitoringv1.BasicAuth{
+										Username: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-jira-test-receiver",
+											},
+											Key: "username",
+										},
+										Password: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-jira-test-receiver",
+											},
+											Key: "token",
+										},
+									},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 28},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  jira_configs:
+  - http_config:
+      basic_auth:
+        username: jira-user
+        password: jira-token
+    api_url: https://jira.example.com
+    project: OPS
+    labels:
+    - alertmanager
+    priority: High
+    issue_type: Bug
+    reopen_transition: To Do
+    resolve_transition: Done
+    reopen_duration: 1h
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name:    "CR with Webhook Receiver and timeout",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL:       strPtr("http://test.url"),
+								MaxAlerts: 10,
+								Timeout:   "5s",
+							}},
+						}},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 28},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - url: http://test.url
+    max_alerts: 10
+    timeout: 5s
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name:    "CR with Webhook Receiver and timeout on unsupported version",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL:       strPtr("http://test.url"),
+								MaxAlerts: 10,
+								Timeout:   "5s",
+							}},
+						}},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 27},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - url: http://test.url
+    max_alerts: 10
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name: "CR with Webhook Receiver and HTTP config",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-http-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL: func(s string) *string {
+									return &s
+								}("http://test.url"),
+								HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+									BasicAuth: &monitoringv1.BasicAuth{
+										Username: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-http-test-receiver",
+											},
+											Key: "username",
+										},
+										Password: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-http-test-receiver",
+											},
+											Key: "password",
+										},
+									},
+									TLSConfig: &monitoringv1.SafeTLSConfig{
+										CA: monitoringv1.SecretOrConfigMap{
+											Secret: &corev1.SecretKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: "tls",
+												},
+												Key: "ca.crt",
+											},
+										},
+										Cert: monitoringv1.SecretOrConfigMap{
+											ConfigMap: &corev1.ConfigMapKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: "tls",
+												},
+												Key: "tls.crt",
+											},
+										},
+										KeySecret: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "tls",
+											},
+											Key: "tls.key",
+										},
+										ServerName: "test.url",
+									},
+									ProxyURL:        "http://proxy.example.com:3128",
+									FollowRedirects: &followRedirects,
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - url: http://test.url
+    http_config:
+      basic_auth:
+        username: user
+        password: pass
+      proxy_url: http://proxy.example.com:3128
+      tls_config:
+        ca_file: /etc/alertmanager/certs/secret_mynamespace_tls_ca.crt
+        cert_file: /etc/alertmanager/certs/configmap_mynamespace_tls_tls.crt
+        key_file: /etc/alertmanager/certs/secret_mynamespace_tls_tls.key
+        server_name: test.url
+        insecure_skip_verify: false
+templates: []
+`,
+		},
+		{
+			name: "CR with Webhook Receiver and HTTP config for Alertmanager >= 0.22",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-http-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL: func(s string) *string {
+									return &s
+								}("http://test.url"),
+								HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+									BasicAuth: &monitoringv1.BasicAuth{
+										Username: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-http-test-receiver",
+											},
+											Key: "username",
+										},
+										Password: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-http-test-receiver",
+											},
+											Key: "password",
+										},
+									},
+									TLSConfig: &monitoringv1.SafeTLSConfig{
+										CA: monitoringv1.SecretOrConfigMap{
+											Secret: &corev1.SecretKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: "tls",
+												},
+												Key: "ca.crt",
+											},
+										},
+										Cert: monitoringv1.SecretOrConfigMap{
+											ConfigMap: &corev1.ConfigMapKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: "tls",
+												},
+												Key: "tls.crt",
+											},
+										},
+										KeySecret: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "tls",
+											},
+											Key: "tls.key",
+										},
+										ServerName: "test.url",
+									},
+									ProxyURL:        "http://proxy.example.com:3128",
+									FollowRedirects: &followRedirects,
+								},
+							}},
+						}},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 22},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - url: http://test.url
+    http_config:
+      basic_auth:
+        username: user
+        password: pass
+      proxy_url: http://proxy.example.com:3128
+      tls_config:
+        ca_file: /etc/alertmanager/certs/secret_mynamespace_tls_ca.crt
+        cert_file: /etc/alertmanager/certs/configmap_mynamespace_tls_tls.crt
+        key_file: /etc/alertmanager/certs/secret_mynamespace_tls_tls.key
+        server_name: test.url
+        insecure_skip_verify: false
+      follow_redirects: false
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name: "webhook with OAuth2 unsupported by default version",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-oauth2-test",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"clientId":     []byte("client"),
+						"clientSecret": []byte("secret"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL: func(s string) *string {
+									return &s
+								}("http://test.url"),
+								HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+									OAuth2: &monitoringv1.OAuth2{
+										ClientID: monitoringv1.SecretOrConfigMap{
+											Secret: &corev1.SecretKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: "am-oauth2-test",
+												},
+												Key: "clientId",
+											},
+										},
+										ClientSecret: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-oauth2-test",
+											},
+											Key: "clientSecret",
+										},
+										TokenURL: "http://auth.example.com/token",
+										Scopes:   []string{"alerts"},
+										EndpointParams: map[string]string{
+											"audience": "receiver",
+										},
+									},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - url: http://test.url
+    http_config: {}
+templates: []
+`,
+		},
+		{
+			name: "webhook with OAuth2",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-oauth2-test",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"clientId":     []byte("client"),
+						"clientSecret": []byte("secret"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URL: func(s string) *string {
+									return &s
+								}("http://test.url"),
+								HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+									OAuth2: &monitoringv1.OAuth2{
+										ClientID: monitoringv1.SecretOrConfigMap{
+											Secret: &corev1.SecretKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: "am-oauth2-test",
+												},
+												Key: "clientId",
+											},
+										},
+										ClientSecret: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "am-oauth2-test",
+											},
+											Key: "clientSecret",
+										},
+										TokenURL: "http://auth.example.com/token",
+										Scopes:   []string{"alerts"},
+										EndpointParams: map[string]string{
+											"audience": "receiver",
+										},
+									},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 22},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  webhook_configs:
+  - url: http://test.url
+    http_config:
+      oauth2:
+        client_id: client
+        client_secret: secret
+        scopes:
+        - alerts
+        token_url: http://auth.example.com/token
+        endpoint_params:
+          audience: receiver
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name:    "CR with mute time intervals",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver:          "test",
+							MuteTimeIntervals: []string{"weekend"},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+						}},
+						MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+							{
+								Name: "weekend",
+								TimeIntervals: []monitoringv1alpha1.TimeInterval{
+									{
+										Times: []monitoringv1alpha1.TimeRange{
+											{
+												StartTime: "00:00",
+												EndTime:   "08:00",
+											},
+										},
+										Weekdays:    []monitoringv1alpha1.WeekdayRange{"saturday:sunday"},
+										DaysOfMonth: []monitoringv1alpha1.DayOfMonthRange{{Start: 1, End: 5}, {Start: -3, End: -1}},
+										Months:      []monitoringv1alpha1.MonthRange{"1:3", "december"},
+										Years:       []monitoringv1alpha1.YearRange{"2021:2022"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 22},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+    mute_time_intervals:
+    - mynamespace-myamc-weekend
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+templates: []
+mute_time_intervals:
+- name: mynamespace-myamc-weekend
+  time_intervals:
+  - times:
+    - start_time: "00:00"
+      end_time: "08:00"
+    weekdays:
+    - saturday:sunday
+    days_of_month:
+    - "1:5"
+    - -3:-1
+    months:
+    - "1:3"
+    - december
+    years:
+    - 2021:2022
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name:    "CR with active time intervals",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver:            "test",
+							ActiveTimeIntervals: []string{"business-hours"},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+						}},
+						MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+							{
+								Name: "business-hours",
+								TimeIntervals: []monitoringv1alpha1.TimeInterval{
+									{
+										Times: []monitoringv1alpha1.TimeRange{
+											{
+												StartTime: "09:00",
+												EndTime:   "17:00",
+											},
+										},
+										Weekdays: []monitoringv1alpha1.WeekdayRange{"monday:friday"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 24},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    continue: true
+    active_time_intervals:
+    - mynamespace-myamc-business-hours
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+templates: []
+mute_time_intervals:
+- name: mynamespace-myamc-business-hours
+  time_intervals:
+  - times:
+    - start_time: "09:00"
+      end_time: "17:00"
+    weekdays:
+    - monday:friday
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name:    "CR with typed matchers",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+							Matchers: []monitoringv1alpha1.Matcher{
+								{Name: "namespace", Value: "other"},
+								{Name: "job", Value: "node"},
+								{Name: "severity", Value: "info", MatchType: monitoringv1alpha1.MatchNotEqual},
+								{Name: "instance", Value: "host-.*", MatchType: monitoringv1alpha1.MatchRegexp},
+								{Name: "env", Value: "dev|test", MatchType: monitoringv1alpha1.MatchNotRegexp},
+								{Name: "team", Value: "infra", Regex: true},
+								{Name: "summary", Value: `say "hi"`},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+						}},
+						InhibitRules: []monitoringv1alpha1.InhibitRule{
+							{
+								SourceMatch: []monitoringv1alpha1.Matcher{
+									{Name: "alertname", Value: "NodeNotReady"},
+								},
+								TargetMatch: []monitoringv1alpha1.Matcher{
+									{Name: "alertname", Value: "NodeNotReady", MatchType: monitoringv1alpha1.MatchNotEqual},
+								},
+								Equal: []string{"node"},
+							},
+						},
+					},
+				},
+			},
+			amVersion: &semver.Version{Major: 0, Minor: 22},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    matchers:
+    - namespace="mynamespace"
+    - job="node"
+    - severity!="info"
+    - instance=~"host-.*"
+    - env!~"dev|test"
+    - team=~"infra"
+    - summary="say \"hi\""
+    continue: true
+inhibit_rules:
+- target_matchers:
+  - namespace="mynamespace"
+  - alertname!="NodeNotReady"
+  source_matchers:
+  - namespace="mynamespace"
+  - alertname="NodeNotReady"
+  equal:
+  - node
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+templates: []
+`,
+			skipConfigLoad: true,
+		},
+		{
+			name:    "CR with nested routes",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+							Routes: []apiextensionsv1.JSON{
+								{Raw: []byte(`{"matchers": [{"name": "severity", "value": "critical"}], "routes": [{"receiver": "test-pager", "matchers": [{"name": "namespace", "value": "other"}], "continue": true}]}`)},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+						}, {
+							Name: "test-pager",
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+    routes:
+    - match:
+        severity: critical
+      routes:
+      - receiver: mynamespace-myamc-test-pager
+        match:
+          namespace: other
+        continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+- name: mynamespace-myamc-test-pager
+templates: []
+`,
+		},
+		{
+			name:    "CR with None matcher strategy",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+							Matchers: []monitoringv1alpha1.Matcher{
+								{Name: "namespace", Value: "other"},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+						}},
+						InhibitRules: []monitoringv1alpha1.InhibitRule{
+							{
+								SourceMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "critical"}},
+								TargetMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "warning"}},
+							},
+						},
+					},
+				},
+			},
+			matcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type: monitoringv1.NoneConfigMatcherStrategyType,
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: other
+    continue: true
+inhibit_rules:
+- target_match:
+    severity: warning
+  source_match:
+    severity: critical
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+templates: []
+`,
+		},
+		{
+			name:    "CR in Alertmanager namespace with OnNamespaceExceptForAlertmanagerNamespace matcher strategy",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+							Matchers: []monitoringv1alpha1.Matcher{
+								{Name: "namespace", Value: "other"},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+						}},
+						InhibitRules: []monitoringv1alpha1.InhibitRule{
+							{
+								SourceMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "critical"}},
+								TargetMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "warning"}},
+							},
+						},
+					},
+				},
+			},
+			matcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type: monitoringv1.OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType,
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: other
+    continue: true
+inhibit_rules:
+- target_match:
+    severity: warning
+  source_match:
+    severity: critical
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+templates: []
+`,
+		},
+		{
+			name: "CR with Pagerduty Receiver with details, images and links",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-pd-test-receiver",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"serviceKey": []byte("1234abc"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+							PagerDutyConfigs: []monitoringv1alpha1.PagerDutyConfig{{
+								ServiceKey: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "am-pd-test-receiver",
+									},
+									Key: "serviceKey",
+								},
+								Severity:  "critical",
+								Class:     "db",
+								Group:     "storage",
+								Component: "postgres",
+								Details: []monitoringv1alpha1.KeyValue{
+									{Key: "firing", Value: "{{ .Alerts.Firing | len }}"},
+								},
+								PagerDutyImageConfigs: []monitoringv1alpha1.PagerDutyImageConfig{
+									{
+										Src:  "https://example.com/image.png",
+										Href: "https://example.com/dashboard",
+										Alt:  "dashboard",
+									},
+								},
+								PagerDutyLinkConfigs: []monitoringv1alpha1.PagerDutyLinkConfig{
+									{
+										Href: "https://example.com/runbook",
+										Text: "runbook",
+									},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+  pagerduty_configs:
+  - service_key: 1234abc
+    details:
+      firing: '{{ .Alerts.Firing | len }}'
+    images:
+    - src: https://example.com/image.png
+      alt: dashboard
+      href: https://example.com/dashboard
+    links:
+    - href: https://example.com/runbook
+      text: runbook
+    severity: critical
+    class: db
+    component: postgres
+    group: storage
 templates: []
+`,
+		},
+		{
+			name:    "CR with templates",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{
+							Name: "test",
+						}},
+						Templates: []monitoringv1.SecretOrConfigMap{
+							{
+								ConfigMap: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "templates",
+									},
+									Key: "slack.tmpl",
+								},
+							},
+							{
+								Secret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "templates",
+									},
+									Key: "email.tmpl",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `route:
+  receiver: "null"
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: "null"
+- name: mynamespace-myamc-test
+templates:
+- /etc/alertmanager/config/mynamespace-myamc-configmap-templates-slack.tmpl
+- /etc/alertmanager/config/mynamespace-myamc-secret-templates-email.tmpl
 `,
 		},
 	}
@@ -572,8 +2940,27 @@ templates: []
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			store := assets.NewStore(tc.kclient.CoreV1(), tc.kclient.CoreV1())
-			cg := newConfigGenerator(nil, store)
-			cfgBytes, err := cg.generateConfig(context.TODO(), tc.baseConfig, tc.amConfigs)
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "alertmanager",
+					Namespace: "mynamespace",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					AlertmanagerConfigMatcherStrategy:     tc.matcherStrategy,
+					AlertmanagerConfigCredentialsMode:     tc.credentialsMode,
+					AlertmanagerConfigDefaultSendResolved: tc.amDefaultSendResolved,
+				},
+			}
+			if tc.amVersion != nil {
+				am.Spec.Version = tc.amVersion.String()
+			}
+
+			cg, err := newConfigGenerator(log.NewNopLogger(), store, am, tc.defaultSendResolved)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfgBytes, err := cg.generateConfig(context.TODO(), tc.baseConfig, tc.clusterConfigs, tc.amConfigs)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -585,6 +2972,21 @@ templates: []
 				t.Errorf("Unexpected result (-want +got):\n%s", diff)
 			}
 
+			credentials := make(map[string]string, len(cg.credentials))
+			for k, v := range cg.credentials {
+				credentials[k] = string(v)
+			}
+			if len(tc.expectedCredentials) == 0 {
+				tc.expectedCredentials = map[string]string{}
+			}
+			if diff := cmp.Diff(tc.expectedCredentials, credentials); diff != "" {
+				t.Errorf("Unexpected credentials (-want +got):\n%s", diff)
+			}
+
+			if tc.skipConfigLoad {
+				return
+			}
+
 			// Verify the generated config is something that Alertmanager will be happy with
 			_, err = config.Load(result)
 			if err != nil {
@@ -593,3 +2995,398 @@ templates: []
 		})
 	}
 }
+
+func TestGenerateGlobalConfig(t *testing.T) {
+	requireTLS := false
+
+	for _, tc := range []struct {
+		name            string
+		amConfiguration *monitoringv1.AlertmanagerConfiguration
+		globalConfig    *monitoringv1alpha1.AlertmanagerConfig
+		amConfigs       map[string]*monitoringv1alpha1.AlertmanagerConfig
+		expected        string
+	}{
+		{
+			name: "global config only",
+			globalConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "global",
+					Namespace: "monitoring",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "default",
+						GroupBy:  []string{"alertname"},
+						Routes: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"receiver": "critical", "matchers": [{"name": "severity", "value": "critical"}]}`)},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{
+						{Name: "default"},
+						{Name: "critical"},
+					},
+					InhibitRules: []monitoringv1alpha1.InhibitRule{
+						{
+							SourceMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "critical"}},
+							TargetMatch: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "warning"}},
+							Equal:       []string{"alertname"},
+						},
+					},
+				},
+			},
+			expected: `route:
+  receiver: monitoring-global-default
+  group_by:
+  - alertname
+  routes:
+  - receiver: monitoring-global-critical
+    match:
+      severity: critical
+inhibit_rules:
+- target_match:
+    severity: warning
+  source_match:
+    severity: critical
+  equal:
+  - alertname
+receivers:
+- name: monitoring-global-default
+- name: monitoring-global-critical
+templates: []
+`,
+		},
+		{
+			name: "global config merged with other AlertmanagerConfig",
+			globalConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "global",
+					Namespace: "monitoring",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "default",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{
+						{Name: "default"},
+					},
+				},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			expected: `route:
+  receiver: monitoring-global-default
+  routes:
+  - receiver: mynamespace-myamc-test
+    match:
+      namespace: mynamespace
+    continue: true
+receivers:
+- name: monitoring-global-default
+- name: mynamespace-myamc-test
+templates: []
+`,
+		},
+		{
+			name: "global config with global parameters",
+			amConfiguration: &monitoringv1.AlertmanagerConfiguration{
+				Name: "global",
+				Global: &monitoringv1.AlertmanagerGlobalConfig{
+					ResolveTimeout: "10m",
+					HTTPConfig: &monitoringv1.HTTPConfig{
+						ProxyURL: "http://proxy.example.com",
+					},
+					SMTPConfig: &monitoringv1.GlobalSMTPConfig{
+						From: "alertmanager@example.com",
+						SmartHost: &monitoringv1.HostPort{
+							Host: "smtp.example.com",
+							Port: "587",
+						},
+						AuthUsername: "alertmanager",
+						AuthPassword: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "global-secret"},
+							Key:                  "smtp-password",
+						},
+						RequireTLS: &requireTLS,
+					},
+					SlackAPIURL: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "global-secret"},
+						Key:                  "slack-api-url",
+					},
+					OpsGenieAPIKey: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "global-secret"},
+						Key:                  "opsgenie-api-key",
+					},
+					PagerdutyURL: "https://pagerduty.example.com",
+				},
+			},
+			globalConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "global",
+					Namespace: "monitoring",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "default",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{
+						{Name: "default"},
+					},
+				},
+			},
+			expected: `global:
+  resolve_timeout: 10m
+  http_config:
+    proxy_url: http://proxy.example.com
+  smtp_from: alertmanager@example.com
+  smtp_smarthost: smtp.example.com:587
+  smtp_auth_username: alertmanager
+  smtp_auth_password: password
+  smtp_require_tls: false
+  slack_api_url: https://slack.example.com
+  pagerduty_url: https://pagerduty.example.com
+  opsgenie_api_key: opsgenie-key
+route:
+  receiver: monitoring-global-default
+receivers:
+- name: monitoring-global-default
+templates: []
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "global-secret",
+						Namespace: "monitoring",
+					},
+					Data: map[string][]byte{
+						"smtp-password":    []byte("password"),
+						"slack-api-url":    []byte("https://slack.example.com"),
+						"opsgenie-api-key": []byte("opsgenie-key"),
+					},
+				},
+			)
+			store := assets.NewStore(kclient.CoreV1(), kclient.CoreV1())
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "alertmanager",
+					Namespace: "monitoring",
+				},
+			}
+
+			cg, err := newConfigGenerator(log.NewNopLogger(), store, am, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			baseConfig, err := cg.generateGlobalConfig(context.TODO(), tc.amConfiguration, tc.globalConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfgBytes, err := cg.generateConfig(context.TODO(), *baseConfig, nil, tc.amConfigs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfgBytes)
+
+			if diff := cmp.Diff(tc.expected, result); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+
+			if _, err = config.Load(result); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestOverrideConfig(t *testing.T) {
+	base := &alertmanagerConfig{
+		Global: &globalConfig{
+			SMTPFrom: "alertmanager@example.com",
+		},
+		Route: &route{Receiver: "base"},
+		InhibitRules: []*inhibitRule{
+			{SourceMatchers: []string{"severity=critical"}},
+		},
+		Receivers: []*receiver{
+			{Name: "base"},
+			{Name: "shared", WebhookConfigs: []*webhookConfig{{URL: "http://base.example.com/"}}},
+		},
+		Templates: []string{"/etc/alertmanager/config/base.tmpl"},
+		MuteTimeIntervals: []*muteTimeInterval{
+			{Name: "weekend"},
+		},
+	}
+
+	override := &alertmanagerConfig{
+		Route: &route{Receiver: "shared"},
+		InhibitRules: []*inhibitRule{
+			{SourceMatchers: []string{"severity=warning"}},
+		},
+		Receivers: []*receiver{
+			{Name: "shared"},
+		},
+		Templates: []string{"/etc/alertmanager/config/global.tmpl"},
+		MuteTimeIntervals: []*muteTimeInterval{
+			{Name: "weekend", TimeIntervals: []timeInterval{{}}},
+		},
+	}
+
+	expected := &alertmanagerConfig{
+		Global: base.Global,
+		Route:  override.Route,
+		InhibitRules: []*inhibitRule{
+			{SourceMatchers: []string{"severity=critical"}},
+			{SourceMatchers: []string{"severity=warning"}},
+		},
+		Receivers: []*receiver{
+			{Name: "base"},
+			{Name: "shared"},
+		},
+		Templates: []string{
+			"/etc/alertmanager/config/base.tmpl",
+			"/etc/alertmanager/config/global.tmpl",
+		},
+		MuteTimeIntervals: []*muteTimeInterval{
+			{Name: "weekend", TimeIntervals: []timeInterval{{}}},
+		},
+	}
+
+	if diff := cmp.Diff(expected, overrideConfig(base, override)); diff != "" {
+		t.Errorf("Unexpected result (-want +got):\n%s", diff)
+	}
+
+	override.Global = &globalConfig{SMTPFrom: "global@example.com"}
+	if got := overrideConfig(base, override).Global; got != override.Global {
+		t.Errorf("expected the global configuration to be overridden, got %v", got)
+	}
+}
+
+func TestAppendTemplateFiles(t *testing.T) {
+	files := makeTemplateFiles([]*corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "slack"},
+			Data: map[string]string{
+				"slack.tmpl": `{{ define "slack.title" }}title{{ end }}`,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "common"},
+			Data: map[string]string{
+				"b.tmpl": `{{ define "b" }}b{{ end }}`,
+				"a.tmpl": `{{ define "a" }}a{{ end }}`,
+			},
+		},
+	})
+
+	expectedFiles := map[string][]byte{
+		"template-configmap-slack-slack.tmpl": []byte(`{{ define "slack.title" }}title{{ end }}`),
+		"template-configmap-common-a.tmpl":    []byte(`{{ define "a" }}a{{ end }}`),
+		"template-configmap-common-b.tmpl":    []byte(`{{ define "b" }}b{{ end }}`),
+	}
+	if diff := cmp.Diff(expectedFiles, files); diff != "" {
+		t.Fatalf("unexpected template files (-want +got):\n%s", diff)
+	}
+
+	templates := []string{
+		"/etc/alertmanager/config/base.tmpl",
+		"/etc/alertmanager/config/template-configmap-slack-slack.tmpl",
+	}
+	got := appendTemplateFiles(templates, files)
+
+	expected := []string{
+		"/etc/alertmanager/config/base.tmpl",
+		"/etc/alertmanager/config/template-configmap-slack-slack.tmpl",
+		"/etc/alertmanager/config/template-configmap-common-a.tmpl",
+		"/etc/alertmanager/config/template-configmap-common-b.tmpl",
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("unexpected templates (-want +got):\n%s", diff)
+	}
+
+	if len(templates) != 2 {
+		t.Fatalf("expected the input templates to be left unchanged, got %v", templates)
+	}
+
+	if got := appendTemplateFiles(templates, nil); len(got) != 2 {
+		t.Fatalf("expected no template to be added, got %v", got)
+	}
+}
+
+func TestRedactConfig(t *testing.T) {
+	in := `global:
+  resolve_timeout: 5m
+  smtp_auth_password: smtp-password
+  slack_api_url: https://hooks.slack.com/services/secret
+  http_config:
+    bearer_token: global-token
+route:
+  receiver: team
+receivers:
+- name: team
+  pagerduty_configs:
+  - routing_key: pagerduty-key
+    routing_key_file: /etc/alertmanager/config/key
+  webhook_configs:
+  - url: https://example.com/secret
+    http_config:
+      basic_auth:
+        username: user
+        password: webhook-password
+  email_configs:
+  - to: team@example.com
+    auth_username: user
+    auth_password: email-password
+templates: []
+`
+	expected := `global:
+  resolve_timeout: 5m
+  http_config:
+    bearer_token: <secret>
+  smtp_auth_password: <secret>
+  slack_api_url: <secret>
+route:
+  receiver: team
+receivers:
+- name: team
+  pagerduty_configs:
+  - routing_key: <secret>
+    routing_key_file: /etc/alertmanager/config/key
+  webhook_configs:
+  - url: <secret>
+    http_config:
+      basic_auth:
+        username: user
+        password: <secret>
+  email_configs:
+  - to: team@example.com
+    auth_username: user
+    auth_password: <secret>
+templates: []
+`
+
+	got, err := redactConfig([]byte(in))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(expected, string(got)); diff != "" {
+		t.Fatalf("unexpected configuration (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"path"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	clusterTLSConfigDir  = "/etc/alertmanager/cluster_tls_config"
+	clusterTLSConfigFile = "cluster-tls-config.yaml"
+)
+
+// clusterTLSConfig is the TLS configuration file of the Alertmanager
+// cluster's gossip protocol.
+// See https://github.com/prometheus/alertmanager/blob/main/docs/https.md#gossip-traffic
+type clusterTLSConfig struct {
+	TLSServerConfig *webTLSServerConfig     `yaml:"tls_server_config"`
+	TLSClientConfig *clusterTLSClientConfig `yaml:"tls_client_config"`
+}
+
+type clusterTLSClientConfig struct {
+	CAFile             string `yaml:"ca_file,omitempty"`
+	CertFile           string `yaml:"cert_file,omitempty"`
+	KeyFile            string `yaml:"key_file,omitempty"`
+	ServerName         string `yaml:"server_name,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+// makeClusterTLSConfig returns the cluster TLS configuration file of the
+// given Alertmanager. The TLS assets referenced by the configuration are
+// added to the store and they're read from the TLS assets directory.
+func makeClusterTLSConfig(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.Store) ([]byte, error) {
+	version, err := semver.ParseTolerant(operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse alertmanager version")
+	}
+
+	if version.LT(semver.MustParse("0.24.0")) {
+		return nil, errors.Errorf("cluster TLS configuration requires Alertmanager >= 0.24.0 (current version: %s)", version)
+	}
+
+	tlsServerConfig, err := makeTLSServerConfig(ctx, am.Namespace, am.Spec.ClusterTLS.ServerTLS, store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cluster TLS server assets")
+	}
+
+	clientTLS := am.Spec.ClusterTLS.ClientTLS
+	if err := store.AddSafeTLSConfig(ctx, am.Namespace, &clientTLS); err != nil {
+		return nil, errors.Wrap(err, "failed to get cluster TLS client assets")
+	}
+
+	tlsClientConfig := &clusterTLSClientConfig{
+		ServerName:         clientTLS.ServerName,
+		InsecureSkipVerify: clientTLS.InsecureSkipVerify,
+	}
+	if clientTLS.CA != (monitoringv1.SecretOrConfigMap{}) {
+		tlsClientConfig.CAFile = path.Join(tlsAssetsDir, assets.TLSAssetKeyFromSelector(am.Namespace, clientTLS.CA).String())
+	}
+	if clientTLS.Cert != (monitoringv1.SecretOrConfigMap{}) {
+		tlsClientConfig.CertFile = path.Join(tlsAssetsDir, assets.TLSAssetKeyFromSelector(am.Namespace, clientTLS.Cert).String())
+	}
+	if clientTLS.KeySecret != nil {
+		tlsClientConfig.KeyFile = path.Join(tlsAssetsDir, assets.TLSAssetKeyFromSecretSelector(am.Namespace, clientTLS.KeySecret).String())
+	}
+
+	return yaml.Marshal(clusterTLSConfig{
+		TLSServerConfig: tlsServerConfig,
+		TLSClientConfig: tlsClientConfig,
+	})
+}
+
+func (c *Operator) createOrUpdateClusterTLSConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.Store) error {
+	if am.Spec.ClusterTLS == nil {
+		return nil
+	}
+
+	conf, err := makeClusterTLSConfig(ctx, am, store)
+	if err != nil {
+		return err
+	}
+
+	return c.createOrUpdateConfigFileSecret(ctx, am, clusterTLSConfigSecretName(am.Name), clusterTLSConfigFile, conf)
+}
+
+func clusterTLSConfigSecretName(name string) string {
+	return prefixedName(name) + "-cluster-tls-config"
+}
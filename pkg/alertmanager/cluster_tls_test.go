@@ -0,0 +1,134 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMakeClusterTLSConfig(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-tls",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"ca.crt":  []byte(caPEM),
+				"tls.crt": []byte(certPEM),
+				"tls.key": []byte(keyPEM),
+			},
+		},
+	)
+
+	selector := func(key string) *v1.SecretKeySelector {
+		return &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "cluster-tls"},
+			Key:                  key,
+		}
+	}
+
+	clusterTLS := &monitoringv1.ClusterTLSConfig{
+		ServerTLS: monitoringv1.WebTLSConfig{
+			Cert:           monitoringv1.SecretOrConfigMap{Secret: selector("tls.crt")},
+			KeySecret:      *selector("tls.key"),
+			ClientCA:       monitoringv1.SecretOrConfigMap{Secret: selector("ca.crt")},
+			ClientAuthType: "RequireAndVerifyClientCert",
+		},
+		ClientTLS: monitoringv1.SafeTLSConfig{
+			CA:         monitoringv1.SecretOrConfigMap{Secret: selector("ca.crt")},
+			Cert:       monitoringv1.SecretOrConfigMap{Secret: selector("tls.crt")},
+			KeySecret:  selector("tls.key"),
+			ServerName: "alertmanager",
+		},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		version    string
+		clusterTLS *monitoringv1.ClusterTLSConfig
+		ok         bool
+		expected   string
+	}{
+		{
+			name:       "valid",
+			version:    "v0.24.0",
+			clusterTLS: clusterTLS,
+			ok:         true,
+			expected: `tls_server_config:
+  cert_file: /etc/alertmanager/certs/secret_ns1_cluster-tls_tls.crt
+  key_file: /etc/alertmanager/certs/secret_ns1_cluster-tls_tls.key
+  client_auth_type: RequireAndVerifyClientCert
+  client_ca_file: /etc/alertmanager/certs/secret_ns1_cluster-tls_ca.crt
+tls_client_config:
+  ca_file: /etc/alertmanager/certs/secret_ns1_cluster-tls_ca.crt
+  cert_file: /etc/alertmanager/certs/secret_ns1_cluster-tls_tls.crt
+  key_file: /etc/alertmanager/certs/secret_ns1_cluster-tls_tls.key
+  server_name: alertmanager
+  insecure_skip_verify: false
+`,
+		},
+		{
+			name:       "unsupported version",
+			version:    "v0.23.0",
+			clusterTLS: clusterTLS,
+			ok:         false,
+		},
+		{
+			name:    "missing client key",
+			version: "v0.24.0",
+			clusterTLS: &monitoringv1.ClusterTLSConfig{
+				ServerTLS: clusterTLS.ServerTLS,
+				ClientTLS: monitoringv1.SafeTLSConfig{
+					Cert:      monitoringv1.SecretOrConfigMap{Secret: selector("tls.crt")},
+					KeySecret: selector("not-existing"),
+				},
+			},
+			ok: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "am",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					Version:    tc.version,
+					ClusterTLS: tc.clusterTLS,
+				},
+			}
+
+			store := assets.NewStore(c.CoreV1(), c.CoreV1())
+			b, err := makeClusterTLSConfig(context.Background(), am, store)
+			if !tc.ok {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package alertmanager
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net"
@@ -32,6 +33,7 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/listwatch"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 
+	"github.com/blang/semver/v4"
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 	"github.com/pkg/errors"
@@ -43,7 +45,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -51,6 +56,17 @@ import (
 
 const (
 	resyncPeriod = 5 * time.Minute
+
+	// Reasons of the Accepted condition reported in the AlertmanagerConfig status.
+	acceptedReason             = "Accepted"
+	invalidConfigurationReason = "InvalidConfiguration"
+
+	// Reasons of the conditions reported in the Alertmanager status.
+	noPodReadyReason              = "NoPodReady"
+	somePodsNotReadyReason        = "SomePodsNotReady"
+	pausedReason                  = "Paused"
+	reconciliationFailedReason    = "ReconciliationFailed"
+	reconciliationSucceededReason = "ReconciliationSucceeded"
 )
 
 var (
@@ -73,12 +89,17 @@ type Operator struct {
 
 	alrtInfs    *informers.ForResource
 	alrtCfgInfs *informers.ForResource
-	secrInfs    *informers.ForResource
-	ssetInfs    *informers.ForResource
+	// clusterAlrtCfgInf is nil when the operator doesn't watch all
+	// namespaces.
+	clusterAlrtCfgInf cache.SharedIndexInformer
+	secrInfs          *informers.ForResource
+	cmapInfs          *informers.ForResource
+	ssetInfs          *informers.ForResource
 
 	queue workqueue.RateLimitingInterface
 
-	metrics *operator.Metrics
+	metrics       *operator.Metrics
+	eventRecorder operator.EventRecorder
 
 	config Config
 }
@@ -93,6 +114,12 @@ type Config struct {
 	Labels                       operator.Labels
 	AlertManagerSelector         string
 	SecretListWatchSelector      string
+	// AllowCrossNamespaceSecrets allows AlertmanagerConfig receivers to
+	// reference Secrets from any namespace.
+	AllowCrossNamespaceSecrets bool
+	// DefaultSendResolved is the default value of send_resolved for the
+	// receivers generated from AlertmanagerConfig resources.
+	DefaultSendResolved *bool
 }
 
 // New creates a new controller.
@@ -128,6 +155,8 @@ func New(ctx context.Context, c operator.Config, logger log.Logger, r prometheus
 			Labels:                       c.Labels,
 			AlertManagerSelector:         c.AlertManagerSelector,
 			SecretListWatchSelector:      c.SecretListWatchSelector,
+			AllowCrossNamespaceSecrets:   c.AlertmanagerConfigAllowCrossNamespaceSecrets,
+			DefaultSendResolved:          c.AlertmanagerConfigDefaultSendResolved,
 		},
 	}
 
@@ -145,6 +174,8 @@ func (c *Operator) bootstrap(ctx context.Context) error {
 		return errors.Wrap(err, "can not parse alertmanager selector value")
 	}
 
+	c.eventRecorder = operator.NewEventRecorder(c.kclient, "alertmanager", c.logger)
+
 	c.alrtInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.config.Namespaces.AlertmanagerAllowList,
@@ -175,6 +206,22 @@ func (c *Operator) bootstrap(ctx context.Context) error {
 		return errors.Wrap(err, "error creating alertmanagerconfig informers")
 	}
 
+	// ClusterAlertmanagerConfig objects are cluster-scoped, watching them
+	// requires access to all namespaces.
+	if listwatch.IsAllNamespaces(c.config.Namespaces.AllowList) {
+		c.clusterAlrtCfgInf = cache.NewSharedIndexInformer(
+			c.metrics.NewInstrumentedListerWatcher(&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					return c.mclient.MonitoringV1alpha1().ClusterAlertmanagerConfigs().List(ctx, options)
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return c.mclient.MonitoringV1alpha1().ClusterAlertmanagerConfigs().Watch(ctx, options)
+				},
+			}),
+			&monitoringv1alpha1.ClusterAlertmanagerConfig{}, resyncPeriod, cache.Indexers{},
+		)
+	}
+
 	secretListWatchSelector, err := fields.ParseSelector(c.config.SecretListWatchSelector)
 	if err != nil {
 		return errors.Wrap(err, "can not parse secrets selector value")
@@ -195,6 +242,20 @@ func (c *Operator) bootstrap(ctx context.Context) error {
 		return errors.Wrap(err, "error creating secret informers")
 	}
 
+	c.cmapInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.config.Namespaces.AlertmanagerAllowList,
+			c.config.Namespaces.DenyList,
+			c.kclient,
+			resyncPeriod,
+			nil,
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceConfigMaps)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "error creating configmap informers")
+	}
+
 	c.ssetInfs, err = informers.NewInformersForResource(
 		informers.NewKubeInformerFactories(
 			c.config.Namespaces.AlertmanagerAllowList,
@@ -251,6 +312,7 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"Alertmanager", c.alrtInfs},
 		{"AlertmanagerConfig", c.alrtCfgInfs},
 		{"Secret", c.secrInfs},
+		{"ConfigMap", c.cmapInfs},
 		{"StatefulSet", c.ssetInfs},
 	} {
 		for _, inf := range infs.informersForResource.GetInformers() {
@@ -266,7 +328,12 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 	}{
 		{"AlertmanagerNamespace", c.nsAlrtInf},
 		{"AlertmanagerConfigNamespace", c.nsAlrtCfgInf},
+		{"ClusterAlertmanagerConfig", c.clusterAlrtCfgInf},
 	} {
+		if inf.informer == nil {
+			continue
+		}
+
 		if !operator.WaitForNamedCacheSync(ctx, "alertmanager", log.With(c.logger, "informer", inf.name), inf.informer) {
 			ok = false
 		}
@@ -292,11 +359,23 @@ func (c *Operator) addHandlers() {
 		DeleteFunc: c.handleAlertmanagerConfigDelete,
 		UpdateFunc: c.handleAlertmanagerConfigUpdate,
 	})
+	if c.clusterAlrtCfgInf != nil {
+		c.clusterAlrtCfgInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleClusterAlertmanagerConfigAdd,
+			DeleteFunc: c.handleClusterAlertmanagerConfigDelete,
+			UpdateFunc: c.handleClusterAlertmanagerConfigUpdate,
+		})
+	}
 	c.secrInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handleSecretAdd,
 		DeleteFunc: c.handleSecretDelete,
 		UpdateFunc: c.handleSecretUpdate,
 	})
+	c.cmapInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleConfigMapAdd,
+		DeleteFunc: c.handleConfigMapDelete,
+		UpdateFunc: c.handleConfigMapUpdate,
+	})
 	c.ssetInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handleStatefulSetAdd,
 		DeleteFunc: c.handleStatefulSetDelete,
@@ -333,11 +412,61 @@ func (c *Operator) handleAlertmanagerConfigDelete(obj interface{}) {
 	if ok {
 		level.Debug(c.logger).Log("msg", "AlertmanagerConfig delete")
 		c.metrics.TriggerByCounter(monitoringv1alpha1.AlertmanagerConfigKind, "delete").Inc()
+		c.metrics.ForgetRejectedResource(monitoringv1alpha1.AlertmanagerConfigKind, o.GetNamespace(), o.GetName())
 
 		c.enqueueForNamespace(o.GetNamespace())
 	}
 }
 
+func (c *Operator) handleClusterAlertmanagerConfigAdd(obj interface{}) {
+	if _, ok := c.getObject(obj); ok {
+		level.Debug(c.logger).Log("msg", "ClusterAlertmanagerConfig added")
+		c.metrics.TriggerByCounter(monitoringv1alpha1.ClusterAlertmanagerConfigKind, "add").Inc()
+
+		c.enqueueForClusterAlertmanagerConfigs()
+	}
+}
+
+func (c *Operator) handleClusterAlertmanagerConfigUpdate(old, cur interface{}) {
+	if old.(*monitoringv1alpha1.ClusterAlertmanagerConfig).ResourceVersion == cur.(*monitoringv1alpha1.ClusterAlertmanagerConfig).ResourceVersion {
+		return
+	}
+
+	if _, ok := c.getObject(cur); ok {
+		level.Debug(c.logger).Log("msg", "ClusterAlertmanagerConfig updated")
+		c.metrics.TriggerByCounter(monitoringv1alpha1.ClusterAlertmanagerConfigKind, "update").Inc()
+
+		c.enqueueForClusterAlertmanagerConfigs()
+	}
+}
+
+func (c *Operator) handleClusterAlertmanagerConfigDelete(obj interface{}) {
+	if o, ok := c.getObject(obj); ok {
+		level.Debug(c.logger).Log("msg", "ClusterAlertmanagerConfig delete")
+		c.metrics.TriggerByCounter(monitoringv1alpha1.ClusterAlertmanagerConfigKind, "delete").Inc()
+		c.metrics.ForgetRejectedResource(monitoringv1alpha1.ClusterAlertmanagerConfigKind, "", o.GetName())
+
+		c.enqueueForClusterAlertmanagerConfigs()
+	}
+}
+
+// enqueueForClusterAlertmanagerConfigs enqueues all Alertmanager object keys
+// that select ClusterAlertmanagerConfig objects.
+func (c *Operator) enqueueForClusterAlertmanagerConfigs() {
+	err := c.alrtInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if am.Spec.ClusterAlertmanagerConfigSelector != nil {
+			c.enqueue(am)
+		}
+	})
+	if err != nil {
+		level.Error(c.logger).Log(
+			"msg", "listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // TODO: Do we need to enqueue secrets just for the namespace or in general?
 func (c *Operator) handleSecretDelete(obj interface{}) {
 	o, ok := c.getObject(obj)
@@ -373,6 +502,75 @@ func (c *Operator) handleSecretAdd(obj interface{}) {
 	}
 }
 
+func (c *Operator) handleConfigMapAdd(obj interface{}) {
+	if o, ok := c.getObject(obj); ok {
+		level.Debug(c.logger).Log("msg", "ConfigMap added")
+		c.metrics.TriggerByCounter("ConfigMap", "add").Inc()
+
+		c.enqueueForTemplateConfigMap(o)
+	}
+}
+
+func (c *Operator) handleConfigMapUpdate(old, cur interface{}) {
+	if old.(*v1.ConfigMap).ResourceVersion == cur.(*v1.ConfigMap).ResourceVersion {
+		return
+	}
+
+	// The labels may have changed, both the old and the new objects need to
+	// be considered.
+	oldObj, ok := c.getObject(old)
+	if !ok {
+		return
+	}
+	if o, ok := c.getObject(cur); ok {
+		level.Debug(c.logger).Log("msg", "ConfigMap updated")
+		c.metrics.TriggerByCounter("ConfigMap", "update").Inc()
+
+		c.enqueueForTemplateConfigMap(oldObj)
+		c.enqueueForTemplateConfigMap(o)
+	}
+}
+
+func (c *Operator) handleConfigMapDelete(obj interface{}) {
+	if o, ok := c.getObject(obj); ok {
+		level.Debug(c.logger).Log("msg", "ConfigMap deleted")
+		c.metrics.TriggerByCounter("ConfigMap", "delete").Inc()
+
+		c.enqueueForTemplateConfigMap(o)
+	}
+}
+
+// enqueueForTemplateConfigMap enqueues the keys of the Alertmanager objects
+// in the namespace of the ConfigMap whose template ConfigMap selector
+// matches it.
+func (c *Operator) enqueueForTemplateConfigMap(cm metav1.Object) {
+	err := c.alrtInfs.ListAllByNamespace(cm.GetNamespace(), labels.Everything(), func(obj interface{}) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if am.Spec.TemplateConfigMapSelector == nil {
+			return
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(am.Spec.TemplateConfigMapSelector)
+		if err != nil {
+			level.Error(c.logger).Log(
+				"msg", fmt.Sprintf("failed to convert TemplateConfigMapSelector of %q to selector", am.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if selector.Matches(labels.Set(cm.GetLabels())) {
+			c.enqueue(am)
+		}
+	})
+	if err != nil {
+		level.Error(c.logger).Log(
+			"msg", "listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // enqueueForNamespace enqueues all Alertmanager object keys that belong to the
 // given namespace or select objects in the given namespace.
 func (c *Operator) enqueueForNamespace(nsName string) {
@@ -454,8 +652,12 @@ func (c *Operator) Run(ctx context.Context) error {
 	go c.alrtInfs.Start(ctx.Done())
 	go c.alrtCfgInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
+	go c.cmapInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
 	go c.nsAlrtCfgInf.Run(ctx.Done())
+	if c.clusterAlrtCfgInf != nil {
+		go c.clusterAlrtCfgInf.Run(ctx.Done())
+	}
 	if c.nsAlrtInf != c.nsAlrtCfgInf {
 		go c.nsAlrtInf.Run(ctx.Done())
 	}
@@ -656,28 +858,49 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	am.APIVersion = monitoringv1.SchemeGroupVersion.String()
 	am.Kind = monitoringv1.AlertmanagersKind
 
+	err = c.reconcile(ctx, key, am)
+	c.updateAlertmanagerStatus(ctx, am, err)
+
+	return err
+}
+
+func (c *Operator) reconcile(ctx context.Context, key string, am *monitoringv1.Alertmanager) error {
 	if am.Spec.Paused {
 		return nil
 	}
 
 	level.Info(c.logger).Log("msg", "sync alertmanager", "key", key)
 
-	assetStore := assets.NewStore(c.kclient.CoreV1(), c.kclient.CoreV1())
+	// Secrets are read from the informers' cache to avoid hitting the API
+	// server for every secret reference of the AlertmanagerConfig objects.
+	assetStore := assets.NewStore(c.kclient.CoreV1(), assets.NewCachedSecretsGetter(c.secrInfs, c.kclient.CoreV1()))
 
 	if err := c.provisionAlertmanagerConfiguration(ctx, am, assetStore); err != nil {
 		return errors.Wrap(err, "provision alertmanager configuration")
 	}
 
+	if err := c.createOrUpdateWebConfigSecret(ctx, am, assetStore); err != nil {
+		return errors.Wrap(err, "creating web config secret failed")
+	}
+
+	if err := c.createOrUpdateClusterTLSConfigSecret(ctx, am, assetStore); err != nil {
+		return errors.Wrap(err, "creating cluster TLS config secret failed")
+	}
+
 	if err := c.createOrUpdateTLSAssetSecret(ctx, am, assetStore); err != nil {
 		return errors.Wrap(err, "creating tls asset secret failed")
 	}
 
 	// Create governing service if it doesn't exist.
 	svcClient := c.kclient.CoreV1().Services(am.Namespace)
-	if err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(am, c.config)); err != nil {
+	if err := k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(am, c.config)); err != nil {
 		return errors.Wrap(err, "synchronizing governing service failed")
 	}
 
+	if err := c.createOrUpdatePodDisruptionBudget(ctx, am); err != nil {
+		return errors.Wrap(err, "synchronizing pod disruption budget failed")
+	}
+
 	ssetClient := c.kclient.AppsV1().StatefulSets(am.Namespace)
 	// Ensure we have a StatefulSet running Alertmanager deployed.
 	obj, err := c.ssetInfs.Get(alertmanagerKeyToStatefulSetKey(key))
@@ -724,7 +947,99 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	return nil
 }
 
+// createOrUpdatePodDisruptionBudget reconciles the PodDisruptionBudget of the
+// Alertmanager pods. The PodDisruptionBudget is deleted when it isn't
+// configured anymore, provided that it's owned by the Alertmanager object.
+func (c *Operator) createOrUpdatePodDisruptionBudget(ctx context.Context, am *monitoringv1.Alertmanager) error {
+	pdbClient := c.kclient.PolicyV1beta1().PodDisruptionBudgets(am.Namespace)
+
+	if am.Spec.PodDisruptionBudget == nil {
+		pdb, err := pdbClient.Get(ctx, prefixedName(am.Name), metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return errors.Wrap(err, "retrieving pod disruption budget failed")
+		}
+
+		for _, ref := range pdb.GetOwnerReferences() {
+			if ref.UID == am.GetUID() {
+				if err := pdbClient.Delete(ctx, pdb.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return errors.Wrap(err, "deleting pod disruption budget failed")
+				}
+				break
+			}
+		}
+
+		return nil
+	}
+
+	pdb, err := makePodDisruptionBudget(am, c.config)
+	if err != nil {
+		return err
+	}
+
+	return k8sutil.CreateOrUpdatePodDisruptionBudget(ctx, pdbClient, pdb)
+}
+
 func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.Store) error {
+	conf, additionalData, err := c.generateAlertmanagerConfiguration(ctx, am, store, false, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.createOrUpdateGeneratedConfigSecret(ctx, am, conf, additionalData); err != nil {
+		return errors.Wrap(err, "create or update generated config secret failed")
+	}
+
+	return nil
+}
+
+// RenderConfig returns the Alertmanager configuration which would be
+// generated for the given Alertmanager resource. Contrary to the
+// reconciliation loop, it neither provisions the configuration nor updates
+// the status of the AlertmanagerConfig objects.
+//
+// If pending isn't nil, it is merged as if it existed in the cluster,
+// replacing the object with the same namespace and name if any. An error is
+// returned if pending isn't selected by the Alertmanager resource or if it is
+// invalid.
+//
+// The credentials of the returned configuration are redacted.
+func (c *Operator) RenderConfig(ctx context.Context, namespace, name string, pending *monitoringv1alpha1.AlertmanagerConfig) ([]byte, error) {
+	aobj, err := c.alrtInfs.Get(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+
+	am := aobj.(*monitoringv1.Alertmanager).DeepCopy()
+	store := assets.NewStore(c.kclient.CoreV1(), assets.NewCachedSecretsGetter(c.secrInfs, c.kclient.CoreV1()))
+
+	if pending != nil {
+		pending = pending.DeepCopy()
+		if pending.Namespace == "" {
+			pending.Namespace = am.Namespace
+		}
+	}
+
+	conf, _, err := c.generateAlertmanagerConfiguration(ctx, am, store, true, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	return redactConfig(conf)
+}
+
+// generateAlertmanagerConfiguration returns the Alertmanager configuration
+// and the additional files of the generated config secret. When dryRun is
+// true, the status, events and metrics of the AlertmanagerConfig objects
+// aren't updated and pending (if not nil) is merged with the
+// AlertmanagerConfig objects existing in the cluster.
+func (c *Operator) generateAlertmanagerConfiguration(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.Store, dryRun bool, pending *monitoringv1alpha1.AlertmanagerConfig) ([]byte, map[string][]byte, error) {
+	if pending != nil && am.Spec.AlertmanagerConfigSelector == nil {
+		return nil, nil, errors.Errorf("AlertmanagerConfig %s/%s isn't selected by the Alertmanager", pending.Namespace, pending.Name)
+	}
+
 	secretName := defaultConfigSecretName(am.Name)
 	if am.Spec.ConfigSecret != "" {
 		secretName = am.Spec.ConfigSecret
@@ -734,7 +1049,7 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 	// configuration.
 	secret, err := c.kclient.CoreV1().Secrets(am.Namespace).Get(ctx, secretName, metav1.GetOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
-		return errors.Wrap(err, "get base configuration secret")
+		return nil, nil, errors.Wrap(err, "get base configuration secret")
 	}
 
 	var secretData map[string][]byte
@@ -742,6 +1057,47 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		secretData = secret.Data
 	}
 
+	generator, err := newConfigGenerator(c.logger, store, am, c.config.DefaultSendResolved)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// When the global configuration is defined by an AlertmanagerConfig
+	// object, the configuration from the secret is ignored.
+	if am.Spec.AlertmanagerConfiguration != nil {
+		globalConfig, err := c.getGlobalAlertmanagerConfig(ctx, am, generator.amVersion, store, dryRun)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := configureGlobalConfigInStore(ctx, am.Spec.AlertmanagerConfiguration.Global, am.Namespace, store); err != nil {
+			return nil, nil, errors.Wrap(err, "invalid global configuration")
+		}
+
+		baseConfig, err := generator.generateGlobalConfig(ctx, am.Spec.AlertmanagerConfiguration, globalConfig)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "generating global Alertmanager config failed")
+		}
+
+		// When the config secret is explicitly set, its configuration is used
+		// as the base on top of which the global configuration is applied.
+		if am.Spec.ConfigSecret != "" && len(secretData[alertmanagerConfigFile]) > 0 {
+			userConfig, err := loadCfg(string(secretData[alertmanagerConfigFile]))
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "base config from Secret could not be parsed")
+			}
+
+			baseConfig = overrideConfig(userConfig, baseConfig)
+		}
+
+		secretData, err = loadTemplates(ctx, store, secretData, []*monitoringv1alpha1.AlertmanagerConfig{globalConfig})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "loading templates of global AlertmanagerConfig failed")
+		}
+
+		return c.generateMergedConfig(ctx, am, generator, *baseConfig, store, secretData, dryRun, pending)
+	}
+
 	rawBaseConfig := []byte(`route:
   receiver: 'null'
 receivers:
@@ -760,41 +1116,163 @@ receivers:
 
 	baseConfig, err := loadCfg(string(rawBaseConfig))
 	if err != nil {
-		return errors.Wrap(err, "base config from Secret could not be parsed")
+		return nil, nil, errors.Wrap(err, "base config from Secret could not be parsed")
 	}
 
-	// If no AlertmanagerConfig selectors are configured, the user wants to
-	// manage configuration themselves.
-	if am.Spec.AlertmanagerConfigSelector == nil {
+	// If neither AlertmanagerConfig nor template ConfigMap selectors are
+	// configured, the user wants to manage configuration themselves.
+	if am.Spec.AlertmanagerConfigSelector == nil && am.Spec.ClusterAlertmanagerConfigSelector == nil && am.Spec.TemplateConfigMapSelector == nil {
 		level.Debug(c.logger).Log("msg", "no AlertmanagerConfig selector specified, copying base config as-is",
 			"base config secret", secretName, "mounted config secret", generatedConfigSecretName(am.Name),
 			"alertmanager", am.Name, "namespace", am.Namespace,
 		)
 
-		err = c.createOrUpdateGeneratedConfigSecret(ctx, am, rawBaseConfig, secretData)
+		return rawBaseConfig, secretData, nil
+	}
+
+	return c.generateMergedConfig(ctx, am, generator, *baseConfig, store, secretData, dryRun, pending)
+}
+
+// generateMergedConfig merges the selected AlertmanagerConfig objects into
+// the base configuration. It returns the resulting configuration and the
+// additional files of the generated config secret.
+func (c *Operator) generateMergedConfig(
+	ctx context.Context,
+	am *monitoringv1.Alertmanager,
+	generator *configGenerator,
+	baseConfig alertmanagerConfig,
+	store *assets.Store,
+	secretData map[string][]byte,
+	dryRun bool,
+	pending *monitoringv1alpha1.AlertmanagerConfig,
+) ([]byte, map[string][]byte, error) {
+	var (
+		clusterConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig
+		amConfigs      map[string]*monitoringv1alpha1.AlertmanagerConfig
+		err            error
+	)
+
+	if am.Spec.ClusterAlertmanagerConfigSelector != nil {
+		clusterConfigs, err = c.selectClusterAlertmanagerConfigs(ctx, am, generator.amVersion, store, &baseConfig, dryRun)
 		if err != nil {
-			return errors.Wrap(err, "create or update generated config secret failed")
+			return nil, nil, errors.Wrap(err, "selecting ClusterAlertmanagerConfigs failed")
 		}
-		return nil
 	}
 
-	amConfigs, err := c.selectAlertmanagerConfigs(ctx, am, store)
+	if am.Spec.AlertmanagerConfigSelector != nil {
+		amConfigs, err = c.selectAlertmanagerConfigs(ctx, am, generator.amVersion, store, withGeneratedNames(&baseConfig, clusterConfigs), dryRun, pending)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "selecting AlertmanagerConfigs failed")
+		}
+	}
+
+	templateFiles, err := c.selectTemplateConfigMaps(am)
 	if err != nil {
-		return errors.Wrap(err, "selecting AlertmanagerConfigs failed")
+		return nil, nil, errors.Wrap(err, "selecting template ConfigMaps failed")
 	}
+	baseConfig.Templates = appendTemplateFiles(baseConfig.Templates, templateFiles)
 
-	generator := newConfigGenerator(c.logger, store)
-	generatedConfig, err := generator.generateConfig(ctx, *baseConfig, amConfigs)
+	generatedConfig, err := generator.generateConfig(ctx, baseConfig, clusterConfigs, amConfigs)
 	if err != nil {
-		return errors.Wrap(err, "generating Alertmanager config yaml failed")
+		return nil, nil, errors.Wrap(err, "generating Alertmanager config yaml failed")
 	}
 
-	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, generatedConfig, secretData)
+	selected := make([]*monitoringv1alpha1.AlertmanagerConfig, 0, len(clusterConfigs)+len(amConfigs))
+	for _, amc := range clusterConfigs {
+		selected = append(selected, amc)
+	}
+	for _, amc := range amConfigs {
+		selected = append(selected, amc)
+	}
+
+	secretData, err = loadTemplates(ctx, store, secretData, selected)
 	if err != nil {
-		return errors.Wrap(err, "create or update generated config secret failed")
+		return nil, nil, errors.Wrap(err, "loading AlertmanagerConfig templates failed")
 	}
 
-	return nil
+	for k, v := range templateFiles {
+		secretData[k] = v
+	}
+
+	// The credentials referenced by *_file fields are stored next to the
+	// generated configuration.
+	for k, v := range generator.credentials {
+		secretData[k] = v
+	}
+
+	return generatedConfig, secretData, nil
+}
+
+// loadTemplates returns a copy of data augmented with the content of the
+// templates referenced by the given AlertmanagerConfig objects.
+func loadTemplates(ctx context.Context, store *assets.Store, data map[string][]byte, amConfigs []*monitoringv1alpha1.AlertmanagerConfig) (map[string][]byte, error) {
+	out := make(map[string][]byte, len(data))
+	for k, v := range data {
+		out[k] = v
+	}
+
+	for _, amc := range amConfigs {
+		crKey := types.NamespacedName{Name: amc.Name, Namespace: amc.Namespace}
+		for _, tmpl := range amc.Spec.Templates {
+			content, err := store.GetKey(ctx, amc.Namespace, tmpl)
+			if err != nil {
+				return nil, errors.Wrapf(err, "AlertmanagerConfig %s", crKey.String())
+			}
+			out[templateFileName(tmpl, crKey)] = []byte(content)
+		}
+	}
+
+	return out, nil
+}
+
+// selectTemplateConfigMaps returns the template files built from the
+// ConfigMaps selected by the template ConfigMap selector of the Alertmanager.
+func (c *Operator) selectTemplateConfigMaps(am *monitoringv1.Alertmanager) (map[string][]byte, error) {
+	if am.Spec.TemplateConfigMapSelector == nil {
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.TemplateConfigMapSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid template ConfigMap selector")
+	}
+
+	var cms []*v1.ConfigMap
+	err = c.cmapInfs.ListAllByNamespace(am.Namespace, selector, func(obj interface{}) {
+		cms = append(cms, obj.(*v1.ConfigMap))
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list ConfigMaps")
+	}
+
+	level.Debug(c.logger).Log("msg", "selected template ConfigMaps", "configmaps", len(cms), "namespace", am.Namespace, "alertmanager", am.Name)
+
+	return makeTemplateFiles(cms), nil
+}
+
+// getGlobalAlertmanagerConfig returns the AlertmanagerConfig object
+// referenced by the alertmanagerConfiguration field after checking that it is
+// valid. Unless dryRun is true, the status of the object is updated.
+func (c *Operator) getGlobalAlertmanagerConfig(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.Store, dryRun bool) (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	name := am.Spec.AlertmanagerConfiguration.Name
+
+	amc, err := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(am.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get global AlertmanagerConfig %q", name)
+	}
+
+	err = c.checkSecretNamespaces(amc)
+	if err == nil {
+		err = checkGlobalAlertmanagerConfig(ctx, amc, amVersion, store)
+	}
+	if !dryRun {
+		c.updateAlertmanagerConfigStatus(ctx, amc, err)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid global AlertmanagerConfig %q", name)
+	}
+
+	return amc, nil
 }
 
 func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte) error {
@@ -820,35 +1298,54 @@ func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *
 	}
 
 	for k, v := range additionalData {
+		// The user-provided configuration has been merged into the
+		// generated configuration already.
+		if k == alertmanagerConfigFile {
+			continue
+		}
 		generatedConfigSecret.Data[k] = v
 	}
-	generatedConfigSecret.Data[alertmanagerConfigFile] = conf
 
-	_, err := sClient.Get(ctx, generatedConfigSecret.Name, metav1.GetOptions{})
-	if err != nil {
-		if !apierrors.IsNotFound(err) {
-			return errors.Wrapf(
-				err,
-				"failed to check whether generated config secret already exists for Alertmanager %v in namespace %v",
-				am.Name,
-				am.Namespace,
-			)
-		}
-		_, err = sClient.Create(ctx, generatedConfigSecret, metav1.CreateOptions{})
-		level.Debug(c.logger).Log("msg", "created generated config secret", "secretname", generatedConfigSecret.Name)
-	} else {
-		_, err = sClient.Update(ctx, generatedConfigSecret, metav1.UpdateOptions{})
-		level.Debug(c.logger).Log("msg", "updated generated config secret", "secretname", generatedConfigSecret.Name)
+	var buf bytes.Buffer
+	if err := operator.GzipConfig(&buf, conf); err != nil {
+		return errors.Wrap(err, "couldn't gzip config")
+	}
+	generatedConfigSecret.Data[alertmanagerConfigFileCompressed] = buf.Bytes()
+
+	var size int
+	for k, v := range generatedConfigSecret.Data {
+		size += len(k) + len(v)
+	}
+	c.metrics.SetGeneratedConfigSize(fmt.Sprintf("%s/%s", am.Namespace, am.Name), size)
+	if size > v1.MaxSecretSize {
+		return errors.Errorf(
+			"generated config secret for Alertmanager %v in namespace %v is too large: %d bytes (maximum: %d bytes)",
+			am.Name,
+			am.Namespace,
+			size,
+			v1.MaxSecretSize,
+		)
 	}
 
+	updated, err := k8sutil.CreateOrUpdateSecret(ctx, sClient, generatedConfigSecret)
 	if err != nil {
 		return errors.Wrapf(err, "failed to update generated config secret for Alertmanager %v in namespace %v", am.Name, am.Namespace)
 	}
 
+	if !updated {
+		level.Debug(c.logger).Log("msg", "updating generated config secret skipped, no configuration change", "secretname", generatedConfigSecret.Name)
+		return nil
+	}
+
+	level.Debug(c.logger).Log("msg", "updated generated config secret", "secretname", generatedConfigSecret.Name)
 	return nil
 }
 
-func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.Store) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
+// selectAlertmanagerConfigs returns the valid AlertmanagerConfig objects
+// selected by the Alertmanager resource. If pending isn't nil, it is selected
+// in place of the object with the same key and an error is returned when it
+// isn't selected or invalid.
+func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.Store, baseConfig *alertmanagerConfig, dryRun bool, pending *monitoringv1alpha1.AlertmanagerConfig) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
 	namespaces := []string{}
 
 	// If 'AlertmanagerConfigNamespaceSelector' is nil, only check own namespace.
@@ -889,31 +1386,94 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 		})
 	}
 
-	var rejected int
-	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(amConfigs))
-	for namespaceAndName, amc := range amConfigs {
-		if err := checkAlertmanagerConfig(ctx, amc, store); err != nil {
-			rejected++
-			level.Warn(c.logger).Log(
-				"msg", "skipping alertmanagerconfig",
-				"error", err.Error(),
-				"alertmanagerconfig", namespaceAndName,
-				"namespace", am.Namespace,
-				"alertmanager", am.Name,
-			)
-			continue
+	var pendingKey string
+	if pending != nil {
+		pendingKey = pending.Namespace + "/" + pending.Name
+		delete(amConfigs, pendingKey)
+
+		selected := amConfigSelector.Matches(labels.Set(pending.Labels))
+		if selected {
+			selected = false
+			for _, ns := range namespaces {
+				if ns == pending.Namespace {
+					selected = true
+					break
+				}
+			}
+		}
+		if !selected {
+			return nil, errors.Errorf("AlertmanagerConfig %s isn't selected by the Alertmanager", pendingKey)
 		}
 
-		res[namespaceAndName] = amc
+		amConfigs[pendingKey] = pending
 	}
 
-	amcKeys := []string{}
-	for k := range res {
-		amcKeys = append(amcKeys, k)
+	// The AlertmanagerConfig object defining the global configuration is
+	// never merged as a regular AlertmanagerConfig object.
+	if am.Spec.AlertmanagerConfiguration != nil {
+		globalKey := am.Namespace + "/" + am.Spec.AlertmanagerConfiguration.Name
+		if pendingKey == globalKey {
+			return nil, errors.Errorf("AlertmanagerConfig %s defines the global configuration of the Alertmanager", pendingKey)
+		}
+		delete(amConfigs, globalKey)
+	}
+
+	invalid := make(map[string]error)
+	valid := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(amConfigs))
+	for namespaceAndName, amc := range amConfigs {
+		err := c.checkSecretNamespaces(amc)
+		if err == nil {
+			err = checkAlertmanagerConfig(ctx, amc, amVersion, store)
+		}
+		if err != nil {
+			invalid[namespaceAndName] = err
+			continue
+		}
+		valid[namespaceAndName] = amc
+	}
+
+	// Only the valid objects are checked for name collisions.
+	for namespaceAndName, err := range checkNameCollisions(baseConfig, valid) {
+		invalid[namespaceAndName] = err
+	}
+
+	if err, ok := invalid[pendingKey]; ok {
+		return nil, errors.Wrapf(err, "AlertmanagerConfig %s is invalid", pendingKey)
+	}
+
+	var rejected int
+	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(amConfigs))
+	for namespaceAndName, amc := range amConfigs {
+		err := invalid[namespaceAndName]
+		if !dryRun {
+			c.updateAlertmanagerConfigStatus(ctx, amc, err)
+		}
+		if err != nil {
+			rejected++
+			level.Warn(c.logger).Log(
+				"msg", "skipping alertmanagerconfig",
+				"error", err.Error(),
+				"alertmanagerconfig", namespaceAndName,
+				"namespace", am.Namespace,
+				"alertmanager", am.Name,
+			)
+			if !dryRun {
+				c.metrics.RejectedResourceCounter(monitoringv1alpha1.AlertmanagerConfigKind, amc.Namespace, amc.Name).Inc()
+				c.eventRecorder.Eventf(amc, v1.EventTypeWarning, invalidConfigurationReason, "AlertmanagerConfig rejected by Alertmanager %s/%s: %v", am.Namespace, am.Name, err)
+			}
+			continue
+		}
+
+		res[namespaceAndName] = amc
+	}
+
+	amcKeys := []string{}
+	for k := range res {
+		amcKeys = append(amcKeys, k)
 	}
 	level.Debug(c.logger).Log("msg", "selected AlertmanagerConfigs", "alertmanagerconfigs", strings.Join(amcKeys, ","), "namespace", am.Namespace, "prometheus", am.Name)
 
-	if amKey, ok := c.keyFunc(am); ok {
+	if amKey, ok := c.keyFunc(am); ok && !dryRun {
 		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, len(res))
 		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, rejected)
 	}
@@ -921,18 +1481,409 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 	return res, nil
 }
 
+// selectClusterAlertmanagerConfigs returns the valid ClusterAlertmanagerConfig
+// objects selected by the Alertmanager resource, converted to
+// AlertmanagerConfig objects scoped to the Alertmanager's namespace.
+func (c *Operator) selectClusterAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.Store, baseConfig *alertmanagerConfig, dryRun bool) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
+	if c.clusterAlrtCfgInf == nil {
+		level.Warn(c.logger).Log(
+			"msg", "ignoring ClusterAlertmanagerConfig selector because the operator doesn't watch all namespaces",
+			"namespace", am.Namespace,
+			"alertmanager", am.Name,
+		)
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.ClusterAlertmanagerConfigSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterConfigs := make(map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig)
+	err = cache.ListAll(c.clusterAlrtCfgInf.GetStore(), selector, func(obj interface{}) {
+		camc := obj.(*monitoringv1alpha1.ClusterAlertmanagerConfig)
+		clusterConfigs[camc.Name] = camc
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list ClusterAlertmanagerConfigs")
+	}
+
+	invalid := make(map[string]error)
+	valid := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(clusterConfigs))
+	for name, camc := range clusterConfigs {
+		amc := alertmanagerConfigFromCluster(camc, am.Namespace)
+
+		err := c.checkSecretNamespaces(amc)
+		if err == nil {
+			err = checkAlertmanagerConfig(ctx, amc, amVersion, store)
+		}
+		if err != nil {
+			invalid[name] = err
+			continue
+		}
+		valid[name] = amc
+	}
+
+	for name, err := range checkNameCollisions(baseConfig, valid) {
+		invalid[name] = err
+	}
+
+	var rejected int
+	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(valid))
+	for name, camc := range clusterConfigs {
+		err := invalid[name]
+		if !dryRun {
+			c.updateClusterAlertmanagerConfigStatus(ctx, camc, err)
+		}
+		if err != nil {
+			rejected++
+			level.Warn(c.logger).Log(
+				"msg", "skipping clusteralertmanagerconfig",
+				"error", err.Error(),
+				"clusteralertmanagerconfig", name,
+				"namespace", am.Namespace,
+				"alertmanager", am.Name,
+			)
+			if !dryRun {
+				c.metrics.RejectedResourceCounter(monitoringv1alpha1.ClusterAlertmanagerConfigKind, "", name).Inc()
+				c.eventRecorder.Eventf(camc, v1.EventTypeWarning, invalidConfigurationReason, "ClusterAlertmanagerConfig rejected by Alertmanager %s/%s: %v", am.Namespace, am.Name, err)
+			}
+			continue
+		}
+
+		res[name] = valid[name]
+	}
+
+	if amKey, ok := c.keyFunc(am); ok && !dryRun {
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.ClusterAlertmanagerConfigKind, len(res))
+		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.ClusterAlertmanagerConfigKind, rejected)
+	}
+
+	return res, nil
+}
+
+// alertmanagerConfigFromCluster returns the AlertmanagerConfig equivalent of
+// the ClusterAlertmanagerConfig object. It is scoped to the given namespace
+// which is where the referenced Secrets and ConfigMaps are read from.
+func alertmanagerConfigFromCluster(camc *monitoringv1alpha1.ClusterAlertmanagerConfig, namespace string) *monitoringv1alpha1.AlertmanagerConfig {
+	return &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       camc.Name,
+			Namespace:  namespace,
+			Labels:     camc.Labels,
+			Generation: camc.Generation,
+		},
+		Spec: *camc.Spec.DeepCopy(),
+	}
+}
+
+// withGeneratedNames returns a copy of the base configuration which also
+// contains the receivers and time intervals generated from the given
+// AlertmanagerConfig objects. It is used to detect the name collisions with
+// objects which are merged afterwards.
+func withGeneratedNames(baseConfig *alertmanagerConfig, amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) *alertmanagerConfig {
+	if len(amConfigs) == 0 {
+		return baseConfig
+	}
+
+	out := *baseConfig
+	out.Receivers = append([]*receiver{}, baseConfig.Receivers...)
+	out.MuteTimeIntervals = append([]*muteTimeInterval{}, baseConfig.MuteTimeIntervals...)
+	for _, amc := range amConfigs {
+		crKey := types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name}
+
+		// Receivers are only merged when the object defines a route.
+		if amc.Spec.Route != nil {
+			for _, r := range amc.Spec.Receivers {
+				out.Receivers = append(out.Receivers, &receiver{Name: makeNamespacedString(r.Name, crKey)})
+			}
+		}
+
+		for _, mti := range amc.Spec.MuteTimeIntervals {
+			out.MuteTimeIntervals = append(out.MuteTimeIntervals, &muteTimeInterval{Name: makeNamespacedString(mti.Name, crKey)})
+		}
+	}
+
+	return &out
+}
+
+// updateAlertmanagerConfigStatus reports in the Accepted condition of the
+// AlertmanagerConfig object whether it passed the validation or not. The
+// object is updated only when the condition changes.
+func (c *Operator) updateAlertmanagerConfigStatus(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, checkErr error) {
+	status, changed := acceptedStatus(amc.Status, amc.Generation, checkErr)
+	if !changed {
+		return
+	}
+
+	amc = amc.DeepCopy()
+	amc.Status = status
+
+	if _, err := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(amc.Namespace).UpdateStatus(ctx, amc, metav1.UpdateOptions{}); err != nil {
+		level.Warn(c.logger).Log(
+			"msg", "failed to update alertmanagerconfig status",
+			"err", err,
+			"alertmanagerconfig", amc.Namespace+"/"+amc.Name,
+		)
+	}
+}
+
+// updateClusterAlertmanagerConfigStatus is the equivalent of
+// updateAlertmanagerConfigStatus for ClusterAlertmanagerConfig objects.
+func (c *Operator) updateClusterAlertmanagerConfigStatus(ctx context.Context, camc *monitoringv1alpha1.ClusterAlertmanagerConfig, checkErr error) {
+	status, changed := acceptedStatus(camc.Status, camc.Generation, checkErr)
+	if !changed {
+		return
+	}
+
+	camc = camc.DeepCopy()
+	camc.Status = status
+
+	if _, err := c.mclient.MonitoringV1alpha1().ClusterAlertmanagerConfigs().UpdateStatus(ctx, camc, metav1.UpdateOptions{}); err != nil {
+		level.Warn(c.logger).Log(
+			"msg", "failed to update clusteralertmanagerconfig status",
+			"err", err,
+			"clusteralertmanagerconfig", camc.Name,
+		)
+	}
+}
+
+// acceptedStatus returns the status with the Accepted condition matching the
+// result of the validation and whether it differs from the current status.
+func acceptedStatus(current *monitoringv1alpha1.AlertmanagerConfigStatus, generation int64, checkErr error) (*monitoringv1alpha1.AlertmanagerConfigStatus, bool) {
+	cond := acceptedCondition(generation, checkErr)
+
+	var currentCond *monitoringv1alpha1.AlertmanagerConfigCondition
+	if current != nil {
+		for i := range current.Conditions {
+			if current.Conditions[i].Type == monitoringv1alpha1.AlertmanagerConfigAccepted {
+				currentCond = &current.Conditions[i]
+				break
+			}
+		}
+	}
+
+	if currentCond != nil {
+		if currentCond.Status == cond.Status &&
+			currentCond.Reason == cond.Reason &&
+			currentCond.Message == cond.Message &&
+			currentCond.ObservedGeneration == cond.ObservedGeneration {
+			return current, false
+		}
+
+		if currentCond.Status == cond.Status {
+			cond.LastTransitionTime = currentCond.LastTransitionTime
+		}
+	}
+
+	conditions := []monitoringv1alpha1.AlertmanagerConfigCondition{cond}
+	if current != nil {
+		for _, other := range current.Conditions {
+			if other.Type != monitoringv1alpha1.AlertmanagerConfigAccepted {
+				conditions = append(conditions, other)
+			}
+		}
+	}
+
+	return &monitoringv1alpha1.AlertmanagerConfigStatus{Conditions: conditions}, true
+}
+
+// acceptedCondition returns the Accepted condition of an object with the
+// given generation given the result of its validation.
+func acceptedCondition(generation int64, checkErr error) monitoringv1alpha1.AlertmanagerConfigCondition {
+	cond := monitoringv1alpha1.AlertmanagerConfigCondition{
+		Type:               monitoringv1alpha1.AlertmanagerConfigAccepted,
+		Status:             monitoringv1alpha1.ConditionTrue,
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             acceptedReason,
+	}
+
+	if checkErr != nil {
+		cond.Status = monitoringv1alpha1.ConditionFalse
+		cond.Reason = invalidConfigurationReason
+		cond.Message = checkErr.Error()
+	}
+
+	return cond
+}
+
+// checkSecretNamespaces verifies that the receivers of the AlertmanagerConfig
+// object are allowed to reference Secrets from other namespaces: either the
+// operator allows it globally or the target namespace opts in with the
+// monitoringv1alpha1.AllowCrossNamespaceSecretsAnnotation annotation.
+func (c *Operator) checkSecretNamespaces(amc *monitoringv1alpha1.AlertmanagerConfig) error {
+	if c.config.AllowCrossNamespaceSecrets {
+		return nil
+	}
+
+	for i := range amc.Spec.Receivers {
+		receiver := &amc.Spec.Receivers[i]
+
+		ns := receiverSecretNamespace(amc.Namespace, receiver)
+		if ns == amc.Namespace {
+			continue
+		}
+
+		obj, exists, err := c.nsAlrtCfgInf.GetStore().GetByKey(ns)
+		if err != nil {
+			return errors.Wrapf(err, "receiver %q: failed to get namespace %q", receiver.Name, ns)
+		}
+
+		if !exists {
+			return errors.Errorf("receiver %q: namespace %q not found", receiver.Name, ns)
+		}
+
+		if obj.(*v1.Namespace).Annotations[monitoringv1alpha1.AllowCrossNamespaceSecretsAnnotation] != "true" {
+			return errors.Errorf("receiver %q: referencing secrets from namespace %q isn't allowed", receiver.Name, ns)
+		}
+	}
+
+	return nil
+}
+
+// checkNameCollisions verifies that the prefixed names of the receivers and
+// time intervals generated from the AlertmanagerConfig objects don't collide
+// with each other or with the names from the base configuration. For
+// instance, the receiver "d" of the "b-c" object in the "a" namespace and the
+// receiver "d" of the "c" object in the "a-b" namespace would both be named
+// "a-b-c-d".
+// The objects are processed by namespace and name so that the first one wins.
+// It returns the errors by object key.
+func checkNameCollisions(baseConfig *alertmanagerConfig, amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) map[string]error {
+	receivers := make(map[string]string)
+	timeIntervals := make(map[string]string)
+	if baseConfig != nil {
+		for _, r := range baseConfig.Receivers {
+			receivers[r.Name] = "base configuration"
+		}
+		for _, mti := range baseConfig.MuteTimeIntervals {
+			timeIntervals[mti.Name] = "base configuration"
+		}
+	}
+
+	errs := make(map[string]error)
+	for _, k := range sortedAlertmanagerConfigKeys(amConfigs) {
+		amc := amConfigs[k]
+		crKey := types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name}
+		owner := "AlertmanagerConfig " + crKey.String()
+
+		var (
+			newReceivers     []string
+			newTimeIntervals []string
+			err              error
+		)
+
+		// Receivers are only merged when the object defines a route.
+		if amc.Spec.Route != nil {
+			for _, r := range amc.Spec.Receivers {
+				name := makeNamespacedString(r.Name, crKey)
+				if other, found := receivers[name]; found {
+					err = errors.Errorf("receiver %q: generated name %q collides with a receiver from %s", r.Name, name, other)
+					break
+				}
+				newReceivers = append(newReceivers, name)
+			}
+		}
+
+		if err == nil {
+			for _, mti := range amc.Spec.MuteTimeIntervals {
+				name := makeNamespacedString(mti.Name, crKey)
+				if other, found := timeIntervals[name]; found {
+					err = errors.Errorf("mute time interval %q: generated name %q collides with a time interval from %s", mti.Name, name, other)
+					break
+				}
+				newTimeIntervals = append(newTimeIntervals, name)
+			}
+		}
+
+		if err != nil {
+			errs[k] = err
+			continue
+		}
+
+		for _, name := range newReceivers {
+			receivers[name] = owner
+		}
+		for _, name := range newTimeIntervals {
+			timeIntervals[name] = owner
+		}
+	}
+
+	return errs
+}
+
 // checkAlertmanagerConfig verifies that an AlertmanagerConfig object is valid
 // and has no missing references to other objects.
-func checkAlertmanagerConfig(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, store *assets.Store) error {
-	receiverNames, err := checkReceivers(ctx, amc, store)
+func checkAlertmanagerConfig(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version, store *assets.Store) error {
+	receiverNames, err := checkReceivers(ctx, amc, amVersion, store)
+	if err != nil {
+		return err
+	}
+
+	if err = checkInhibitRules(amc, amVersion); err != nil {
+		return err
+	}
+
+	muteTimeIntervalNames, err := checkMuteTimeIntervals(amc, amVersion)
 	if err != nil {
 		return err
 	}
 
-	return checkAlertmanagerRoutes(amc.Spec.Route, receiverNames, true)
+	if err = checkTemplates(ctx, amc, store); err != nil {
+		return err
+	}
+
+	return checkAlertmanagerRoutes(amc.Spec.Route, receiverNames, muteTimeIntervalNames, amVersion, true)
+}
+
+// checkGlobalAlertmanagerConfig verifies that an AlertmanagerConfig object
+// used as the global configuration is valid. On top of the regular checks,
+// its route must be usable as the top-level route.
+func checkGlobalAlertmanagerConfig(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version, store *assets.Store) error {
+	if err := checkAlertmanagerConfig(ctx, amc, amVersion, store); err != nil {
+		return err
+	}
+
+	r := amc.Spec.Route
+	if r == nil {
+		return errors.New("missing top-level route")
+	}
+
+	if len(r.Matchers) > 0 {
+		return errors.New("top-level route can't have matchers")
+	}
+
+	if r.Continue {
+		return errors.New("top-level route can't have continue set to true")
+	}
+
+	if len(r.MuteTimeIntervals) > 0 || len(r.ActiveTimeIntervals) > 0 {
+		return errors.New("top-level route can't have mute or active time intervals")
+	}
+
+	return nil
 }
 
-func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, store *assets.Store) (map[string]struct{}, error) {
+func checkTemplates(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, store *assets.Store) error {
+	for i, tmpl := range amc.Spec.Templates {
+		if tmpl.Secret == nil && tmpl.ConfigMap == nil {
+			return errors.Errorf("template %d: either secret or configMap must be defined", i)
+		}
+
+		if err := tmpl.Validate(); err != nil {
+			return errors.Wrapf(err, "template %d", i)
+		}
+
+		if _, err := store.GetKey(ctx, amc.Namespace, tmpl); err != nil {
+			return errors.Wrapf(err, "template %d", i)
+		}
+	}
+
+	return nil
+}
+
+func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version, store *assets.Store) (map[string]struct{}, error) {
 	var err error
 	receiverNames := make(map[string]struct{})
 
@@ -943,42 +1894,78 @@ func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerCon
 		receiverNames[receiver.Name] = struct{}{}
 
 		amcKey := fmt.Sprintf("alertmanagerConfig/%s/%s/%d", amc.GetNamespace(), amc.GetName(), i)
+		secretNamespace := receiverSecretNamespace(amc.GetNamespace(), &amc.Spec.Receivers[i])
+
+		err = checkPagerDutyConfigs(ctx, receiver.PagerDutyConfigs, secretNamespace, amcKey, store)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkOpsGenieConfigs(ctx, receiver.OpsGenieConfigs, secretNamespace, amcKey, store)
+		if err != nil {
+			return nil, err
+		}
+		err = checkSlackConfigs(ctx, receiver.SlackConfigs, secretNamespace, amcKey, store)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkWebhookConfigs(ctx, receiver.WebhookConfigs, secretNamespace, amcKey, store)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkWechatConfigs(ctx, receiver.WeChatConfigs, secretNamespace, amcKey, store)
+		if err != nil {
+			return nil, err
+		}
 
-		err = checkPagerDutyConfigs(ctx, receiver.PagerDutyConfigs, amc.GetNamespace(), amcKey, store)
+		err = checkEmailConfigs(ctx, receiver.EmailConfigs, secretNamespace, amcKey, store)
 		if err != nil {
 			return nil, err
 		}
 
-		err = checkOpsGenieConfigs(ctx, receiver.OpsGenieConfigs, amc.GetNamespace(), amcKey, store)
+		err = checkVictorOpsConfigs(ctx, receiver.VictorOpsConfigs, secretNamespace, amcKey, store)
 		if err != nil {
 			return nil, err
 		}
-		err = checkSlackConfigs(ctx, receiver.SlackConfigs, amc.GetNamespace(), amcKey, store)
+
+		err = checkPushoverConfigs(ctx, receiver.PushoverConfigs, secretNamespace, amcKey, store)
 		if err != nil {
 			return nil, err
 		}
 
-		err = checkWebhookConfigs(ctx, receiver.WebhookConfigs, amc.GetNamespace(), amcKey, store)
+		err = checkSNSConfigs(ctx, receiver.SNSConfigs, secretNamespace, amcKey, store, amVersion)
 		if err != nil {
 			return nil, err
 		}
 
-		err = checkWechatConfigs(ctx, receiver.WeChatConfigs, amc.GetNamespace(), amcKey, store)
+		err = checkTelegramConfigs(ctx, receiver.TelegramConfigs, secretNamespace, amcKey, store, amVersion)
 		if err != nil {
 			return nil, err
 		}
 
-		err = checkEmailConfigs(ctx, receiver.EmailConfigs, amc.GetNamespace(), amcKey, store)
+		err = checkDiscordConfigs(ctx, receiver.DiscordConfigs, secretNamespace, amcKey, store, amVersion)
 		if err != nil {
 			return nil, err
 		}
 
-		err = checkVictorOpsConfigs(ctx, receiver.VictorOpsConfigs, amc.GetNamespace(), amcKey, store)
+		err = checkMSTeamsConfigs(ctx, receiver.MSTeamsConfigs, secretNamespace, amcKey, store, amVersion)
 		if err != nil {
 			return nil, err
 		}
 
-		err = checkPushoverConfigs(ctx, receiver.PushoverConfigs, amc.GetNamespace(), amcKey, store)
+		err = checkMSTeamsV2Configs(ctx, receiver.MSTeamsV2Configs, secretNamespace, amcKey, store, amVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkRocketChatConfigs(ctx, receiver.RocketChatConfigs, secretNamespace, amcKey, store, amVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkJiraConfigs(ctx, receiver.JiraConfigs, secretNamespace, amcKey, store, amVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -1063,10 +2050,25 @@ func checkWebhookConfigs(ctx context.Context, configs []monitoringv1alpha1.Webho
 			return errors.New("one of url or urlSecret should be specified")
 		}
 
+		var webhookURL string
 		if config.URLSecret != nil {
-			if _, err := store.GetSecretKey(ctx, namespace, *config.URLSecret); err != nil {
+			s, err := store.GetSecretKey(ctx, namespace, *config.URLSecret)
+			if err != nil {
 				return err
 			}
+			webhookURL = s
+		} else {
+			webhookURL = *config.URL
+		}
+
+		if _, err := url.Parse(webhookURL); err != nil {
+			return errors.Wrap(err, "invalid webhook URL")
+		}
+
+		if config.Timeout != "" {
+			if _, err := time.ParseDuration(config.Timeout); err != nil {
+				return errors.Wrap(err, "invalid webhook timeout")
+			}
 		}
 
 		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, webhookConfigKey, store); err != nil {
@@ -1198,7 +2200,7 @@ func checkPushoverConfigs(ctx context.Context, configs []monitoringv1alpha1.Push
 			return err
 		}
 		if s == "" {
-			return errors.New("mandatory field userKey is empty")
+			return errors.Errorf("mandatory field %s is empty", name)
 		}
 		return nil
 	}
@@ -1213,15 +2215,21 @@ func checkPushoverConfigs(ctx context.Context, configs []monitoringv1alpha1.Push
 		}
 
 		if config.Retry != "" {
-			_, err := time.ParseDuration(config.Retry)
+			retry, err := time.ParseDuration(config.Retry)
 			if err != nil {
-				return errors.New("invalid retry duration")
+				return errors.Wrap(err, "invalid retry duration")
+			}
+			if retry < 30*time.Second {
+				return errors.Errorf("retry duration %q must be at least 30s", config.Retry)
 			}
 		}
 		if config.Expire != "" {
-			_, err := time.ParseDuration(config.Expire)
+			expire, err := time.ParseDuration(config.Expire)
 			if err != nil {
-				return errors.New("invalid expire duration")
+				return errors.Wrap(err, "invalid expire duration")
+			}
+			if expire <= 0 {
+				return errors.Errorf("expire duration %q must be positive", config.Expire)
 			}
 		}
 
@@ -1234,8 +2242,281 @@ func checkPushoverConfigs(ctx context.Context, configs []monitoringv1alpha1.Push
 	return nil
 }
 
+func checkSNSConfigs(ctx context.Context, configs []monitoringv1alpha1.SNSConfig, namespace string, key string, store *assets.Store, amVersion semver.Version) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.23.0")) {
+		return errors.Errorf("SNS integration requires Alertmanager >= 0.23.0 (current version: %s)", amVersion)
+	}
+
+	for i, config := range configs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		if config.Sigv4 != nil {
+			if config.Sigv4.AccessKey != nil {
+				if _, err := store.GetSecretKey(ctx, namespace, *config.Sigv4.AccessKey); err != nil {
+					return err
+				}
+			}
+			if config.Sigv4.SecretKey != nil {
+				if _, err := store.GetSecretKey(ctx, namespace, *config.Sigv4.SecretKey); err != nil {
+					return err
+				}
+			}
+		}
+
+		snsConfigKey := fmt.Sprintf("%s/sns/%d", key, i)
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, snsConfigKey, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkTelegramConfigs(ctx context.Context, configs []monitoringv1alpha1.TelegramConfig, namespace string, key string, store *assets.Store, amVersion semver.Version) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.24.0")) {
+		return errors.Errorf("Telegram integration requires Alertmanager >= 0.24.0 (current version: %s)", amVersion)
+	}
+
+	for i, config := range configs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		if _, err := store.GetSecretKey(ctx, namespace, *config.BotToken); err != nil {
+			return err
+		}
+
+		telegramConfigKey := fmt.Sprintf("%s/telegram/%d", key, i)
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, telegramConfigKey, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkDiscordConfigs(ctx context.Context, configs []monitoringv1alpha1.DiscordConfig, namespace string, key string, store *assets.Store, amVersion semver.Version) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.25.0")) {
+		return errors.Errorf("Discord integration requires Alertmanager >= 0.25.0 (current version: %s)", amVersion)
+	}
+
+	for i, config := range configs {
+		webhookURL, err := store.GetSecretKey(ctx, namespace, config.APIURL)
+		if err != nil {
+			return err
+		}
+
+		if _, err := url.Parse(webhookURL); err != nil {
+			return errors.Wrap(err, "invalid Discord webhook URL")
+		}
+
+		discordConfigKey := fmt.Sprintf("%s/discord/%d", key, i)
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, discordConfigKey, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkMSTeamsConfigs(ctx context.Context, configs []monitoringv1alpha1.MSTeamsConfig, namespace string, key string, store *assets.Store, amVersion semver.Version) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.26.0")) {
+		return errors.Errorf("MSTeams integration requires Alertmanager >= 0.26.0 (current version: %s)", amVersion)
+	}
+
+	for i, config := range configs {
+		if config.Summary != "" && amVersion.LT(semver.MustParse("0.27.0")) {
+			return errors.Errorf("MSTeams summary field requires Alertmanager >= 0.27.0 (current version: %s)", amVersion)
+		}
+
+		if _, err := store.GetSecretKey(ctx, namespace, config.WebhookURL); err != nil {
+			return err
+		}
+
+		msTeamsConfigKey := fmt.Sprintf("%s/msteams/%d", key, i)
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, msTeamsConfigKey, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkMSTeamsV2Configs(ctx context.Context, configs []monitoringv1alpha1.MSTeamsV2Config, namespace string, key string, store *assets.Store, amVersion semver.Version) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return errors.Errorf("MSTeamsV2 integration requires Alertmanager >= 0.28.0 (current version: %s)", amVersion)
+	}
+
+	for i, config := range configs {
+		if _, err := store.GetSecretKey(ctx, namespace, config.WebhookURL); err != nil {
+			return err
+		}
+
+		msTeamsV2ConfigKey := fmt.Sprintf("%s/msteamsv2/%d", key, i)
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, msTeamsV2ConfigKey, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkRocketChatConfigs(ctx context.Context, configs []monitoringv1alpha1.RocketChatConfig, namespace string, key string, store *assets.Store, amVersion semver.Version) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return errors.Errorf("Rocket.Chat integration requires Alertmanager >= 0.28.0 (current version: %s)", amVersion)
+	}
+
+	for i, config := range configs {
+		if config.APIURL != nil {
+			apiURL, err := store.GetSecretKey(ctx, namespace, *config.APIURL)
+			if err != nil {
+				return err
+			}
+
+			if _, err := url.Parse(apiURL); err != nil {
+				return errors.Wrap(err, "invalid Rocket.Chat API URL")
+			}
+		}
+
+		if _, err := store.GetSecretKey(ctx, namespace, config.Token); err != nil {
+			return err
+		}
+
+		if _, err := store.GetSecretKey(ctx, namespace, config.TokenID); err != nil {
+			return err
+		}
+
+		rocketChatConfigKey := fmt.Sprintf("%s/rocketchat/%d", key, i)
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, rocketChatConfigKey, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkJiraConfigs(ctx context.Context, configs []monitoringv1alpha1.JiraConfig, namespace string, key string, store *assets.Store, amVersion semver.Version) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return errors.Errorf("Jira integration requires Alertmanager >= 0.28.0 (current version: %s)", amVersion)
+	}
+
+	for i, config := range configs {
+		if config.APIURL == "" {
+			return errors.New("missing API URL in Jira config")
+		}
+
+		if _, err := url.Parse(config.APIURL); err != nil {
+			return errors.Wrap(err, "invalid Jira API URL")
+		}
+
+		if config.Project == "" {
+			return errors.New("missing project in Jira config")
+		}
+
+		if config.IssueType == "" {
+			return errors.New("missing issue type in Jira config")
+		}
+
+		if config.ReopenDuration != "" {
+			if _, err := time.ParseDuration(config.ReopenDuration); err != nil {
+				return errors.Wrap(err, "invalid reopen duration in Jira config")
+			}
+		}
+
+		jiraConfigKey := fmt.Sprintf("%s/jira/%d", key, i)
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, jiraConfigKey, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkMuteTimeIntervals verifies that the mute time intervals are
+// semantically valid and returns their names.
+func checkMuteTimeIntervals(amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version) (map[string]struct{}, error) {
+	muteTimeIntervalNames := make(map[string]struct{}, len(amc.Spec.MuteTimeIntervals))
+
+	if len(amc.Spec.MuteTimeIntervals) > 0 && amVersion.LT(semver.MustParse("0.22.0")) {
+		return nil, errors.Errorf("mute time intervals require Alertmanager >= 0.22.0 (current version: %s)", amVersion)
+	}
+
+	for i, mti := range amc.Spec.MuteTimeIntervals {
+		if err := mti.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "mute time interval[%d]", i)
+		}
+
+		if _, found := muteTimeIntervalNames[mti.Name]; found {
+			return nil, errors.Errorf("%q mute time interval is not unique", mti.Name)
+		}
+		muteTimeIntervalNames[mti.Name] = struct{}{}
+	}
+
+	return muteTimeIntervalNames, nil
+}
+
+// checkMatchers verifies that the given matchers are semantically valid and
+// supported by the Alertmanager version.
+func checkMatchers(matchers []monitoringv1alpha1.Matcher, amVersion semver.Version) error {
+	for _, m := range matchers {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+
+		if m.IsNegative() && amVersion.LT(semver.MustParse("0.22.0")) {
+			return errors.Errorf("matcher %q: negative match types require Alertmanager >= 0.22.0 (current version: %s)", m.Name, amVersion)
+		}
+	}
+
+	return nil
+}
+
+func checkInhibitRules(amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version) error {
+	for i, rule := range amc.Spec.InhibitRules {
+		if err := checkMatchers(rule.SourceMatch, amVersion); err != nil {
+			return errors.Wrapf(err, "inhibit rule[%d]: invalid source matcher", i)
+		}
+
+		if err := checkMatchers(rule.TargetMatch, amVersion); err != nil {
+			return errors.Wrapf(err, "inhibit rule[%d]: invalid target matcher", i)
+		}
+	}
+
+	return nil
+}
+
 // checkAlertmanagerRoutes verifies that the given route and all its children are semantically valid.
-func checkAlertmanagerRoutes(r *monitoringv1alpha1.Route, receivers map[string]struct{}, topLevelRoute bool) error {
+func checkAlertmanagerRoutes(r *monitoringv1alpha1.Route, receivers, muteTimeIntervals map[string]struct{}, amVersion semver.Version, topLevelRoute bool) error {
 	if r == nil {
 		return nil
 	}
@@ -1244,13 +2525,33 @@ func checkAlertmanagerRoutes(r *monitoringv1alpha1.Route, receivers map[string]s
 		return errors.Errorf("receiver %q not found", r.Receiver)
 	}
 
+	if err := checkMatchers(r.Matchers, amVersion); err != nil {
+		return err
+	}
+
+	for _, name := range r.MuteTimeIntervals {
+		if _, found := muteTimeIntervals[name]; !found {
+			return errors.Errorf("mute time interval %q not found", name)
+		}
+	}
+
+	if len(r.ActiveTimeIntervals) > 0 && amVersion.LT(semver.MustParse("0.24.0")) {
+		return errors.Errorf("active time intervals require Alertmanager >= 0.24.0 (current version: %s)", amVersion)
+	}
+
+	for _, name := range r.ActiveTimeIntervals {
+		if _, found := muteTimeIntervals[name]; !found {
+			return errors.Errorf("active time interval %q not found", name)
+		}
+	}
+
 	children, err := r.ChildRoutes()
 	if err != nil {
 		return err
 	}
 
 	for i := range children {
-		if err := checkAlertmanagerRoutes(&children[i], receivers, false); err != nil {
+		if err := checkAlertmanagerRoutes(&children[i], receivers, muteTimeIntervals, amVersion, false); err != nil {
 			return errors.Wrapf(err, "route[%d]", i)
 		}
 	}
@@ -1278,6 +2579,41 @@ func configureHTTPConfigInStore(ctx context.Context, httpConfig *monitoringv1alp
 	if err = store.AddSafeTLSConfig(ctx, namespace, httpConfig.TLSConfig); err != nil {
 		return err
 	}
+
+	if httpConfig.OAuth2 != nil {
+		if err = httpConfig.OAuth2.Validate(); err != nil {
+			return err
+		}
+		if _, err = store.GetKey(ctx, namespace, httpConfig.OAuth2.ClientID); err != nil {
+			return errors.Wrap(err, "failed to get OAuth2 client id")
+		}
+		if _, err = store.GetSecretKey(ctx, namespace, httpConfig.OAuth2.ClientSecret); err != nil {
+			return errors.Wrap(err, "failed to get OAuth2 client secret")
+		}
+	}
+	return nil
+}
+
+// configureGlobalConfigInStore checks the global parameters defined in the
+// Alertmanager resource and loads the referenced assets into the store.
+func configureGlobalConfigInStore(ctx context.Context, globalConfig *monitoringv1.AlertmanagerGlobalConfig, namespace string, store *assets.Store) error {
+	if globalConfig == nil {
+		return nil
+	}
+
+	if globalConfig.HTTPConfig != nil {
+		httpConfig := monitoringv1alpha1.HTTPConfig(*globalConfig.HTTPConfig)
+		if err := configureHTTPConfigInStore(ctx, &httpConfig, namespace, "alertmanager/global/httpconfig", store); err != nil {
+			return err
+		}
+	}
+
+	if smtp := globalConfig.SMTPConfig; smtp != nil && smtp.SmartHost != nil {
+		if smtp.SmartHost.Host == "" || smtp.SmartHost.Port == "" {
+			return errors.New("SMTP smarthost requires both host and port")
+		}
+	}
+
 	return nil
 }
 
@@ -1307,26 +2643,51 @@ func (c *Operator) createOrUpdateTLSAssetSecret(ctx context.Context, am *monitor
 		tlsAssetsSecret.Data[key.String()] = []byte(asset)
 	}
 
-	_, err := sClient.Get(ctx, tlsAssetsSecret.Name, metav1.GetOptions{})
+	updated, err := k8sutil.CreateOrUpdateSecret(ctx, sClient, tlsAssetsSecret)
 	if err != nil {
-		if !apierrors.IsNotFound(err) {
-			return errors.Wrapf(
-				err,
-				"failed to check whether tls assets secret already exists for Alertmanager %v in namespace %v",
-				am.Name,
-				am.Namespace,
-			)
-		}
-		_, err = sClient.Create(ctx, tlsAssetsSecret, metav1.CreateOptions{})
-		level.Debug(c.logger).Log("msg", "created tlsAssetsSecret", "secretname", tlsAssetsSecret.Name)
+		return errors.Wrapf(err, "failed to create TLS assets secret for Alertmanager %v in namespace %v", am.Name, am.Namespace)
+	}
 
-	} else {
-		_, err = sClient.Update(ctx, tlsAssetsSecret, metav1.UpdateOptions{})
+	if updated {
 		level.Debug(c.logger).Log("msg", "updated tlsAssetsSecret", "secretname", tlsAssetsSecret.Name)
 	}
 
+	return nil
+}
+
+// createOrUpdateConfigFileSecret creates or updates the Secret holding the
+// given configuration file of the Alertmanager web server or cluster.
+func (c *Operator) createOrUpdateConfigFileSecret(ctx context.Context, am *monitoringv1.Alertmanager, name, fileName string, conf []byte) error {
+	boolTrue := true
+	sClient := c.kclient.CoreV1().Secrets(am.Namespace)
+
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: c.config.Labels.Merge(managedByOperatorLabels),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         am.APIVersion,
+					BlockOwnerDeletion: &boolTrue,
+					Controller:         &boolTrue,
+					Kind:               am.Kind,
+					Name:               am.Name,
+					UID:                am.UID,
+				},
+			},
+		},
+		Data: map[string][]byte{
+			fileName: conf,
+		},
+	}
+
+	updated, err := k8sutil.CreateOrUpdateSecret(ctx, sClient, secret)
 	if err != nil {
-		return errors.Wrapf(err, "failed to create TLS assets secret for Alertmanager %v in namespace %v", am.Name, am.Namespace)
+		return errors.Wrapf(err, "failed to update secret %v for Alertmanager %v in namespace %v", secret.Name, am.Name, am.Namespace)
+	}
+
+	if updated {
+		level.Debug(c.logger).Log("msg", "updated secret", "secretname", secret.Name)
 	}
 
 	return nil
@@ -1346,12 +2707,14 @@ func checkAlertmanagerSpecDeprecation(key string, a *monitoringv1.Alertmanager,
 	}
 }
 
+// ListOptions returns the options to list the pods of the given Alertmanager.
+// The label selector is sorted so it can be used in the status.
 func ListOptions(name string) metav1.ListOptions {
 	return metav1.ListOptions{
-		LabelSelector: fields.SelectorFromSet(fields.Set(map[string]string{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
 			"app":          "alertmanager",
 			"alertmanager": name,
-		})).String(),
+		}).String(),
 	}
 }
 
@@ -1392,6 +2755,110 @@ func AlertmanagerStatus(ctx context.Context, kclient kubernetes.Interface, a *mo
 	return res, oldPods, nil
 }
 
+// updateAlertmanagerStatus reports the state of the Alertmanager pods and the
+// result of the reconciliation in the status of the Alertmanager object. The
+// object is updated only when the status changes.
+func (c *Operator) updateAlertmanagerStatus(ctx context.Context, am *monitoringv1.Alertmanager, reconcileErr error) {
+	status, _, err := AlertmanagerStatus(ctx, c.kclient, am)
+	if err != nil {
+		if !apierrors.IsNotFound(errors.Cause(err)) {
+			level.Warn(c.logger).Log(
+				"msg", "failed to get alertmanager status",
+				"err", err,
+				"alertmanager", am.Namespace+"/"+am.Name,
+			)
+			return
+		}
+		// The statefulset hasn't been created yet.
+		status = &monitoringv1.AlertmanagerStatus{Paused: am.Spec.Paused}
+	}
+
+	status.Selector = ListOptions(am.Name).LabelSelector
+	status.Conditions = []monitoringv1.AlertmanagerCondition{
+		availableCondition(am, status),
+		reconciledCondition(am, reconcileErr),
+	}
+
+	if am.Status != nil {
+		for i := range status.Conditions {
+			for _, current := range am.Status.Conditions {
+				if current.Type == status.Conditions[i].Type && current.Status == status.Conditions[i].Status {
+					status.Conditions[i].LastTransitionTime = current.LastTransitionTime
+				}
+			}
+		}
+
+		if reflect.DeepEqual(am.Status, status) {
+			return
+		}
+	}
+
+	am = am.DeepCopy()
+	am.Status = status
+
+	if _, err := c.mclient.MonitoringV1().Alertmanagers(am.Namespace).UpdateStatus(ctx, am, metav1.UpdateOptions{}); err != nil {
+		level.Warn(c.logger).Log(
+			"msg", "failed to update alertmanager status",
+			"err", err,
+			"alertmanager", am.Namespace+"/"+am.Name,
+		)
+	}
+}
+
+// availableCondition returns the Available condition of the Alertmanager
+// object given the number of ready pods.
+func availableCondition(am *monitoringv1.Alertmanager, status *monitoringv1.AlertmanagerStatus) monitoringv1.AlertmanagerCondition {
+	cond := monitoringv1.AlertmanagerCondition{
+		Type:               monitoringv1.AlertmanagerAvailable,
+		Status:             monitoringv1.ConditionTrue,
+		ObservedGeneration: am.Generation,
+		LastTransitionTime: metav1.Now(),
+	}
+
+	replicas := minReplicas
+	if am.Spec.Replicas != nil {
+		replicas = *am.Spec.Replicas
+	}
+
+	switch {
+	case replicas > 0 && status.AvailableReplicas == 0:
+		cond.Status = monitoringv1.ConditionFalse
+		cond.Reason = noPodReadyReason
+		cond.Message = fmt.Sprintf("0/%d pods are ready", replicas)
+	case status.AvailableReplicas < replicas:
+		cond.Status = monitoringv1.ConditionDegraded
+		cond.Reason = somePodsNotReadyReason
+		cond.Message = fmt.Sprintf("%d/%d pods are ready", status.AvailableReplicas, replicas)
+	}
+
+	return cond
+}
+
+// reconciledCondition returns the Reconciled condition of the Alertmanager
+// object given the result of the reconciliation.
+func reconciledCondition(am *monitoringv1.Alertmanager, reconcileErr error) monitoringv1.AlertmanagerCondition {
+	cond := monitoringv1.AlertmanagerCondition{
+		Type:               monitoringv1.AlertmanagerReconciled,
+		Status:             monitoringv1.ConditionTrue,
+		ObservedGeneration: am.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reconciliationSucceededReason,
+	}
+
+	switch {
+	case am.Spec.Paused:
+		cond.Status = monitoringv1.ConditionUnknown
+		cond.Reason = pausedReason
+		cond.Message = "the resource is paused, only delete actions are performed"
+	case reconcileErr != nil:
+		cond.Status = monitoringv1.ConditionFalse
+		cond.Reason = reconciliationFailedReason
+		cond.Message = reconcileErr.Error()
+	}
+
+	return cond
+}
+
 func needsUpdate(pod *v1.Pod, tmpl v1.PodTemplateSpec) bool {
 	c1 := pod.Spec.Containers[0]
 	c2 := tmpl.Spec.Containers[0]
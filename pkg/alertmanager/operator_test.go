@@ -15,16 +15,24 @@
 package alertmanager
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
 	"testing"
 
+	"github.com/blang/semver/v4"
 	"github.com/go-kit/kit/log"
 	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
@@ -45,14 +53,16 @@ func TestCheckAlertmanagerConfig(t *testing.T) {
 				Namespace: "ns1",
 			},
 			Data: map[string][]byte{
-				"key1": []byte("val1"),
+				"key1":        []byte("val1"),
+				"invalid-url": []byte(":invalid"),
 			},
 		},
 	)
 
 	for _, tc := range []struct {
-		amConfig *monitoringv1alpha1.AlertmanagerConfig
-		ok       bool
+		amConfig  *monitoringv1alpha1.AlertmanagerConfig
+		amVersion string
+		ok        bool
 	}{
 		{
 			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
@@ -367,6 +377,76 @@ func TestCheckAlertmanagerConfig(t *testing.T) {
 			},
 			ok: false,
 		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook-with-invalid-url",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{
+								URL: strPtr(":invalid"),
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook-with-invalid-url-secret",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{
+								URLSecret: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+									Key:                  "invalid-url",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook-with-invalid-timeout",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{
+								URL:     strPtr("http://test.local"),
+								Timeout: "5 seconds",
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
 		{
 			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
@@ -798,275 +878,2317 @@ func TestCheckAlertmanagerConfig(t *testing.T) {
 			},
 			ok: true,
 		},
-	} {
-		t.Run(tc.amConfig.Name, func(t *testing.T) {
-			store := assets.NewStore(c.CoreV1(), c.CoreV1())
-			err := checkAlertmanagerConfig(context.Background(), tc.amConfig, store)
-			if tc.ok {
-				if err != nil {
-					t.Fatalf("expecting no error but got %q", err)
-				}
-				return
-			}
-
-			if err == nil {
-				t.Fatal("expecting error but got none")
-			}
-		})
-	}
-}
-
-func TestListOptions(t *testing.T) {
-	for i := 0; i < 1000; i++ {
-		o := ListOptions("test")
-		if o.LabelSelector != "app=alertmanager,alertmanager=test" && o.LabelSelector != "alertmanager=test,app=alertmanager" {
-			t.Fatalf("LabelSelector not computed correctly\n\nExpected: \"app=alertmanager,alertmanager=test\"\n\nGot:      %#+v", o.LabelSelector)
-		}
-	}
-}
-
-func TestProvisionAlertmanagerConfiguration(t *testing.T) {
-	for _, tc := range []struct {
-		am      *monitoringv1.Alertmanager
-		objects []runtime.Object
-
-		ok           bool
-		expectedKeys []string
-	}{
 		{
-			am: &monitoringv1.Alertmanager{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "empty",
-					Namespace: "test",
+					Name:      "pushover-with-valid-durations",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						PushoverConfigs: []monitoringv1alpha1.PushoverConfig{
+							{
+								UserKey: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Token: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Retry:  "60s",
+								Expire: "1h",
+							},
+						},
+					}},
 				},
 			},
 			ok: true,
 		},
 		{
-			am: &monitoringv1.Alertmanager{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "empty-with-selector",
-					Namespace: "test",
+					Name:      "pushover-with-invalid-retry",
+					Namespace: "ns1",
 				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					AlertmanagerConfigSelector: &metav1.LabelSelector{},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						PushoverConfigs: []monitoringv1alpha1.PushoverConfig{
+							{
+								UserKey: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Token: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Retry:  "1 minute",
+								Expire: "1h",
+							},
+						},
+					}},
 				},
 			},
-			ok: true,
+			ok: false,
 		},
 		{
-			am: &monitoringv1.Alertmanager{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "invalid-user-config",
-					Namespace: "test",
-				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					ConfigSecret: "amconfig",
+					Name:      "pushover-with-too-short-retry",
+					Namespace: "ns1",
 				},
-			},
-			objects: []runtime.Object{
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "amconfig",
-						Namespace: "test",
-					},
-					Data: map[string][]byte{
-						"alertmanager.yaml": []byte(`invalid`),
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
 					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						PushoverConfigs: []monitoringv1alpha1.PushoverConfig{
+							{
+								UserKey: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Token: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Retry:  "10s",
+								Expire: "1h",
+							},
+						},
+					}},
 				},
 			},
 			ok: false,
 		},
 		{
-			am: &monitoringv1.Alertmanager{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "valid-user-config",
-					Namespace: "test",
-				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					ConfigSecret: "amconfig",
+					Name:      "sns-with-valid-config",
+					Namespace: "ns1",
 				},
-			},
-			objects: []runtime.Object{
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "amconfig",
-						Namespace: "test",
-					},
-					Data: map[string][]byte{
-						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
 					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						SNSConfigs: []monitoringv1alpha1.SNSConfig{
+							{
+								TopicARN: "arn:aws:sns:us-east-2:123456789012:My-Topic",
+								Sigv4: &monitoringv1.Sigv4{
+									Region: "us-east-2",
+									AccessKey: &v1.SecretKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "secret",
+										},
+										Key: "key1",
+									},
+									SecretKey: &v1.SecretKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "secret",
+										},
+										Key: "key1",
+									},
+								},
+							},
+						},
+					}},
 				},
 			},
-			ok: true,
+			amVersion: "0.23.0",
+			ok:        true,
 		},
 		{
-			am: &monitoringv1.Alertmanager{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "valid-user-config-with-selector",
-					Namespace: "test",
-				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					ConfigSecret:               "amconfig",
-					AlertmanagerConfigSelector: &metav1.LabelSelector{},
+					Name:      "sns-with-unsupported-version",
+					Namespace: "ns1",
 				},
-			},
-			objects: []runtime.Object{
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "amconfig",
-						Namespace: "test",
-					},
-					Data: map[string][]byte{
-						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
 					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						SNSConfigs: []monitoringv1alpha1.SNSConfig{
+							{
+								TopicARN: "arn:aws:sns:us-east-2:123456789012:My-Topic",
+								Sigv4: &monitoringv1.Sigv4{
+									Region: "us-east-2",
+									AccessKey: &v1.SecretKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "secret",
+										},
+										Key: "key1",
+									},
+									SecretKey: &v1.SecretKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "secret",
+										},
+										Key: "key1",
+									},
+								},
+							},
+						},
+					}},
 				},
 			},
-			ok: true,
+			amVersion: "0.21.0",
+			ok:        false,
 		},
 		{
-			am: &monitoringv1.Alertmanager{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "valid-user-config-and-additional-secret",
-					Namespace: "test",
-				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					ConfigSecret: "amconfig",
+					Name:      "sns-without-destination",
+					Namespace: "ns1",
 				},
-			},
-			objects: []runtime.Object{
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "amconfig",
-						Namespace: "test",
-					},
-					Data: map[string][]byte{
-						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
-						"key1":              []byte(`val1`),
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
 					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						SNSConfigs: []monitoringv1alpha1.SNSConfig{
+							{
+								Sigv4: &monitoringv1.Sigv4{
+									Region: "us-east-2",
+									AccessKey: &v1.SecretKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "secret",
+										},
+										Key: "key1",
+									},
+									SecretKey: &v1.SecretKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "secret",
+										},
+										Key: "key1",
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.23.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "telegram-with-valid-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						TelegramConfigs: []monitoringv1alpha1.TelegramConfig{
+							{
+								BotToken: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								ChatID: 12345,
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.24.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "telegram-with-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						TelegramConfigs: []monitoringv1alpha1.TelegramConfig{
+							{
+								BotToken: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								ChatID: 12345,
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.23.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "telegram-without-chat-id",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						TelegramConfigs: []monitoringv1alpha1.TelegramConfig{
+							{
+								BotToken: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								ChatID: 0,
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.24.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "discord-with-valid-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						DiscordConfigs: []monitoringv1alpha1.DiscordConfig{
+							{
+								APIURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.25.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "discord-with-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						DiscordConfigs: []monitoringv1alpha1.DiscordConfig{
+							{
+								APIURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.24.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "discord-with-missing-secret",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						DiscordConfigs: []monitoringv1alpha1.DiscordConfig{
+							{
+								APIURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "not-found",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.25.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "msteams-with-valid-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MSTeamsConfigs: []monitoringv1alpha1.MSTeamsConfig{
+							{
+								WebhookURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.26.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "msteams-with-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MSTeamsConfigs: []monitoringv1alpha1.MSTeamsConfig{
+							{
+								WebhookURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.25.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "msteams-with-summary-and-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MSTeamsConfigs: []monitoringv1alpha1.MSTeamsConfig{
+							{
+								WebhookURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Summary: "summary",
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.26.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "msteamsv2-with-valid-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MSTeamsV2Configs: []monitoringv1alpha1.MSTeamsV2Config{
+							{
+								WebhookURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.28.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "msteamsv2-with-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MSTeamsV2Configs: []monitoringv1alpha1.MSTeamsV2Config{
+							{
+								WebhookURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.27.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid-mute-time-intervals",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:          "recv1",
+						MuteTimeIntervals: []string{"workdays"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "workdays",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Times: []monitoringv1alpha1.TimeRange{
+										{
+											StartTime: "00:00",
+											EndTime:   "08:00",
+										},
+									},
+									Weekdays:    []monitoringv1alpha1.WeekdayRange{"Monday:Friday"},
+									DaysOfMonth: []monitoringv1alpha1.DayOfMonthRange{{Start: 1, End: 5}, {Start: -3, End: -1}},
+									Months:      []monitoringv1alpha1.MonthRange{"1:3", "December"},
+									Years:       []monitoringv1alpha1.YearRange{"2021:2022"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mute-time-intervals-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:          "recv1",
+						MuteTimeIntervals: []string{"workdays"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "workdays",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Times: []monitoringv1alpha1.TimeRange{
+										{
+											StartTime: "00:00",
+											EndTime:   "08:00",
+										},
+									},
+									Weekdays:    []monitoringv1alpha1.WeekdayRange{"Monday:Friday"},
+									DaysOfMonth: []monitoringv1alpha1.DayOfMonthRange{{Start: 1, End: 5}, {Start: -3, End: -1}},
+									Months:      []monitoringv1alpha1.MonthRange{"1:3", "December"},
+									Years:       []monitoringv1alpha1.YearRange{"2021:2022"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.21.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "missing-mute-time-interval",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:          "recv1",
+						MuteTimeIntervals: []string{"weekday"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "workdays",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Times: []monitoringv1alpha1.TimeRange{
+										{
+											StartTime: "00:00",
+											EndTime:   "08:00",
+										},
+									},
+									Weekdays:    []monitoringv1alpha1.WeekdayRange{"Monday:Friday"},
+									DaysOfMonth: []monitoringv1alpha1.DayOfMonthRange{{Start: 1, End: 5}, {Start: -3, End: -1}},
+									Months:      []monitoringv1alpha1.MonthRange{"1:3", "December"},
+									Years:       []monitoringv1alpha1.YearRange{"2021:2022"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "inverted-weekday-range",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:          "recv1",
+						MuteTimeIntervals: []string{"weekend"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "weekend",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Weekdays: []monitoringv1alpha1.WeekdayRange{"Saturday:Sunday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-mute-time-interval-times",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:          "recv1",
+						MuteTimeIntervals: []string{"weekend"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "weekend",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Times: []monitoringv1alpha1.TimeRange{
+										{
+											StartTime: "08:00",
+											EndTime:   "07:00",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-mute-time-interval-weekdays",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:          "recv1",
+						MuteTimeIntervals: []string{"weekend"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "weekend",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Weekdays: []monitoringv1alpha1.WeekdayRange{"Friday:Monday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid-active-time-intervals",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:            "recv1",
+						ActiveTimeIntervals: []string{"business-hours"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "business-hours",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Times: []monitoringv1alpha1.TimeRange{
+										{
+											StartTime: "09:00",
+											EndTime:   "17:00",
+										},
+									},
+									Weekdays: []monitoringv1alpha1.WeekdayRange{"monday:friday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.24.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "active-time-intervals-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:            "recv1",
+						ActiveTimeIntervals: []string{"business-hours"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "business-hours",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Times: []monitoringv1alpha1.TimeRange{
+										{
+											StartTime: "09:00",
+											EndTime:   "17:00",
+										},
+									},
+									Weekdays: []monitoringv1alpha1.WeekdayRange{"monday:friday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.23.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "missing-active-time-interval",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver:            "recv1",
+						ActiveTimeIntervals: []string{"weekend"},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "business-hours",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Times: []monitoringv1alpha1.TimeRange{
+										{
+											StartTime: "09:00",
+											EndTime:   "17:00",
+										},
+									},
+									Weekdays: []monitoringv1alpha1.WeekdayRange{"monday:friday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			amVersion: "0.24.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "negative-matcher",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Matchers: []monitoringv1alpha1.Matcher{
+							{Name: "severity", Value: "info", MatchType: monitoringv1alpha1.MatchNotEqual},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "negative-matcher-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Matchers: []monitoringv1alpha1.Matcher{
+							{Name: "severity", Value: "info", MatchType: monitoringv1alpha1.MatchNotEqual},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+				},
+			},
+			amVersion: "0.21.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "matcher-with-regex-and-match-type",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Matchers: []monitoringv1alpha1.Matcher{
+							{Name: "severity", Value: "info", MatchType: monitoringv1alpha1.MatchEqual, Regex: true},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "matcher-with-invalid-regex",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Matchers: []monitoringv1alpha1.Matcher{
+							{Name: "severity", Value: "(info", MatchType: monitoringv1alpha1.MatchRegexp},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "matcher-with-invalid-match-type",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Matchers: []monitoringv1alpha1.Matcher{
+							{Name: "severity", Value: "info", MatchType: "=="},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+				},
+			},
+			amVersion: "0.22.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deeply-nested-routes",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Routes: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"routes": [{"routes": [{"receiver": "recv2"}]}]}`)},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}, {
+						Name: "recv2",
+					}},
+				},
+			},
+			ok: true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deeply-nested-routes-with-missing-receiver",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Routes: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"routes": [{"routes": [{"receiver": "recv3"}]}]}`)},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}, {
+						Name: "recv2",
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nested-route-with-unknown-field",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+						Routes: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"routes": [{"reciever": "recv2"}]}`)},
+						},
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}, {
+						Name: "recv2",
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "templateFromSecret",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1.SecretOrConfigMap{
+						{
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+						},
+					},
+				},
+			},
+			ok: true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "templateFromMissingSecretKey",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1.SecretOrConfigMap{
+						{
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "missing",
+							},
+						},
+					},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "templateWithoutReference",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1.SecretOrConfigMap{{}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rocketchat-with-valid-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						RocketChatConfigs: []monitoringv1alpha1.RocketChatConfig{
+							{
+								Token: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								TokenID: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.28.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rocketchat-with-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						RocketChatConfigs: []monitoringv1alpha1.RocketChatConfig{
+							{
+								Token: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								TokenID: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.27.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rocketchat-with-missing-secret",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						RocketChatConfigs: []monitoringv1alpha1.RocketChatConfig{
+							{
+								Token: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "not-found",
+									},
+									Key: "key1",
+								},
+								TokenID: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.28.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "jira-with-valid-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						JiraConfigs: []monitoringv1alpha1.JiraConfig{
+							{
+								APIURL:    "https://jira.example.com",
+								Project:   "OPS",
+								IssueType: "Bug",
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.28.0",
+			ok:        true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "jira-with-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						JiraConfigs: []monitoringv1alpha1.JiraConfig{
+							{
+								APIURL:    "https://jira.example.com",
+								Project:   "OPS",
+								IssueType: "Bug",
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.27.0",
+			ok:        false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "jira-with-missing-project",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						JiraConfigs: []monitoringv1alpha1.JiraConfig{
+							{
+								APIURL:    "https://jira.example.com",
+								Project:   "",
+								IssueType: "Bug",
+							},
+						},
+					}},
+				},
+			},
+			amVersion: "0.28.0",
+			ok:        false,
+		},
+	} {
+		t.Run(tc.amConfig.Name, func(t *testing.T) {
+			amVersion := semver.MustParse(strings.TrimPrefix(operator.DefaultAlertmanagerVersion, "v"))
+			if tc.amVersion != "" {
+				amVersion = semver.MustParse(tc.amVersion)
+			}
+
+			store := assets.NewStore(c.CoreV1(), c.CoreV1())
+			err := checkAlertmanagerConfig(context.Background(), tc.amConfig, amVersion, store)
+			if tc.ok {
+				if err != nil {
+					t.Fatalf("expecting no error but got %q", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expecting error but got none")
+			}
+		})
+	}
+}
+
+func TestCheckGlobalAlertmanagerConfig(t *testing.T) {
+	c := fake.NewSimpleClientset()
+
+	for _, tc := range []struct {
+		name  string
+		route *monitoringv1alpha1.Route
+		ok    bool
+	}{
+		{
+			name: "valid",
+			route: &monitoringv1alpha1.Route{
+				Receiver: "recv1",
+				Routes: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"receiver": "recv1", "matchers": [{"name": "severity", "value": "critical"}], "continue": true}`)},
+				},
+			},
+			ok: true,
+		},
+		{
+			name: "missing-route",
+			ok:   false,
+		},
+		{
+			name: "top-level-route-with-matchers",
+			route: &monitoringv1alpha1.Route{
+				Receiver: "recv1",
+				Matchers: []monitoringv1alpha1.Matcher{{Name: "severity", Value: "critical"}},
+			},
+			ok: false,
+		},
+		{
+			name: "top-level-route-with-continue",
+			route: &monitoringv1alpha1.Route{
+				Receiver: "recv1",
+				Continue: true,
+			},
+			ok: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			amc := &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "global",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: tc.route,
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+					}},
+				},
+			}
+
+			amVersion := semver.MustParse(strings.TrimPrefix(operator.DefaultAlertmanagerVersion, "v"))
+			store := assets.NewStore(c.CoreV1(), c.CoreV1())
+			err := checkGlobalAlertmanagerConfig(context.Background(), amc, amVersion, store)
+			if tc.ok {
+				if err != nil {
+					t.Fatalf("expecting no error but got %q", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expecting error but got none")
+			}
+		})
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		o := ListOptions("test")
+		if o.LabelSelector != "app=alertmanager,alertmanager=test" && o.LabelSelector != "alertmanager=test,app=alertmanager" {
+			t.Fatalf("LabelSelector not computed correctly\n\nExpected: \"app=alertmanager,alertmanager=test\"\n\nGot:      %#+v", o.LabelSelector)
+		}
+	}
+}
+
+func TestProvisionAlertmanagerConfiguration(t *testing.T) {
+	for _, tc := range []struct {
+		am      *monitoringv1.Alertmanager
+		objects []runtime.Object
+
+		ok           bool
+		expectedKeys []string
+	}{
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "empty",
+					Namespace: "test",
+				},
+			},
+			ok: true,
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "empty-with-selector",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					AlertmanagerConfigSelector: &metav1.LabelSelector{},
+				},
+			},
+			ok: true,
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-user-config",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					ConfigSecret: "amconfig",
+				},
+			},
+			objects: []runtime.Object{
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amconfig",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"alertmanager.yaml": []byte(`invalid`),
+					},
+				},
+			},
+			ok: false,
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid-user-config",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					ConfigSecret: "amconfig",
+				},
+			},
+			objects: []runtime.Object{
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amconfig",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
+					},
+				},
+			},
+			ok: true,
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid-user-config-with-selector",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					ConfigSecret:               "amconfig",
+					AlertmanagerConfigSelector: &metav1.LabelSelector{},
+				},
+			},
+			objects: []runtime.Object{
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amconfig",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
+					},
+				},
+			},
+			ok: true,
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid-user-config-and-additional-secret",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					ConfigSecret: "amconfig",
+				},
+			},
+			objects: []runtime.Object{
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amconfig",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
+						"key1":              []byte(`val1`),
+					},
+				},
+			},
+			ok:           true,
+			expectedKeys: []string{"key1"},
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid-user-config-and-additional-secret-with-selectors",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					ConfigSecret:               "amconfig",
+					AlertmanagerConfigSelector: &metav1.LabelSelector{},
+				},
+			},
+			objects: []runtime.Object{
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amconfig",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
+						"key1":              []byte(`val1`),
+					},
+				},
+			},
+			ok:           true,
+			expectedKeys: []string{"key1"},
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "no-user-config-but-additional-secret",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					ConfigSecret: "amconfig",
+				},
+			},
+			objects: []runtime.Object{
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amconfig",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"key1": []byte(`val1`),
+					},
+				},
+			},
+			ok:           true,
+			expectedKeys: []string{"key1"},
+		},
+		{
+			am: &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "no-user-config-but-additional-secret-with-selectors",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					ConfigSecret:               "amconfig",
+					AlertmanagerConfigSelector: &metav1.LabelSelector{},
+				},
+			},
+			objects: []runtime.Object{
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amconfig",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"key1": []byte(`val1`),
+					},
+				},
+			},
+			ok:           true,
+			expectedKeys: []string{"key1"},
+		},
+	} {
+		t.Run(tc.am.Name, func(t *testing.T) {
+			c := fake.NewSimpleClientset(tc.objects...)
+
+			o := &Operator{
+				kclient: c,
+				mclient: monitoringfake.NewSimpleClientset(),
+				logger:  log.NewNopLogger(),
+				metrics: operator.NewMetrics("alertmanager", prometheus.NewRegistry()),
+			}
+
+			err := o.bootstrap(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			store := assets.NewStore(c.CoreV1(), c.CoreV1())
+			err = o.provisionAlertmanagerConfiguration(context.Background(), tc.am, store)
+
+			if !tc.ok {
+				if err == nil {
+					t.Fatal("expecting error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error but got %q", err)
+			}
+
+			secret, err := c.CoreV1().Secrets(tc.am.Namespace).Get(context.Background(), generatedConfigSecretName(tc.am.Name), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := append(tc.expectedKeys, alertmanagerConfigFileCompressed)
+			if len(secret.Data) != len(expected) {
+				t.Fatalf("expecting %d items to be present in the generated secret but got %d", len(expected), len(secret.Data))
+			}
+			for _, k := range expected {
+				if _, found := secret.Data[k]; !found {
+					t.Fatalf("expecting key %q to be present in the generated secret but got nothing", k)
+				}
+			}
+
+			r, err := gzip.NewReader(bytes.NewReader(secret.Data[alertmanagerConfigFileCompressed]))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			conf, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := loadCfg(string(conf)); err != nil {
+				t.Fatalf("expecting the generated configuration to be valid but got %q", err)
+			}
+		})
+	}
+}
+
+func TestGenerateAlertmanagerConfigurationDryRun(t *testing.T) {
+	amc := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "global",
+			Namespace: "ns1",
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "null",
+			},
+			Receivers: []monitoringv1alpha1.Receiver{{Name: "null"}},
+		},
+	}
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "am",
+			Namespace: "ns1",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfiguration: &monitoringv1.AlertmanagerConfiguration{
+				Name: "global",
+			},
+		},
+	}
+
+	kclient := fake.NewSimpleClientset()
+	mclient := monitoringfake.NewSimpleClientset(amc)
+
+	o := &Operator{
+		kclient: kclient,
+		mclient: mclient,
+		logger:  log.NewNopLogger(),
+	}
+
+	store := assets.NewStore(kclient.CoreV1(), kclient.CoreV1())
+	conf, _, err := o.generateAlertmanagerConfiguration(context.Background(), am, store, true, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := loadCfg(string(conf)); err != nil {
+		t.Fatalf("invalid configuration: %v", err)
+	}
+
+	for _, action := range mclient.Actions() {
+		if action.GetVerb() != "get" {
+			t.Fatalf("expected no update of AlertmanagerConfig objects, got %q %q", action.GetVerb(), action.GetSubresource())
+		}
+	}
+
+	for _, action := range kclient.Actions() {
+		if action.GetVerb() != "get" {
+			t.Fatalf("expected no update of Kubernetes objects, got %q %q", action.GetVerb(), action.GetResource().Resource)
+		}
+	}
+}
+
+func TestGenerateAlertmanagerConfigurationWithPendingConfig(t *testing.T) {
+	newAlertmanagerConfig := func(ns string, lbls map[string]string, receiver string) *monitoringv1alpha1.AlertmanagerConfig {
+		return &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pending",
+				Namespace: ns,
+				Labels:    lbls,
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: receiver,
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{
+					Name: "webhook",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+						URL: strPtr("http://test.local"),
+					}},
+				}},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		selector *metav1.LabelSelector
+		pending  *monitoringv1alpha1.AlertmanagerConfig
+
+		ok bool
+	}{
+		{
+			name:     "selected",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"group": "test"}},
+			pending:  newAlertmanagerConfig("test", map[string]string{"group": "test"}, "webhook"),
+			ok:       true,
+		},
+		{
+			name:    "no selector",
+			pending: newAlertmanagerConfig("test", map[string]string{"group": "test"}, "webhook"),
+		},
+		{
+			name:     "labels not matching",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"group": "test"}},
+			pending:  newAlertmanagerConfig("test", map[string]string{"group": "other"}, "webhook"),
+		},
+		{
+			name:     "namespace not selected",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"group": "test"}},
+			pending:  newAlertmanagerConfig("other", map[string]string{"group": "test"}, "webhook"),
+		},
+		{
+			name:     "invalid",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"group": "test"}},
+			pending:  newAlertmanagerConfig("test", map[string]string{"group": "test"}, "unknown"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fake.NewSimpleClientset()
+			mclient := monitoringfake.NewSimpleClientset()
+
+			o := &Operator{
+				kclient: c,
+				mclient: mclient,
+				logger:  log.NewNopLogger(),
+				metrics: operator.NewMetrics("alertmanager", prometheus.NewRegistry()),
+			}
+
+			if err := o.bootstrap(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "am",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					AlertmanagerConfigSelector: tc.selector,
 				},
+			}
+
+			store := assets.NewStore(c.CoreV1(), c.CoreV1())
+			conf, _, err := o.generateAlertmanagerConfiguration(context.Background(), am, store, true, tc.pending)
+
+			if !tc.ok {
+				if err == nil {
+					t.Fatal("expecting error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error but got %q", err)
+			}
+
+			cfg, err := loadCfg(string(conf))
+			if err != nil {
+				t.Fatalf("invalid configuration: %v", err)
+			}
+
+			var found bool
+			for _, r := range cfg.Receivers {
+				if r.Name == "test-pending-webhook" {
+					found = true
+				}
+			}
+			if !found {
+				t.Fatalf("expecting the receiver of the pending AlertmanagerConfig in the configuration, got:\n%s", conf)
+			}
+
+			if len(mclient.Actions()) != 0 {
+				t.Fatalf("expected no update of AlertmanagerConfig objects, got %v", mclient.Actions())
+			}
+		})
+	}
+}
+
+func TestUpdateAlertmanagerConfigStatus(t *testing.T) {
+	amc := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "amc",
+			Namespace:  "ns1",
+			Generation: 2,
+		},
+	}
+	mclient := monitoringfake.NewSimpleClientset(amc)
+
+	o := &Operator{
+		mclient: mclient,
+		logger:  log.NewNopLogger(),
+	}
+
+	for _, tc := range []struct {
+		name            string
+		err             error
+		expectedStatus  monitoringv1alpha1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+		expectedActions int
+	}{
+		{
+			name:            "valid",
+			expectedStatus:  monitoringv1alpha1.ConditionTrue,
+			expectedReason:  acceptedReason,
+			expectedActions: 1,
+		},
+		{
+			name:            "invalid",
+			err:             errors.New("invalid receiver"),
+			expectedStatus:  monitoringv1alpha1.ConditionFalse,
+			expectedReason:  invalidConfigurationReason,
+			expectedMessage: "invalid receiver",
+			expectedActions: 1,
+		},
+		{
+			name:            "unchanged",
+			err:             errors.New("invalid receiver"),
+			expectedStatus:  monitoringv1alpha1.ConditionFalse,
+			expectedReason:  invalidConfigurationReason,
+			expectedMessage: "invalid receiver",
+			expectedActions: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			current, err := mclient.MonitoringV1alpha1().AlertmanagerConfigs("ns1").Get(context.Background(), "amc", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			mclient.ClearActions()
+
+			o.updateAlertmanagerConfigStatus(context.Background(), current, tc.err)
+
+			if len(mclient.Actions()) != tc.expectedActions {
+				t.Fatalf("expected %d actions, got %d", tc.expectedActions, len(mclient.Actions()))
+			}
+
+			updated, err := mclient.MonitoringV1alpha1().AlertmanagerConfigs("ns1").Get(context.Background(), "amc", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if updated.Status == nil || len(updated.Status.Conditions) != 1 {
+				t.Fatalf("expected 1 condition, got %v", updated.Status)
+			}
+
+			cond := updated.Status.Conditions[0]
+			if cond.Type != monitoringv1alpha1.AlertmanagerConfigAccepted {
+				t.Fatalf("expected condition type %q, got %q", monitoringv1alpha1.AlertmanagerConfigAccepted, cond.Type)
+			}
+			if cond.Status != tc.expectedStatus {
+				t.Fatalf("expected status %q, got %q", tc.expectedStatus, cond.Status)
+			}
+			if cond.Reason != tc.expectedReason {
+				t.Fatalf("expected reason %q, got %q", tc.expectedReason, cond.Reason)
+			}
+			if cond.Message != tc.expectedMessage {
+				t.Fatalf("expected message %q, got %q", tc.expectedMessage, cond.Message)
+			}
+			if cond.ObservedGeneration != 2 {
+				t.Fatalf("expected observed generation 2, got %d", cond.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func TestCheckSecretNamespaces(t *testing.T) {
+	nsInf := cache.NewSharedIndexInformer(&cache.ListWatch{}, &v1.Namespace{}, 0, cache.Indexers{})
+	for _, ns := range []*v1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns1",
 			},
-			ok:           true,
-			expectedKeys: []string{"key1"},
 		},
 		{
-			am: &monitoringv1.Alertmanager{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "valid-user-config-and-additional-secret-with-selectors",
-					Namespace: "test",
-				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					ConfigSecret:               "amconfig",
-					AlertmanagerConfigSelector: &metav1.LabelSelector{},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "allowed",
+				Annotations: map[string]string{
+					monitoringv1alpha1.AllowCrossNamespaceSecretsAnnotation: "true",
 				},
 			},
-			objects: []runtime.Object{
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "amconfig",
-						Namespace: "test",
-					},
-					Data: map[string][]byte{
-						"alertmanager.yaml": []byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`),
-						"key1":              []byte(`val1`),
-					},
-				},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "denied",
 			},
-			ok:           true,
-			expectedKeys: []string{"key1"},
+		},
+	} {
+		if err := nsInf.GetStore().Add(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name            string
+		secretNamespace string
+		allowAll        bool
+		ok              bool
+	}{
+		{
+			name: "same namespace",
+			ok:   true,
 		},
 		{
-			am: &monitoringv1.Alertmanager{
+			name:            "explicit same namespace",
+			secretNamespace: "ns1",
+			ok:              true,
+		},
+		{
+			name:            "annotated namespace",
+			secretNamespace: "allowed",
+			ok:              true,
+		},
+		{
+			name:            "namespace without annotation",
+			secretNamespace: "denied",
+			ok:              false,
+		},
+		{
+			name:            "namespace without annotation and cross-namespace secrets allowed",
+			secretNamespace: "denied",
+			allowAll:        true,
+			ok:              true,
+		},
+		{
+			name:            "missing namespace",
+			secretNamespace: "missing",
+			ok:              false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Operator{
+				nsAlrtCfgInf: nsInf,
+				config: Config{
+					AllowCrossNamespaceSecrets: tc.allowAll,
+				},
+			}
+
+			amc := &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "no-user-config-but-additional-secret",
-					Namespace: "test",
+					Name:      "amc",
+					Namespace: "ns1",
 				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					ConfigSecret: "amconfig",
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name:            "test",
+						SecretNamespace: tc.secretNamespace,
+					}},
 				},
+			}
+
+			err := o.checkSecretNamespaces(amc)
+			if tc.ok && err != nil {
+				t.Fatalf("expecting no error but got %q", err)
+			}
+			if !tc.ok && err == nil {
+				t.Fatal("expecting error but got none")
+			}
+		})
+	}
+}
+
+func TestSelectClusterAlertmanagerConfigs(t *testing.T) {
+	valid := &monitoringv1alpha1.ClusterAlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "valid",
+			Labels: map[string]string{"scope": "org"},
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "pager",
 			},
-			objects: []runtime.Object{
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "amconfig",
-						Namespace: "test",
-					},
-					Data: map[string][]byte{
-						"key1": []byte(`val1`),
-					},
-				},
+			Receivers: []monitoringv1alpha1.Receiver{{Name: "pager"}},
+		},
+	}
+	invalid := &monitoringv1alpha1.ClusterAlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "invalid",
+			Labels: map[string]string{"scope": "org"},
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "missing",
+			},
+		},
+	}
+	unselected := &monitoringv1alpha1.ClusterAlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "unselected",
+		},
+	}
+
+	kclient := fake.NewSimpleClientset()
+	mclient := monitoringfake.NewSimpleClientset(valid, invalid, unselected)
+
+	inf := cache.NewSharedIndexInformer(&cache.ListWatch{}, &monitoringv1alpha1.ClusterAlertmanagerConfig{}, 0, cache.Indexers{})
+	for _, camc := range []*monitoringv1alpha1.ClusterAlertmanagerConfig{valid, invalid, unselected} {
+		if err := inf.GetStore().Add(camc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	o := &Operator{
+		kclient:           kclient,
+		mclient:           mclient,
+		logger:            log.NewNopLogger(),
+		metrics:           operator.NewMetrics("alertmanager", prometheus.NewRegistry()),
+		eventRecorder:     operator.NewEventRecorder(kclient, "alertmanager", log.NewNopLogger()),
+		clusterAlrtCfgInf: inf,
+	}
+
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "am",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			ClusterAlertmanagerConfigSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"scope": "org"},
+			},
+		},
+	}
+
+	store := assets.NewStore(kclient.CoreV1(), kclient.CoreV1())
+	baseConfig := &alertmanagerConfig{
+		Route:     &route{Receiver: "null"},
+		Receivers: []*receiver{{Name: "null"}},
+	}
+	selected, err := o.selectClusterAlertmanagerConfigs(context.Background(), am, semver.MustParse("0.24.0"), store, baseConfig, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(selected) != 1 {
+		t.Fatalf("expected 1 selected object, got %d", len(selected))
+	}
+	amc, found := selected["valid"]
+	if !found {
+		t.Fatalf("expected the valid object to be selected")
+	}
+	if amc.Namespace != am.Namespace {
+		t.Fatalf("expected the object to be scoped to namespace %q, got %q", am.Namespace, amc.Namespace)
+	}
+
+	for name, expected := range map[string]monitoringv1alpha1.ConditionStatus{
+		"valid":   monitoringv1alpha1.ConditionTrue,
+		"invalid": monitoringv1alpha1.ConditionFalse,
+	} {
+		camc, err := mclient.MonitoringV1alpha1().ClusterAlertmanagerConfigs().Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if camc.Status == nil || len(camc.Status.Conditions) != 1 {
+			t.Fatalf("%s: expected 1 condition, got %v", name, camc.Status)
+		}
+		if camc.Status.Conditions[0].Status != expected {
+			t.Fatalf("%s: expected status %q, got %q", name, expected, camc.Status.Conditions[0].Status)
+		}
+	}
+
+	events, err := kclient.CoreV1().Events(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events.Items) != 1 || events.Items[0].InvolvedObject.Name != "invalid" {
+		t.Fatalf("expected 1 event for the invalid object, got %v", events.Items)
+	}
+
+	// The receivers generated from the ClusterAlertmanagerConfig objects
+	// collide with the AlertmanagerConfig objects merged afterwards.
+	collisions := checkNameCollisions(withGeneratedNames(baseConfig, selected), map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"monitoring/valid": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "valid",
+				Namespace: "monitoring",
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route:     &monitoringv1alpha1.Route{Receiver: "pager"},
+				Receivers: []monitoringv1alpha1.Receiver{{Name: "pager"}},
 			},
-			ok:           true,
-			expectedKeys: []string{"key1"},
 		},
+	})
+	if _, found := collisions["monitoring/valid"]; !found {
+		t.Fatalf("expected a name collision")
+	}
+}
+
+func TestCheckNameCollisions(t *testing.T) {
+	newAMC := func(ns, name, receiver, timeInterval string) *monitoringv1alpha1.AlertmanagerConfig {
+		amc := &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+			},
+		}
+		if receiver != "" {
+			amc.Spec.Route = &monitoringv1alpha1.Route{Receiver: receiver}
+			amc.Spec.Receivers = []monitoringv1alpha1.Receiver{{Name: receiver}}
+		}
+		if timeInterval != "" {
+			amc.Spec.MuteTimeIntervals = []monitoringv1alpha1.MuteTimeInterval{{Name: timeInterval}}
+		}
+		return amc
+	}
+
+	for _, tc := range []struct {
+		name        string
+		baseConfig  *alertmanagerConfig
+		amConfigs   map[string]*monitoringv1alpha1.AlertmanagerConfig
+		expectedErr []string
+	}{
 		{
-			am: &monitoringv1.Alertmanager{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "no-user-config-but-additional-secret-with-selectors",
-					Namespace: "test",
-				},
-				Spec: monitoringv1.AlertmanagerSpec{
-					ConfigSecret:               "amconfig",
-					AlertmanagerConfigSelector: &metav1.LabelSelector{},
-				},
+			name: "no collision",
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"a/b": newAMC("a", "b", "c", "c"),
+				"a/c": newAMC("a", "c", "c", "c"),
 			},
-			objects: []runtime.Object{
-				&v1.Secret{
+		},
+		{
+			name: "receiver collision",
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"a-b/c": newAMC("a-b", "c", "d", ""),
+				"a/b-c": newAMC("a", "b-c", "d", ""),
+			},
+			expectedErr: []string{"a-b/c"},
+		},
+		{
+			name: "time interval collision",
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"a-b/c": newAMC("a-b", "c", "", "d"),
+				"a/b":   newAMC("a", "b", "", "c-d"),
+			},
+			expectedErr: []string{"a-b/c"},
+		},
+		{
+			name: "collision with the base configuration",
+			baseConfig: &alertmanagerConfig{
+				Receivers: []*receiver{{Name: "a-b-c"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"a/b": newAMC("a", "b", "c", ""),
+			},
+			expectedErr: []string{"a/b"},
+		},
+		{
+			name: "no collision for receivers without route",
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"a-b/c": newAMC("a-b", "c", "d", ""),
+				"a/b-c": {
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "amconfig",
-						Namespace: "test",
+						Name:      "b-c",
+						Namespace: "a",
 					},
-					Data: map[string][]byte{
-						"key1": []byte(`val1`),
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "d"}},
 					},
 				},
 			},
-			ok:           true,
-			expectedKeys: []string{"key1"},
 		},
 	} {
-		t.Run(tc.am.Name, func(t *testing.T) {
-			c := fake.NewSimpleClientset(tc.objects...)
+		t.Run(tc.name, func(t *testing.T) {
+			errs := checkNameCollisions(tc.baseConfig, tc.amConfigs)
 
-			o := &Operator{
-				kclient: c,
-				mclient: monitoringfake.NewSimpleClientset(),
-				logger:  log.NewNopLogger(),
-				metrics: operator.NewMetrics("alertmanager", prometheus.NewRegistry()),
+			if len(errs) != len(tc.expectedErr) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tc.expectedErr), len(errs), errs)
 			}
 
-			err := o.bootstrap(context.Background())
+			for _, k := range tc.expectedErr {
+				if _, found := errs[k]; !found {
+					t.Fatalf("expected error for %q, got %v", k, errs)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateAlertmanagerStatus(t *testing.T) {
+	replicas := int32(2)
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "am",
+			Namespace:  "ns1",
+			Generation: 3,
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			Replicas: &replicas,
+		},
+	}
+
+	containers := []v1.Container{{Name: "alertmanager", Image: "quay.io/prometheus/alertmanager:v0.21.0"}}
+	pod := func(name string, ready bool) *v1.Pod {
+		p := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns1",
+				Labels: map[string]string{
+					"app":          "alertmanager",
+					"alertmanager": "am",
+				},
+			},
+			Spec: v1.PodSpec{Containers: containers},
+			Status: v1.PodStatus{
+				Phase: v1.PodPending,
+			},
+		}
+		if ready {
+			p.Status.Phase = v1.PodRunning
+			p.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+		}
+		return p
+	}
+
+	kclient := fake.NewSimpleClientset(
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "alertmanager-am",
+				Namespace: "ns1",
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{Containers: containers},
+				},
+			},
+		},
+		pod("alertmanager-am-0", true),
+		pod("alertmanager-am-1", false),
+	)
+	mclient := monitoringfake.NewSimpleClientset(am)
+
+	o := &Operator{
+		kclient: kclient,
+		mclient: mclient,
+		logger:  log.NewNopLogger(),
+	}
+
+	for _, tc := range []struct {
+		name              string
+		err               error
+		expectedActions   int
+		expectedReconcile monitoringv1.ConditionStatus
+		expectedReason    string
+	}{
+		{
+			name:              "reconciled",
+			expectedActions:   1,
+			expectedReconcile: monitoringv1.ConditionTrue,
+			expectedReason:    reconciliationSucceededReason,
+		},
+		{
+			name:              "unchanged",
+			expectedActions:   0,
+			expectedReconcile: monitoringv1.ConditionTrue,
+			expectedReason:    reconciliationSucceededReason,
+		},
+		{
+			name:              "failed",
+			err:               errors.New("creating statefulset failed"),
+			expectedActions:   1,
+			expectedReconcile: monitoringv1.ConditionFalse,
+			expectedReason:    reconciliationFailedReason,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			current, err := mclient.MonitoringV1().Alertmanagers("ns1").Get(context.Background(), "am", metav1.GetOptions{})
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
+			mclient.ClearActions()
 
-			store := assets.NewStore(c.CoreV1(), c.CoreV1())
-			err = o.provisionAlertmanagerConfiguration(context.Background(), tc.am, store)
+			o.updateAlertmanagerStatus(context.Background(), current, tc.err)
 
-			if !tc.ok {
-				if err == nil {
-					t.Fatal("expecting error but got none")
-				}
-				return
+			if len(mclient.Actions()) != tc.expectedActions {
+				t.Fatalf("expected %d actions, got %d", tc.expectedActions, len(mclient.Actions()))
 			}
 
+			updated, err := mclient.MonitoringV1().Alertmanagers("ns1").Get(context.Background(), "am", metav1.GetOptions{})
 			if err != nil {
-				t.Fatalf("expecting no error but got %q", err)
+				t.Fatalf("unexpected error: %v", err)
 			}
 
-			secret, err := c.CoreV1().Secrets(tc.am.Namespace).Get(context.Background(), generatedConfigSecretName(tc.am.Name), metav1.GetOptions{})
-			if err != nil {
-				t.Fatalf("unexpected error: %v", err)
+			status := updated.Status
+			if status == nil {
+				t.Fatal("expected status to be set")
+			}
+			if status.Replicas != 2 || status.AvailableReplicas != 1 || status.UpdatedReplicas != 1 || status.UnavailableReplicas != 1 {
+				t.Fatalf("unexpected replicas in status: %+v", status)
+			}
+			if status.Selector != "alertmanager=am,app=alertmanager" {
+				t.Fatalf("unexpected selector %q", status.Selector)
+			}
+			if len(status.Conditions) != 2 {
+				t.Fatalf("expected 2 conditions, got %v", status.Conditions)
 			}
 
-			expected := append(tc.expectedKeys, alertmanagerConfigFile)
-			if len(secret.Data) != len(expected) {
-				t.Fatalf("expecting %d items to be present in the generated secret but got %d", len(expected), len(secret.Data))
+			available := status.Conditions[0]
+			if available.Type != monitoringv1.AlertmanagerAvailable {
+				t.Fatalf("expected condition type %q, got %q", monitoringv1.AlertmanagerAvailable, available.Type)
 			}
-			for _, k := range expected {
-				if _, found := secret.Data[k]; !found {
-					t.Fatalf("expecting key %q to be present in the generated secret but got nothing", k)
-				}
+			if available.Status != monitoringv1.ConditionDegraded || available.Reason != somePodsNotReadyReason {
+				t.Fatalf("unexpected available condition: %+v", available)
+			}
+
+			reconciled := status.Conditions[1]
+			if reconciled.Type != monitoringv1.AlertmanagerReconciled {
+				t.Fatalf("expected condition type %q, got %q", monitoringv1.AlertmanagerReconciled, reconciled.Type)
+			}
+			if reconciled.Status != tc.expectedReconcile || reconciled.Reason != tc.expectedReason {
+				t.Fatalf("unexpected reconciled condition: %+v", reconciled)
+			}
+			if reconciled.ObservedGeneration != 3 {
+				t.Fatalf("expected observed generation 3, got %d", reconciled.ObservedGeneration)
 			}
 		})
 	}
@@ -16,12 +16,14 @@ package alertmanager
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"path"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -43,6 +45,12 @@ const (
 	alertmanagerConfigFile = "alertmanager.yaml"
 	alertmanagerStorageDir = "/alertmanager"
 	defaultPortName        = "web"
+
+	// The generated configuration is stored compressed in the config Secret
+	// and decompressed by the config-reloader into the output directory.
+	alertmanagerConfigFileCompressed = "alertmanager.yaml.gz"
+	alertmanagerConfigOutDir         = "/etc/alertmanager/config_out"
+	alertmanagerConfigEnvsubstFile   = "alertmanager.env.yaml"
 )
 
 var (
@@ -205,6 +213,40 @@ func makeStatefulSetService(p *monitoringv1.Alertmanager, config Config) *v1.Ser
 	return svc
 }
 
+// makePodDisruptionBudget returns the PodDisruptionBudget of the
+// Alertmanager pods.
+func makePodDisruptionBudget(am *monitoringv1.Alertmanager, config Config) (*policyv1beta1.PodDisruptionBudget, error) {
+	spec := am.Spec.PodDisruptionBudget
+	if (spec.MinAvailable == nil) == (spec.MaxUnavailable == nil) {
+		return nil, errors.New("exactly one of minAvailable and maxUnavailable must be set in podDisruptionBudget")
+	}
+
+	return &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   prefixedName(am.Name),
+			Labels: config.Labels.Merge(managedByOperatorLabels),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Name:       am.GetName(),
+					Kind:       am.Kind,
+					APIVersion: am.APIVersion,
+					UID:        am.GetUID(),
+				},
+			},
+		},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable:   spec.MinAvailable,
+			MaxUnavailable: spec.MaxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: config.Labels.Merge(map[string]string{
+					"app":          "alertmanager",
+					"alertmanager": am.Name,
+				}),
+			},
+		},
+	}, nil
+}
+
 func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.StatefulSetSpec, error) {
 	// Before editing 'a' create deep copy, to prevent side effects. For more
 	// details see https://github.com/prometheus-operator/prometheus-operator/issues/1659
@@ -228,11 +270,15 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 	}
 
 	amArgs := []string{
-		fmt.Sprintf("--config.file=%s", path.Join(alertmanagerConfigDir, alertmanagerConfigFile)),
+		fmt.Sprintf("--config.file=%s", path.Join(alertmanagerConfigOutDir, alertmanagerConfigEnvsubstFile)),
 		fmt.Sprintf("--storage.path=%s", alertmanagerStorageDir),
 		fmt.Sprintf("--data.retention=%s", a.Spec.Retention),
 	}
 
+	if a.Spec.AlertsGCInterval != "" {
+		amArgs = append(amArgs, fmt.Sprintf("--alerts.gc-interval=%s", a.Spec.AlertsGCInterval))
+	}
+
 	if *a.Spec.Replicas == 1 && !a.Spec.ForceEnableClusterMode {
 		amArgs = append(amArgs, "--cluster.listen-address=")
 	} else {
@@ -249,6 +295,10 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 		amArgs = append(amArgs, "--web.external-url="+a.Spec.ExternalURL)
 	}
 
+	if a.Spec.Web != nil {
+		amArgs = append(amArgs, fmt.Sprintf("--web.config.file=%s", path.Join(webConfigDir, webConfigFile)))
+	}
+
 	webRoutePrefix := "/"
 	if a.Spec.RoutePrefix != "" {
 		webRoutePrefix = a.Spec.RoutePrefix
@@ -281,22 +331,56 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 		amArgs = append(amArgs, fmt.Sprintf("--cluster.peer-timeout=%s", a.Spec.ClusterPeerTimeout))
 	}
 
+	if a.Spec.ClusterTCPTimeout != "" {
+		amArgs = append(amArgs, fmt.Sprintf("--cluster.tcp-timeout=%s", a.Spec.ClusterTCPTimeout))
+	}
+
+	if a.Spec.ClusterProbeInterval != "" {
+		amArgs = append(amArgs, fmt.Sprintf("--cluster.probe-interval=%s", a.Spec.ClusterProbeInterval))
+	}
+
+	if a.Spec.ClusterProbeTimeout != "" {
+		amArgs = append(amArgs, fmt.Sprintf("--cluster.probe-timeout=%s", a.Spec.ClusterProbeTimeout))
+	}
+
+	if a.Spec.ClusterTLS != nil {
+		amArgs = append(amArgs, fmt.Sprintf("--cluster.tls-config=%s", path.Join(clusterTLSConfigDir, clusterTLSConfigFile)))
+	}
+
+	// The flag 'cluster.label' exists since Alertmanager v0.26.0.
+	if a.Spec.ClusterLabel != "" && version.GTE(semver.MustParse("0.26.0")) {
+		amArgs = append(amArgs, fmt.Sprintf("--cluster.label=%s", a.Spec.ClusterLabel))
+	}
+
+	// The flag 'enable-feature' exists since Alertmanager v0.27.0.
+	if len(a.Spec.EnableFeatures) > 0 && version.GTE(semver.MustParse("0.27.0")) {
+		amArgs = append(amArgs, fmt.Sprintf("--enable-feature=%s", strings.Join(a.Spec.EnableFeatures, ",")))
+	}
+
+	webScheme := v1.URISchemeHTTP
+	if webTLSEnabled(a) {
+		webScheme = v1.URISchemeHTTPS
+	}
+
 	livenessProbeHandler := v1.Handler{
 		HTTPGet: &v1.HTTPGetAction{
-			Path: path.Clean(webRoutePrefix + "/-/healthy"),
-			Port: intstr.FromString(a.Spec.PortName),
+			Path:   path.Clean(webRoutePrefix + "/-/healthy"),
+			Port:   intstr.FromString(a.Spec.PortName),
+			Scheme: webScheme,
 		},
 	}
 
 	readinessProbeHandler := v1.Handler{
 		HTTPGet: &v1.HTTPGetAction{
-			Path: path.Clean(webRoutePrefix + "/-/ready"),
-			Port: intstr.FromString(a.Spec.PortName),
+			Path:   path.Clean(webRoutePrefix + "/-/ready"),
+			Port:   intstr.FromString(a.Spec.PortName),
+			Scheme: webScheme,
 		},
 	}
 
 	var livenessProbe *v1.Probe
 	var readinessProbe *v1.Probe
+	var startupProbe *v1.Probe
 	if !a.Spec.ListenLocal {
 		livenessProbe = &v1.Probe{
 			Handler:          livenessProbeHandler,
@@ -311,7 +395,17 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 			PeriodSeconds:       5,
 			FailureThreshold:    10,
 		}
+
+		if a.Spec.StartupProbe != nil {
+			startupProbe = &v1.Probe{
+				Handler:        livenessProbeHandler,
+				TimeoutSeconds: probeTimeoutSeconds,
+			}
+		}
 	}
+	livenessProbe = operator.MakeProbe(livenessProbe, a.Spec.LivenessProbe)
+	readinessProbe = operator.MakeProbe(readinessProbe, a.Spec.ReadinessProbe)
+	startupProbe = operator.MakeProbe(startupProbe, a.Spec.StartupProbe)
 
 	podAnnotations := map[string]string{}
 	podLabels := map[string]string{}
@@ -347,7 +441,7 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 	}
 
 	for _, peer := range a.Spec.AdditionalPeers {
-		amArgs = append(amArgs, fmt.Sprintf("--cluster.peer=%s", peer))
+		amArgs = append(amArgs, fmt.Sprintf("--cluster.peer=%s", additionalPeerAddress(peer)))
 	}
 
 	ports := []v1.ContainerPort{
@@ -411,6 +505,11 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 		return nil, errors.Errorf("unsupported Alertmanager major version %s", version)
 	}
 
+	amArgs, err = operator.BuildArgs(amArgs, a.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
 	volumes := []v1.Volume{
 		{
 			Name: "config-volume",
@@ -428,6 +527,12 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 				},
 			},
 		},
+		{
+			Name: "config-out",
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		},
 	}
 
 	volName := volumeName(a.Name)
@@ -442,6 +547,11 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 			Name:      "config-volume",
 			MountPath: alertmanagerConfigDir,
 		},
+		{
+			Name:      "config-out",
+			ReadOnly:  true,
+			MountPath: alertmanagerConfigOutDir,
+		},
 		{
 			Name:      "tls-assets",
 			ReadOnly:  true,
@@ -454,13 +564,24 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 		},
 	}
 
-	reloadWatchDirs := []string{alertmanagerConfigDir}
+	// The TLS assets referenced by the AlertmanagerConfig receivers are
+	// watched too so that certificate rotations trigger a reload.
+	reloadWatchDirs := []string{alertmanagerConfigDir, tlsAssetsDir}
 	configReloaderVolumeMounts := []v1.VolumeMount{
 		{
 			Name:      "config-volume",
 			MountPath: alertmanagerConfigDir,
 			ReadOnly:  true,
 		},
+		{
+			Name:      "config-out",
+			MountPath: alertmanagerConfigOutDir,
+		},
+		{
+			Name:      "tls-assets",
+			MountPath: tlsAssetsDir,
+			ReadOnly:  true,
+		},
 	}
 
 	for _, s := range a.Spec.Secrets {
@@ -505,17 +626,92 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 		reloadWatchDirs = append(reloadWatchDirs, mountPath)
 	}
 
+	if a.Spec.Web != nil {
+		volumes = append(volumes, v1.Volume{
+			Name: "web-config",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: webConfigSecretName(a.Name),
+				},
+			},
+		})
+		amVolumeMounts = append(amVolumeMounts, v1.VolumeMount{
+			Name:      "web-config",
+			ReadOnly:  true,
+			MountPath: webConfigDir,
+		})
+	}
+
+	if a.Spec.ClusterTLS != nil {
+		volumes = append(volumes, v1.Volume{
+			Name: "cluster-tls-config",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: clusterTLSConfigSecretName(a.Name),
+				},
+			},
+		})
+		amVolumeMounts = append(amVolumeMounts, v1.VolumeMount{
+			Name:      "cluster-tls-config",
+			ReadOnly:  true,
+			MountPath: clusterTLSConfigDir,
+		})
+	}
+
 	amVolumeMounts = append(amVolumeMounts, a.Spec.VolumeMounts...)
 
 	terminationGracePeriod := int64(120)
 	finalSelectorLabels := config.Labels.Merge(podSelectorLabels)
 	finalLabels := config.Labels.Merge(podLabels)
 
-	var configReloaderArgs []string
+	configReloaderArgs := []string{
+		fmt.Sprintf("--config-file=%s", path.Join(alertmanagerConfigDir, alertmanagerConfigFileCompressed)),
+		fmt.Sprintf("--config-envsubst-file=%s", path.Join(alertmanagerConfigOutDir, alertmanagerConfigEnvsubstFile)),
+	}
+
+	// The configuration file read by Alertmanager lives in an emptyDir volume
+	// so it needs to be written before Alertmanager starts.
+	initContainers, err := k8sutil.MergePatchContainers(
+		[]v1.Container{
+			operator.CreateInitConfigReloader(
+				config.ReloaderConfig,
+				a.Spec.ConfigReloaderResources,
+				a.Spec.LogFormat,
+				a.Spec.LogLevel,
+				configReloaderArgs,
+				[]v1.VolumeMount{
+					{
+						Name:      "config-volume",
+						MountPath: alertmanagerConfigDir,
+						ReadOnly:  true,
+					},
+					{
+						Name:      "config-out",
+						MountPath: alertmanagerConfigOutDir,
+					},
+				},
+			),
+		},
+		a.Spec.InitContainers,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to merge init containers spec")
+	}
+	for i := range initContainers {
+		if initContainers[i].Name == "init-config-reloader" {
+			initContainers[i].ImagePullPolicy = a.Spec.ImagePullPolicy
+		}
+	}
+
 	for _, reloadWatchDir := range reloadWatchDirs {
 		configReloaderArgs = append(configReloaderArgs, fmt.Sprintf("--watched-dir=%s", reloadWatchDir))
 	}
 
+	reloaderConfig, err := config.ReloaderConfig.WithThrottling(a.Spec.ReloadDelayInterval, a.Spec.MaxConcurrentReloads)
+	if err != nil {
+		return nil, err
+	}
+
 	defaultContainers := []v1.Container{
 		{
 			Args:           amArgs,
@@ -525,6 +721,7 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 			VolumeMounts:   amVolumeMounts,
 			LivenessProbe:  livenessProbe,
 			ReadinessProbe: readinessProbe,
+			StartupProbe:   startupProbe,
 			Resources:      a.Spec.Resources,
 			Env: []v1.EnvVar{
 				{
@@ -540,9 +737,10 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 		},
 		operator.CreateConfigReloader(
-			config.ReloaderConfig,
+			reloaderConfig,
+			a.Spec.ConfigReloaderResources,
 			url.URL{
-				Scheme: "http",
+				Scheme: strings.ToLower(string(webScheme)),
 				Host:   config.LocalHost + ":9093",
 				Path:   path.Clean(webRoutePrefix + "/-/reload"),
 			},
@@ -556,6 +754,10 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 		),
 	}
 
+	for i := range defaultContainers {
+		defaultContainers[i].ImagePullPolicy = a.Spec.ImagePullPolicy
+	}
+
 	containers, err := k8sutil.MergePatchContainers(defaultContainers, a.Spec.Containers)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to merge containers spec")
@@ -582,14 +784,19 @@ func makeStatefulSetSpec(a *monitoringv1.Alertmanager, config Config) (*appsv1.S
 				NodeSelector:                  a.Spec.NodeSelector,
 				PriorityClassName:             a.Spec.PriorityClassName,
 				TerminationGracePeriodSeconds: &terminationGracePeriod,
-				InitContainers:                a.Spec.InitContainers,
+				InitContainers:                initContainers,
 				Containers:                    containers,
 				Volumes:                       volumes,
 				ServiceAccountName:            a.Spec.ServiceAccountName,
+				AutomountServiceAccountToken:  a.Spec.AutomountServiceAccountToken,
 				SecurityContext:               a.Spec.SecurityContext,
 				Tolerations:                   a.Spec.Tolerations,
 				Affinity:                      a.Spec.Affinity,
 				TopologySpreadConstraints:     a.Spec.TopologySpreadConstraints,
+				HostAliases:                   a.Spec.HostAliases,
+				HostNetwork:                   a.Spec.HostNetwork,
+				DNSPolicy:                     operator.MakeDNSPolicy(a.Spec.HostNetwork, a.Spec.DNSPolicy),
+				DNSConfig:                     a.Spec.DNSConfig,
 			},
 		},
 	}, nil
@@ -632,3 +839,12 @@ func filter(strings []string, f func(string) bool) []string {
 	}
 	return filteredStrings
 }
+
+// additionalPeerAddress returns the address of an additional cluster peer,
+// defaulting to the mesh port when the peer doesn't specify any port.
+func additionalPeerAddress(peer string) string {
+	if _, _, err := net.SplitHostPort(peer); err == nil {
+		return peer
+	}
+	return net.JoinHostPort(peer, "9094")
+}
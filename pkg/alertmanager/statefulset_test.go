@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kylelemons/godebug/pretty"
 )
@@ -404,6 +405,32 @@ func TestMakeStatefulSetSpecAdditionalPeers(t *testing.T) {
 	}
 }
 
+func TestAdditionalPeersAddress(t *testing.T) {
+	a := monitoringv1.Alertmanager{}
+	a.Spec.Version = "v0.22.0"
+	replicas := int32(1)
+	a.Spec.Replicas = &replicas
+	a.Spec.AdditionalPeers = []string{
+		"alertmanager.example.com",
+		"alertmanager.example.com:9095",
+		"10.0.0.1",
+		"::1",
+	}
+
+	statefulSet, err := makeStatefulSetSpec(&a, defaultTestConfig)
+	require.NoError(t, err)
+
+	amArgs := statefulSet.Template.Spec.Containers[0].Args
+	for _, expected := range []string{
+		"--cluster.peer=alertmanager.example.com:9094",
+		"--cluster.peer=alertmanager.example.com:9095",
+		"--cluster.peer=10.0.0.1:9094",
+		"--cluster.peer=[::1]:9094",
+	} {
+		require.Contains(t, amArgs, expected)
+	}
+}
+
 func TestAdditionalSecretsMounted(t *testing.T) {
 	secrets := []string{"secret1", "secret2"}
 	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
@@ -447,6 +474,126 @@ func TestAdditionalSecretsMounted(t *testing.T) {
 	}
 }
 
+func TestTLSAssetsMountedInConfigReloader(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{},
+		Spec:       monitoringv1.AlertmanagerSpec{},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	reloader := sset.Spec.Template.Spec.Containers[1]
+
+	mountFound := false
+	for _, v := range reloader.VolumeMounts {
+		if v.Name == "tls-assets" && v.MountPath == "/etc/alertmanager/certs" {
+			mountFound = true
+		}
+	}
+	if !mountFound {
+		t.Fatal("TLS assets volume not mounted in the config reloader.")
+	}
+
+	argFound := false
+	for _, arg := range reloader.Args {
+		if arg == "--watched-dir=/etc/alertmanager/certs" {
+			argFound = true
+		}
+	}
+	if !argFound {
+		t.Fatalf("TLS assets directory not watched by the config reloader: %v", reloader.Args)
+	}
+}
+
+func TestWebConfig(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			Version: "v0.22.0",
+			Web: &monitoringv1.AlertmanagerWebSpec{
+				TLSConfig: &monitoringv1.WebTLSConfig{
+					Cert: monitoringv1.SecretOrConfigMap{
+						Secret: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "web-tls"},
+							Key:                  "tls.crt",
+						},
+					},
+					KeySecret: v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "web-tls"},
+						Key:                  "tls.key",
+					},
+				},
+			},
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	am := sset.Spec.Template.Spec.Containers[0]
+	require.Contains(t, am.Args, "--web.config.file=/etc/alertmanager/web_config/web-config.yaml")
+	require.Equal(t, v1.URISchemeHTTPS, am.LivenessProbe.HTTPGet.Scheme)
+	require.Equal(t, v1.URISchemeHTTPS, am.ReadinessProbe.HTTPGet.Scheme)
+
+	mountFound := false
+	for _, v := range am.VolumeMounts {
+		if v.Name == "web-config" && v.MountPath == "/etc/alertmanager/web_config" {
+			mountFound = true
+		}
+	}
+	if !mountFound {
+		t.Fatal("web-config volume not mounted in the alertmanager container")
+	}
+
+	volumeFound := false
+	for _, v := range sset.Spec.Template.Spec.Volumes {
+		if v.Name == "web-config" && v.Secret != nil && v.Secret.SecretName == "alertmanager-test-web-config" {
+			volumeFound = true
+		}
+	}
+	if !volumeFound {
+		t.Fatal("web-config volume not found")
+	}
+
+	reloader := sset.Spec.Template.Spec.Containers[1]
+	reloadURLFound := false
+	for _, arg := range reloader.Args {
+		if strings.HasPrefix(arg, "--reload-url=https://") {
+			reloadURLFound = true
+		}
+	}
+	if !reloadURLFound {
+		t.Fatalf("expected the config reloader to use HTTPS: %v", reloader.Args)
+	}
+}
+
+func TestCompressedConfigDecompressedByConfigReloader(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{},
+		Spec:       monitoringv1.AlertmanagerSpec{},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	am := sset.Spec.Template.Spec.Containers[0]
+	require.Contains(t, am.Args, "--config.file=/etc/alertmanager/config_out/alertmanager.env.yaml")
+
+	reloader := sset.Spec.Template.Spec.Containers[1]
+	require.Contains(t, reloader.Args, "--config-file=/etc/alertmanager/config/alertmanager.yaml.gz")
+	require.Contains(t, reloader.Args, "--config-envsubst-file=/etc/alertmanager/config_out/alertmanager.env.yaml")
+
+	for _, c := range []v1.Container{am, reloader} {
+		mountFound := false
+		for _, v := range c.VolumeMounts {
+			if v.Name == "config-out" && v.MountPath == "/etc/alertmanager/config_out" {
+				mountFound = true
+				require.Equal(t, c.Name == "alertmanager", v.ReadOnly)
+			}
+		}
+		if !mountFound {
+			t.Fatalf("config-out volume not mounted in container %q", c.Name)
+		}
+	}
+}
+
 func TestAlertManagerDefaultBaseImageFlag(t *testing.T) {
 	alertManagerBaseImageConfig := Config{
 		ReloaderConfig: operator.ReloaderConfig{
@@ -666,6 +813,41 @@ func TestSidecarsNoMemoryLimits(t *testing.T) {
 	}
 }
 
+func TestConfigReloaderResources(t *testing.T) {
+	expectedResources := v1.ResourceRequirements{
+		Limits: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("200m"),
+			v1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("50m"),
+			v1.ResourceMemory: resource.MustParse("50Mi"),
+		},
+	}
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			ConfigReloaderResources: &expectedResources,
+		},
+	}, nil, defaultTestConfig)
+	if err != nil {
+		t.Fatalf("Unexpected error while making StatefulSet: %v", err)
+	}
+
+	var found bool
+	for _, c := range sset.Spec.Template.Spec.Containers {
+		if c.Name != "config-reloader" {
+			continue
+		}
+		found = true
+		if !reflect.DeepEqual(c.Resources, expectedResources) {
+			t.Fatalf("Expected resource requests/limits:\n\n%s\n\nGot:\n\n%s", expectedResources.String(), c.Resources.String())
+		}
+	}
+	if !found {
+		t.Fatal("expected to find the config-reloader container")
+	}
+}
+
 func TestTerminationPolicy(t *testing.T) {
 	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
 		Spec: monitoringv1.AlertmanagerSpec{},
@@ -681,6 +863,20 @@ func TestTerminationPolicy(t *testing.T) {
 	}
 }
 
+func TestInitConfigReloader(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	initContainers := sset.Spec.Template.Spec.InitContainers
+	require.Len(t, initContainers, 1)
+	require.Equal(t, "init-config-reloader", initContainers[0].Name)
+	require.Equal(t, []string{
+		"--watch-interval=0",
+		"--config-file=/etc/alertmanager/config/alertmanager.yaml.gz",
+		"--config-envsubst-file=/etc/alertmanager/config_out/alertmanager.env.yaml",
+	}, initContainers[0].Args)
+}
+
 func TestClusterListenAddressForSingleReplica(t *testing.T) {
 	a := monitoringv1.Alertmanager{}
 	replicas := int32(1)
@@ -759,3 +955,412 @@ func TestClusterListenAddressForMultiReplica(t *testing.T) {
 		t.Fatal("expected stateful set to contain arg '--cluster.listen-address=[$(POD_IP)]:9094'")
 	}
 }
+
+func TestAlertsGCInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		gcInterval   string
+		expectedArgs []string
+	}{
+		{
+			name:         "default",
+			expectedArgs: []string{},
+		},
+		{
+			name:         "custom",
+			gcInterval:   "10m",
+			expectedArgs: []string{"--alerts.gc-interval=10m"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+				Spec: monitoringv1.AlertmanagerSpec{
+					AlertsGCInterval: tc.gcInterval,
+				},
+			}, nil, defaultTestConfig)
+			require.NoError(t, err)
+
+			var gcArgs []string
+			for _, arg := range sset.Spec.Template.Spec.Containers[0].Args {
+				if strings.HasPrefix(arg, "--alerts.gc-interval") {
+					gcArgs = append(gcArgs, arg)
+				}
+			}
+			require.ElementsMatch(t, tc.expectedArgs, gcArgs)
+		})
+	}
+}
+
+func TestClusterParameters(t *testing.T) {
+	for _, tc := range []struct {
+		version       string
+		expectedLabel bool
+	}{
+		{
+			version:       "v0.25.0",
+			expectedLabel: false,
+		},
+		{
+			version:       "v0.26.0",
+			expectedLabel: true,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			a := monitoringv1.Alertmanager{}
+			replicas := int32(3)
+			a.Spec.Version = tc.version
+			a.Spec.Replicas = &replicas
+			a.Spec.ClusterTCPTimeout = "5s"
+			a.Spec.ClusterProbeInterval = "2s"
+			a.Spec.ClusterProbeTimeout = "1s"
+			a.Spec.ClusterLabel = "eu-west-1"
+
+			statefulSet, err := makeStatefulSetSpec(&a, defaultTestConfig)
+			require.NoError(t, err)
+
+			amArgs := statefulSet.Template.Spec.Containers[0].Args
+			require.Contains(t, amArgs, "--cluster.tcp-timeout=5s")
+			require.Contains(t, amArgs, "--cluster.probe-interval=2s")
+			require.Contains(t, amArgs, "--cluster.probe-timeout=1s")
+
+			if tc.expectedLabel {
+				require.Contains(t, amArgs, "--cluster.label=eu-west-1")
+			} else {
+				require.NotContains(t, amArgs, "--cluster.label=eu-west-1")
+			}
+		})
+	}
+}
+
+func TestEnableFeatures(t *testing.T) {
+	for _, tc := range []struct {
+		version      string
+		features     []string
+		expectedArgs []string
+	}{
+		{
+			version:      "v0.26.0",
+			features:     []string{"receiver-name-in-metrics"},
+			expectedArgs: nil,
+		},
+		{
+			version:      "v0.27.0",
+			features:     nil,
+			expectedArgs: nil,
+		},
+		{
+			version:      "v0.27.0",
+			features:     []string{"receiver-name-in-metrics", "classic-mode"},
+			expectedArgs: []string{"--enable-feature=receiver-name-in-metrics,classic-mode"},
+		},
+	} {
+		t.Run(fmt.Sprintf("%s %v", tc.version, tc.features), func(t *testing.T) {
+			sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+				Spec: monitoringv1.AlertmanagerSpec{
+					Version:        tc.version,
+					EnableFeatures: tc.features,
+				},
+			}, nil, defaultTestConfig)
+			require.NoError(t, err)
+
+			var featureArgs []string
+			for _, arg := range sset.Spec.Template.Spec.Containers[0].Args {
+				if strings.HasPrefix(arg, "--enable-feature") {
+					featureArgs = append(featureArgs, arg)
+				}
+			}
+			require.Equal(t, tc.expectedArgs, featureArgs)
+		})
+	}
+}
+
+func TestClusterTLS(t *testing.T) {
+	a := monitoringv1.Alertmanager{}
+	replicas := int32(3)
+	a.Name = "test"
+	a.Spec.Version = "v0.24.0"
+	a.Spec.Replicas = &replicas
+	a.Spec.ClusterTLS = &monitoringv1.ClusterTLSConfig{}
+
+	statefulSet, err := makeStatefulSetSpec(&a, defaultTestConfig)
+	require.NoError(t, err)
+
+	am := statefulSet.Template.Spec.Containers[0]
+	require.Contains(t, am.Args, "--cluster.tls-config=/etc/alertmanager/cluster_tls_config/cluster-tls-config.yaml")
+
+	mountFound := false
+	for _, v := range am.VolumeMounts {
+		if v.Name == "cluster-tls-config" && v.MountPath == "/etc/alertmanager/cluster_tls_config" {
+			mountFound = true
+		}
+	}
+	if !mountFound {
+		t.Fatal("cluster-tls-config volume not mounted in the alertmanager container")
+	}
+
+	volumeFound := false
+	for _, v := range statefulSet.Template.Spec.Volumes {
+		if v.Name == "cluster-tls-config" && v.Secret != nil && v.Secret.SecretName == "alertmanager-test-cluster-tls-config" {
+			volumeFound = true
+		}
+	}
+	if !volumeFound {
+		t.Fatal("cluster-tls-config volume not found")
+	}
+}
+
+func TestMakePodDisruptionBudget(t *testing.T) {
+	minAvailable := intstr.FromInt(2)
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns1",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			PodDisruptionBudget: &monitoringv1.PodDisruptionBudgetSpec{
+				MinAvailable: &minAvailable,
+			},
+		},
+	}
+
+	pdb, err := makePodDisruptionBudget(am, defaultTestConfig)
+	require.NoError(t, err)
+
+	require.Equal(t, "alertmanager-test", pdb.Name)
+	require.Equal(t, &minAvailable, pdb.Spec.MinAvailable)
+	require.Nil(t, pdb.Spec.MaxUnavailable)
+	require.Equal(t, map[string]string{
+		"app":          "alertmanager",
+		"alertmanager": "test",
+	}, pdb.Spec.Selector.MatchLabels)
+
+	maxUnavailable := intstr.FromInt(1)
+	am.Spec.PodDisruptionBudget.MaxUnavailable = &maxUnavailable
+	_, err = makePodDisruptionBudget(am, defaultTestConfig)
+	require.Error(t, err)
+
+	am.Spec.PodDisruptionBudget = &monitoringv1.PodDisruptionBudgetSpec{}
+	_, err = makePodDisruptionBudget(am, defaultTestConfig)
+	require.Error(t, err)
+}
+
+func TestTopologySpreadConstraints(t *testing.T) {
+	tsc := []v1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: v1.DoNotSchedule,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "alertmanager"},
+			},
+		},
+	}
+
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			TopologySpreadConstraints: tsc,
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	require.Equal(t, tsc, sset.Spec.Template.Spec.TopologySpreadConstraints)
+}
+
+func TestHostAliases(t *testing.T) {
+	hostAliases := []v1.HostAlias{
+		{
+			IP:        "10.0.0.1",
+			Hostnames: []string{"smtp.example.com"},
+		},
+	}
+
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			HostAliases: hostAliases,
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	require.Equal(t, hostAliases, sset.Spec.Template.Spec.HostAliases)
+}
+
+func TestProbes(t *testing.T) {
+	baseSet, err := makeStatefulSet(&monitoringv1.Alertmanager{}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	baseContainer := baseSet.Spec.Template.Spec.Containers[0]
+	require.NotNil(t, baseContainer.LivenessProbe)
+	require.NotNil(t, baseContainer.ReadinessProbe)
+	require.Nil(t, baseContainer.StartupProbe)
+
+	periodSeconds := int32(10)
+	failureThreshold := int32(30)
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			LivenessProbe: &monitoringv1.ProbeParameters{
+				Disabled: true,
+			},
+			ReadinessProbe: &monitoringv1.ProbeParameters{
+				PeriodSeconds: &periodSeconds,
+			},
+			StartupProbe: &monitoringv1.ProbeParameters{
+				FailureThreshold: &failureThreshold,
+			},
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	container := sset.Spec.Template.Spec.Containers[0]
+
+	require.Nil(t, container.LivenessProbe)
+
+	require.Equal(t, periodSeconds, container.ReadinessProbe.PeriodSeconds)
+	require.Equal(t, baseContainer.ReadinessProbe.InitialDelaySeconds, container.ReadinessProbe.InitialDelaySeconds)
+	require.Equal(t, baseContainer.ReadinessProbe.Handler, container.ReadinessProbe.Handler)
+
+	require.NotNil(t, container.StartupProbe)
+	require.Equal(t, baseContainer.LivenessProbe.Handler, container.StartupProbe.Handler)
+	require.Equal(t, failureThreshold, container.StartupProbe.FailureThreshold)
+}
+
+func TestAdditionalContainers(t *testing.T) {
+	baseSet, err := makeStatefulSet(&monitoringv1.Alertmanager{}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	// Adding a new container results in an additional container.
+	addSset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			Containers: []v1.Container{
+				{
+					Name: "auth-proxy",
+				},
+			},
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	require.Len(t, addSset.Spec.Template.Spec.Containers, len(baseSet.Spec.Template.Spec.Containers)+1)
+
+	// Adding a container with the name of an operator generated container
+	// patches the generated container.
+	modSset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			Containers: []v1.Container{
+				{
+					Name: "config-reloader",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{
+							v1.ResourceMemory: resource.MustParse("50Mi"),
+						},
+					},
+				},
+			},
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	require.Len(t, modSset.Spec.Template.Spec.Containers, len(baseSet.Spec.Template.Spec.Containers))
+
+	var found bool
+	for _, c := range modSset.Spec.Template.Spec.Containers {
+		if c.Name != "config-reloader" {
+			continue
+		}
+		found = true
+		require.Equal(t, resource.MustParse("50Mi"), c.Resources.Limits[v1.ResourceMemory])
+		require.NotEmpty(t, c.Image, "expected the image of the config-reloader container to be preserved")
+	}
+	require.True(t, found, "config-reloader container not found")
+}
+
+func TestInitContainers(t *testing.T) {
+	initContainers := []v1.Container{
+		{
+			Name:  "fetch-secrets",
+			Image: "quay.io/example/fetch-secrets:latest",
+		},
+	}
+
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			InitContainers: initContainers,
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	actual := sset.Spec.Template.Spec.InitContainers
+	require.Len(t, actual, 2)
+	require.Equal(t, "init-config-reloader", actual[0].Name)
+	require.Equal(t, initContainers[0], actual[1])
+}
+
+func TestImagePullPolicyAndAutomountServiceAccountToken(t *testing.T) {
+	automount := false
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			ImagePullPolicy:              v1.PullNever,
+			AutomountServiceAccountToken: &automount,
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	require.Equal(t, &automount, sset.Spec.Template.Spec.AutomountServiceAccountToken)
+	for _, c := range append(sset.Spec.Template.Spec.InitContainers, sset.Spec.Template.Spec.Containers...) {
+		require.Equal(t, v1.PullNever, c.ImagePullPolicy, "unexpected image pull policy for container %s", c.Name)
+	}
+}
+
+func TestLogLevelAndFormat(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			LogLevel:  "debug",
+			LogFormat: "json",
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+
+	for _, c := range sset.Spec.Template.Spec.Containers {
+		switch c.Name {
+		case "alertmanager":
+			require.Contains(t, c.Args, "--log.level=debug")
+			require.Contains(t, c.Args, "--log.format=json")
+		case "config-reloader":
+			require.Contains(t, c.Args, "--log-level=debug")
+			require.Contains(t, c.Args, "--log-format=json")
+		}
+	}
+}
+
+func TestAdditionalArgs(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			AdditionalArgs: []monitoringv1.Argument{
+				{Name: "cluster.settle-timeout", Value: "1m"},
+			},
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args, "--cluster.settle-timeout=1m")
+
+	_, err = makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			AdditionalArgs: []monitoringv1.Argument{
+				{Name: "web.listen-address", Value: ":8080"},
+			},
+		},
+	}, nil, defaultTestConfig)
+	require.Error(t, err)
+}
+
+func TestDNSPolicyAndHostNetwork(t *testing.T) {
+	dnsPolicy := v1.DNSDefault
+	dnsConfig := &v1.PodDNSConfig{
+		Nameservers: []string{"10.0.0.10"},
+	}
+
+	sset, err := makeStatefulSet(&monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			HostNetwork: true,
+			DNSPolicy:   &dnsPolicy,
+			DNSConfig:   dnsConfig,
+		},
+	}, nil, defaultTestConfig)
+	require.NoError(t, err)
+	require.True(t, sset.Spec.Template.Spec.HostNetwork)
+	require.Equal(t, v1.DNSDefault, sset.Spec.Template.Spec.DNSPolicy)
+	require.Equal(t, dnsConfig, sset.Spec.Template.Spec.DNSConfig)
+}
@@ -29,11 +29,12 @@ import (
 // marshalling. See the following issue for details:
 // https://github.com/prometheus/alertmanager/issues/1985
 type alertmanagerConfig struct {
-	Global       *globalConfig  `yaml:"global,omitempty" json:"global,omitempty"`
-	Route        *route         `yaml:"route,omitempty" json:"route,omitempty"`
-	InhibitRules []*inhibitRule `yaml:"inhibit_rules,omitempty" json:"inhibit_rules,omitempty"`
-	Receivers    []*receiver    `yaml:"receivers,omitempty" json:"receivers,omitempty"`
-	Templates    []string       `yaml:"templates" json:"templates"`
+	Global            *globalConfig       `yaml:"global,omitempty" json:"global,omitempty"`
+	Route             *route              `yaml:"route,omitempty" json:"route,omitempty"`
+	InhibitRules      []*inhibitRule      `yaml:"inhibit_rules,omitempty" json:"inhibit_rules,omitempty"`
+	Receivers         []*receiver         `yaml:"receivers,omitempty" json:"receivers,omitempty"`
+	Templates         []string            `yaml:"templates" json:"templates"`
+	MuteTimeIntervals []*muteTimeInterval `yaml:"mute_time_intervals,omitempty" json:"mute_time_intervals,omitempty"`
 }
 
 type globalConfig struct {
@@ -41,7 +42,7 @@ type globalConfig struct {
 	// if it has not been updated.
 	ResolveTimeout model.Duration `yaml:"resolve_timeout" json:"resolve_timeout"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	HTTPConfig *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
 	SMTPFrom         string          `yaml:"smtp_from,omitempty" json:"smtp_from,omitempty"`
 	SMTPHello        string          `yaml:"smtp_hello,omitempty" json:"smtp_hello,omitempty"`
@@ -50,7 +51,7 @@ type globalConfig struct {
 	SMTPAuthPassword string          `yaml:"smtp_auth_password,omitempty" json:"smtp_auth_password,omitempty"`
 	SMTPAuthSecret   string          `yaml:"smtp_auth_secret,omitempty" json:"smtp_auth_secret,omitempty"`
 	SMTPAuthIdentity string          `yaml:"smtp_auth_identity,omitempty" json:"smtp_auth_identity,omitempty"`
-	SMTPRequireTLS   bool            `yaml:"smtp_require_tls,omitempty" json:"smtp_require_tls,omitempty"`
+	SMTPRequireTLS   *bool           `yaml:"smtp_require_tls,omitempty" json:"smtp_require_tls,omitempty"`
 	SlackAPIURL      *config.URL     `yaml:"slack_api_url,omitempty" json:"slack_api_url,omitempty"`
 	PagerdutyURL     *config.URL     `yaml:"pagerduty_url,omitempty" json:"pagerduty_url,omitempty"`
 	HipchatAPIURL    *config.URL     `yaml:"hipchat_api_url,omitempty" json:"hipchat_api_url,omitempty"`
@@ -65,36 +66,47 @@ type globalConfig struct {
 }
 
 type route struct {
-	Receiver       string            `yaml:"receiver,omitempty" json:"receiver,omitempty"`
-	GroupByStr     []string          `yaml:"group_by,omitempty" json:"group_by,omitempty"`
-	Match          map[string]string `yaml:"match,omitempty" json:"match,omitempty"`
-	MatchRE        map[string]string `yaml:"match_re,omitempty" json:"match_re,omitempty"`
-	Continue       bool              `yaml:"continue,omitempty" json:"continue,omitempty"`
-	Routes         []*route          `yaml:"routes,omitempty" json:"routes,omitempty"`
-	GroupWait      string            `yaml:"group_wait,omitempty" json:"group_wait,omitempty"`
-	GroupInterval  string            `yaml:"group_interval,omitempty" json:"group_interval,omitempty"`
-	RepeatInterval string            `yaml:"repeat_interval,omitempty" json:"repeat_interval,omitempty"`
+	Receiver            string            `yaml:"receiver,omitempty" json:"receiver,omitempty"`
+	GroupByStr          []string          `yaml:"group_by,omitempty" json:"group_by,omitempty"`
+	Match               map[string]string `yaml:"match,omitempty" json:"match,omitempty"`
+	MatchRE             map[string]string `yaml:"match_re,omitempty" json:"match_re,omitempty"`
+	Matchers            []string          `yaml:"matchers,omitempty" json:"matchers,omitempty"`
+	Continue            bool              `yaml:"continue,omitempty" json:"continue,omitempty"`
+	Routes              []*route          `yaml:"routes,omitempty" json:"routes,omitempty"`
+	GroupWait           string            `yaml:"group_wait,omitempty" json:"group_wait,omitempty"`
+	GroupInterval       string            `yaml:"group_interval,omitempty" json:"group_interval,omitempty"`
+	RepeatInterval      string            `yaml:"repeat_interval,omitempty" json:"repeat_interval,omitempty"`
+	MuteTimeIntervals   []string          `yaml:"mute_time_intervals,omitempty" json:"mute_time_intervals,omitempty"`
+	ActiveTimeIntervals []string          `yaml:"active_time_intervals,omitempty" json:"active_time_intervals,omitempty"`
 }
 
 type inhibitRule struct {
-	TargetMatch   map[string]string `yaml:"target_match,omitempty" json:"target_match,omitempty"`
-	TargetMatchRE map[string]string `yaml:"target_match_re,omitempty" json:"target_match_re,omitempty"`
-	SourceMatch   map[string]string `yaml:"source_match,omitempty" json:"source_match,omitempty"`
-	SourceMatchRE map[string]string `yaml:"source_match_re,omitempty" json:"source_match_re,omitempty"`
-	Equal         []string          `yaml:"equal,omitempty" json:"equal,omitempty"`
+	TargetMatch    map[string]string `yaml:"target_match,omitempty" json:"target_match,omitempty"`
+	TargetMatchRE  map[string]string `yaml:"target_match_re,omitempty" json:"target_match_re,omitempty"`
+	SourceMatch    map[string]string `yaml:"source_match,omitempty" json:"source_match,omitempty"`
+	SourceMatchRE  map[string]string `yaml:"source_match_re,omitempty" json:"source_match_re,omitempty"`
+	TargetMatchers []string          `yaml:"target_matchers,omitempty" json:"target_matchers,omitempty"`
+	SourceMatchers []string          `yaml:"source_matchers,omitempty" json:"source_matchers,omitempty"`
+	Equal          []string          `yaml:"equal,omitempty" json:"equal,omitempty"`
 }
 
 type receiver struct {
-	Name             string             `yaml:"name" json:"name"`
-	OpsgenieConfigs  []*opsgenieConfig  `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
-	PagerdutyConfigs []*pagerdutyConfig `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
-	SlackConfigs     []*slackConfig     `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
-	WebhookConfigs   []*webhookConfig   `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
-	WeChatConfigs    []*weChatConfig    `yaml:"wechat_configs,omitempty" json:"wechat_config,omitempty"`
-	// TODO(simonpasquier): support the following receivers with AlertmanagerConfig.
-	EmailConfigs     []*emailConfig     `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
-	PushoverConfigs  []*pushoverConfig  `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
-	VictorOpsConfigs []*victorOpsConfig `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
+	Name              string              `yaml:"name" json:"name"`
+	OpsgenieConfigs   []*opsgenieConfig   `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
+	PagerdutyConfigs  []*pagerdutyConfig  `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
+	SlackConfigs      []*slackConfig      `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
+	WebhookConfigs    []*webhookConfig    `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
+	WeChatConfigs     []*weChatConfig     `yaml:"wechat_configs,omitempty" json:"wechat_configs,omitempty"`
+	EmailConfigs      []*emailConfig      `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
+	PushoverConfigs   []*pushoverConfig   `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
+	VictorOpsConfigs  []*victorOpsConfig  `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
+	SNSConfigs        []*snsConfig        `yaml:"sns_configs,omitempty" json:"sns_configs,omitempty"`
+	TelegramConfigs   []*telegramConfig   `yaml:"telegram_configs,omitempty" json:"telegram_configs,omitempty"`
+	DiscordConfigs    []*discordConfig    `yaml:"discord_configs,omitempty" json:"discord_configs,omitempty"`
+	MSTeamsConfigs    []*msTeamsConfig    `yaml:"msteams_configs,omitempty" json:"msteams_configs,omitempty"`
+	MSTeamsV2Configs  []*msTeamsV2Config  `yaml:"msteamsv2_configs,omitempty" json:"msteamsv2_configs,omitempty"`
+	RocketChatConfigs []*rocketChatConfig `yaml:"rocketchat_configs,omitempty" json:"rocketchat_configs,omitempty"`
+	JiraConfigs       []*jiraConfig       `yaml:"jira_configs,omitempty" json:"jira_configs,omitempty"`
 }
 
 type webhookConfig struct {
@@ -102,30 +114,34 @@ type webhookConfig struct {
 	URL           string            `yaml:"url,omitempty" json:"url,omitempty"`
 	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 	MaxAlerts     int32             `yaml:"max_alerts,omitempty" json:"max_alerts,omitempty"`
+	Timeout       string            `yaml:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
 type pagerdutyConfig struct {
-	VSendResolved *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
-	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
-	ServiceKey    string            `yaml:"service_key,omitempty" json:"service_key,omitempty"`
-	RoutingKey    string            `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
-	URL           string            `yaml:"url,omitempty" json:"url,omitempty"`
-	Client        string            `yaml:"client,omitempty" json:"client,omitempty"`
-	ClientURL     string            `yaml:"client_url,omitempty" json:"client_url,omitempty"`
-	Description   string            `yaml:"description,omitempty" json:"description,omitempty"`
-	Details       map[string]string `yaml:"details,omitempty" json:"details,omitempty"`
-	Images        []pagerdutyImage  `yaml:"images,omitempty" json:"images,omitempty"`
-	Links         []pagerdutyLink   `yaml:"links,omitempty" json:"links,omitempty"`
-	Severity      string            `yaml:"severity,omitempty" json:"severity,omitempty"`
-	Class         string            `yaml:"class,omitempty" json:"class,omitempty"`
-	Component     string            `yaml:"component,omitempty" json:"component,omitempty"`
-	Group         string            `yaml:"group,omitempty" json:"group,omitempty"`
+	VSendResolved  *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	HTTPConfig     *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	ServiceKey     string            `yaml:"service_key,omitempty" json:"service_key,omitempty"`
+	ServiceKeyFile string            `yaml:"service_key_file,omitempty" json:"service_key_file,omitempty"`
+	RoutingKey     string            `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
+	RoutingKeyFile string            `yaml:"routing_key_file,omitempty" json:"routing_key_file,omitempty"`
+	URL            string            `yaml:"url,omitempty" json:"url,omitempty"`
+	Client         string            `yaml:"client,omitempty" json:"client,omitempty"`
+	ClientURL      string            `yaml:"client_url,omitempty" json:"client_url,omitempty"`
+	Description    string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Details        map[string]string `yaml:"details,omitempty" json:"details,omitempty"`
+	Images         []pagerdutyImage  `yaml:"images,omitempty" json:"images,omitempty"`
+	Links          []pagerdutyLink   `yaml:"links,omitempty" json:"links,omitempty"`
+	Severity       string            `yaml:"severity,omitempty" json:"severity,omitempty"`
+	Class          string            `yaml:"class,omitempty" json:"class,omitempty"`
+	Component      string            `yaml:"component,omitempty" json:"component,omitempty"`
+	Group          string            `yaml:"group,omitempty" json:"group,omitempty"`
 }
 
 type opsgenieConfig struct {
 	VSendResolved *bool               `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
 	HTTPConfig    *httpClientConfig   `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 	APIKey        string              `yaml:"api_key,omitempty" json:"api_key,omitempty"`
+	APIKeyFile    string              `yaml:"api_key_file,omitempty" json:"api_key_file,omitempty"`
 	APIURL        string              `yaml:"api_url,omitempty" json:"api_url,omitempty"`
 	Message       string              `yaml:"message,omitempty" json:"message,omitempty"`
 	Description   string              `yaml:"description,omitempty" json:"description,omitempty"`
@@ -155,6 +171,7 @@ type slackConfig struct {
 	VSendResolved *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
 	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 	APIURL        string            `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	APIURLFile    string            `yaml:"api_url_file,omitempty" json:"api_url_file,omitempty"`
 	Channel       string            `yaml:"channel,omitempty" json:"channel,omitempty"`
 	Username      string            `yaml:"username,omitempty" json:"username,omitempty"`
 	Color         string            `yaml:"color,omitempty" json:"color,omitempty"`
@@ -177,11 +194,28 @@ type slackConfig struct {
 }
 
 type httpClientConfig struct {
+	Authorization   *authorization      `yaml:"authorization,omitempty"`
 	BasicAuth       *basicAuth          `yaml:"basic_auth,omitempty"`
 	BearerToken     string              `yaml:"bearer_token,omitempty"`
 	BearerTokenFile string              `yaml:"bearer_token_file,omitempty"`
 	ProxyURL        string              `yaml:"proxy_url,omitempty"`
 	TLSConfig       commoncfg.TLSConfig `yaml:"tls_config,omitempty"`
+	FollowRedirects *bool               `yaml:"follow_redirects,omitempty"`
+	OAuth2          *oauth2             `yaml:"oauth2,omitempty"`
+}
+
+type oauth2 struct {
+	ClientID       string            `yaml:"client_id"`
+	ClientSecret   string            `yaml:"client_secret"`
+	Scopes         []string          `yaml:"scopes,omitempty"`
+	TokenURL       string            `yaml:"token_url"`
+	EndpointParams map[string]string `yaml:"endpoint_params,omitempty"`
+}
+
+type authorization struct {
+	Type            string `yaml:"type,omitempty"`
+	Credentials     string `yaml:"credentials,omitempty"`
+	CredentialsFile string `yaml:"credentials_file,omitempty"`
 }
 
 type basicAuth struct {
@@ -232,20 +266,21 @@ type slackConfirmationField struct {
 }
 
 type emailConfig struct {
-	VSendResolved *bool               `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
-	To            string              `yaml:"to,omitempty" json:"to,omitempty"`
-	From          string              `yaml:"from,omitempty" json:"from,omitempty"`
-	Hello         string              `yaml:"hello,omitempty" json:"hello,omitempty"`
-	Smarthost     config.HostPort     `yaml:"smarthost,omitempty" json:"smarthost,omitempty"`
-	AuthUsername  string              `yaml:"auth_username,omitempty" json:"auth_username,omitempty"`
-	AuthPassword  string              `yaml:"auth_password,omitempty" json:"auth_password,omitempty"`
-	AuthSecret    string              `yaml:"auth_secret,omitempty" json:"auth_secret,omitempty"`
-	AuthIdentity  string              `yaml:"auth_identity,omitempty" json:"auth_identity,omitempty"`
-	Headers       map[string]string   `yaml:"headers,omitempty" json:"headers,omitempty"`
-	HTML          string              `yaml:"html,omitempty" json:"html,omitempty"`
-	Text          string              `yaml:"text,omitempty" json:"text,omitempty"`
-	RequireTLS    *bool               `yaml:"require_tls,omitempty" json:"require_tls,omitempty"`
-	TLSConfig     commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
+	VSendResolved    *bool               `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	To               string              `yaml:"to,omitempty" json:"to,omitempty"`
+	From             string              `yaml:"from,omitempty" json:"from,omitempty"`
+	Hello            string              `yaml:"hello,omitempty" json:"hello,omitempty"`
+	Smarthost        config.HostPort     `yaml:"smarthost,omitempty" json:"smarthost,omitempty"`
+	AuthUsername     string              `yaml:"auth_username,omitempty" json:"auth_username,omitempty"`
+	AuthPassword     string              `yaml:"auth_password,omitempty" json:"auth_password,omitempty"`
+	AuthPasswordFile string              `yaml:"auth_password_file,omitempty" json:"auth_password_file,omitempty"`
+	AuthSecret       string              `yaml:"auth_secret,omitempty" json:"auth_secret,omitempty"`
+	AuthIdentity     string              `yaml:"auth_identity,omitempty" json:"auth_identity,omitempty"`
+	Headers          map[string]string   `yaml:"headers,omitempty" json:"headers,omitempty"`
+	HTML             string              `yaml:"html,omitempty" json:"html,omitempty"`
+	Text             string              `yaml:"text,omitempty" json:"text,omitempty"`
+	RequireTLS       *bool               `yaml:"require_tls,omitempty" json:"require_tls,omitempty"`
+	TLSConfig        commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
 }
 
 type pushoverConfig struct {
@@ -282,6 +317,7 @@ type victorOpsConfig struct {
 	VSendResolved     *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
 	HTTPConfig        *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 	APIKey            string            `yaml:"api_key,omitempty" json:"api_key,omitempty"`
+	APIKeyFile        string            `yaml:"api_key_file,omitempty" json:"api_key_file,omitempty"`
 	APIURL            string            `yaml:"api_url,omitempty" json:"api_url,omitempty"`
 	RoutingKey        string            `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
 	MessageType       string            `yaml:"message_type,omitempty" json:"message_type,omitempty"`
@@ -290,3 +326,129 @@ type victorOpsConfig struct {
 	MonitoringTool    string            `yaml:"monitoring_tool,omitempty" json:"monitoring_tool,omitempty"`
 	CustomFields      map[string]string `yaml:"custom_fields,omitempty" json:"custom_fields,omitempty"`
 }
+
+type snsConfig struct {
+	VSendResolved *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	APIUrl        string            `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	Sigv4         *sigV4Config      `yaml:"sigv4,omitempty" json:"sigv4,omitempty"`
+	TopicARN      string            `yaml:"topic_arn,omitempty" json:"topic_arn,omitempty"`
+	PhoneNumber   string            `yaml:"phone_number,omitempty" json:"phone_number,omitempty"`
+	TargetARN     string            `yaml:"target_arn,omitempty" json:"target_arn,omitempty"`
+	Subject       string            `yaml:"subject,omitempty" json:"subject,omitempty"`
+	Message       string            `yaml:"message,omitempty" json:"message,omitempty"`
+	Attributes    map[string]string `yaml:"attributes,omitempty" json:"attributes,omitempty"`
+}
+
+type sigV4Config struct {
+	Region    string `yaml:"region,omitempty" json:"region,omitempty"`
+	AccessKey string `yaml:"access_key,omitempty" json:"access_key,omitempty"`
+	SecretKey string `yaml:"secret_key,omitempty" json:"secret_key,omitempty"`
+	Profile   string `yaml:"profile,omitempty" json:"profile,omitempty"`
+	RoleARN   string `yaml:"role_arn,omitempty" json:"role_arn,omitempty"`
+}
+
+type telegramConfig struct {
+	VSendResolved        *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	APIUrl               string            `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	BotToken             string            `yaml:"bot_token,omitempty" json:"bot_token,omitempty"`
+	ChatID               int64             `yaml:"chat_id,omitempty" json:"chat_id,omitempty"`
+	Message              string            `yaml:"message,omitempty" json:"message,omitempty"`
+	DisableNotifications bool              `yaml:"disable_notifications,omitempty" json:"disable_notifications,omitempty"`
+	ParseMode            string            `yaml:"parse_mode,omitempty" json:"parse_mode,omitempty"`
+	HTTPConfig           *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+}
+
+type discordConfig struct {
+	VSendResolved *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	WebhookURL    string            `yaml:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+	Title         string            `yaml:"title,omitempty" json:"title,omitempty"`
+	Message       string            `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+type msTeamsConfig struct {
+	VSendResolved *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	WebhookURL    string            `yaml:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+	Title         string            `yaml:"title,omitempty" json:"title,omitempty"`
+	Summary       string            `yaml:"summary,omitempty" json:"summary,omitempty"`
+	Text          string            `yaml:"text,omitempty" json:"text,omitempty"`
+}
+
+type msTeamsV2Config struct {
+	VSendResolved *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	WebhookURL    string            `yaml:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+	Title         string            `yaml:"title,omitempty" json:"title,omitempty"`
+	Text          string            `yaml:"text,omitempty" json:"text,omitempty"`
+}
+
+type rocketChatConfig struct {
+	VSendResolved *bool               `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	HTTPConfig    *httpClientConfig   `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	APIURL        string              `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	Channel       string              `yaml:"channel,omitempty" json:"channel,omitempty"`
+	Token         string              `yaml:"token,omitempty" json:"token,omitempty"`
+	TokenFile     string              `yaml:"token_file,omitempty" json:"token_file,omitempty"`
+	TokenID       string              `yaml:"token_id,omitempty" json:"token_id,omitempty"`
+	TokenIDFile   string              `yaml:"token_id_file,omitempty" json:"token_id_file,omitempty"`
+	Color         string              `yaml:"color,omitempty" json:"color,omitempty"`
+	Emoji         string              `yaml:"emoji,omitempty" json:"emoji,omitempty"`
+	IconURL       string              `yaml:"icon_url,omitempty" json:"icon_url,omitempty"`
+	Text          string              `yaml:"text,omitempty" json:"text,omitempty"`
+	Title         string              `yaml:"title,omitempty" json:"title,omitempty"`
+	TitleLink     string              `yaml:"title_link,omitempty" json:"title_link,omitempty"`
+	Fields        []*rocketChatField  `yaml:"fields,omitempty" json:"fields,omitempty"`
+	ShortFields   bool                `yaml:"short_fields,omitempty" json:"short_fields,omitempty"`
+	ImageURL      string              `yaml:"image_url,omitempty" json:"image_url,omitempty"`
+	ThumbURL      string              `yaml:"thumb_url,omitempty" json:"thumb_url,omitempty"`
+	LinkNames     bool                `yaml:"link_names,omitempty" json:"link_names,omitempty"`
+	Actions       []*rocketChatAction `yaml:"actions,omitempty" json:"actions,omitempty"`
+}
+
+type rocketChatField struct {
+	Title string `yaml:"title,omitempty" json:"title,omitempty"`
+	Value string `yaml:"value,omitempty" json:"value,omitempty"`
+	Short *bool  `yaml:"short,omitempty" json:"short,omitempty"`
+}
+
+type rocketChatAction struct {
+	Text string `yaml:"text,omitempty" json:"text,omitempty"`
+	URL  string `yaml:"url,omitempty" json:"url,omitempty"`
+	Msg  string `yaml:"msg,omitempty" json:"msg,omitempty"`
+}
+
+type jiraConfig struct {
+	VSendResolved     *bool             `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	HTTPConfig        *httpClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	APIURL            string            `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	Project           string            `yaml:"project,omitempty" json:"project,omitempty"`
+	Summary           string            `yaml:"summary,omitempty" json:"summary,omitempty"`
+	Description       string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Labels            []string          `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Priority          string            `yaml:"priority,omitempty" json:"priority,omitempty"`
+	IssueType         string            `yaml:"issue_type,omitempty" json:"issue_type,omitempty"`
+	ReopenTransition  string            `yaml:"reopen_transition,omitempty" json:"reopen_transition,omitempty"`
+	ResolveTransition string            `yaml:"resolve_transition,omitempty" json:"resolve_transition,omitempty"`
+	WontFixResolution string            `yaml:"wont_fix_resolution,omitempty" json:"wont_fix_resolution,omitempty"`
+	ReopenDuration    string            `yaml:"reopen_duration,omitempty" json:"reopen_duration,omitempty"`
+}
+
+type muteTimeInterval struct {
+	Name          string         `yaml:"name" json:"name"`
+	TimeIntervals []timeInterval `yaml:"time_intervals" json:"time_intervals"`
+}
+
+type timeInterval struct {
+	Times       []timeRange `yaml:"times,omitempty" json:"times,omitempty"`
+	Weekdays    []string    `yaml:"weekdays,omitempty" json:"weekdays,omitempty"`
+	DaysOfMonth []string    `yaml:"days_of_month,omitempty" json:"days_of_month,omitempty"`
+	Months      []string    `yaml:"months,omitempty" json:"months,omitempty"`
+	Years       []string    `yaml:"years,omitempty" json:"years,omitempty"`
+}
+
+type timeRange struct {
+	StartTime string `yaml:"start_time" json:"start_time"`
+	EndTime   string `yaml:"end_time" json:"end_time"`
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	webConfigDir  = "/etc/alertmanager/web_config"
+	webConfigFile = "web-config.yaml"
+)
+
+// webConfig is the web configuration file of Alertmanager.
+// See https://prometheus.io/docs/prometheus/latest/configuration/https/
+type webConfig struct {
+	TLSServerConfig  *webTLSServerConfig  `yaml:"tls_server_config,omitempty"`
+	HTTPServerConfig *webHTTPServerConfig `yaml:"http_server_config,omitempty"`
+}
+
+type webTLSServerConfig struct {
+	CertFile                 string   `yaml:"cert_file"`
+	KeyFile                  string   `yaml:"key_file"`
+	ClientAuthType           string   `yaml:"client_auth_type,omitempty"`
+	ClientCAFile             string   `yaml:"client_ca_file,omitempty"`
+	MinVersion               string   `yaml:"min_version,omitempty"`
+	MaxVersion               string   `yaml:"max_version,omitempty"`
+	CipherSuites             []string `yaml:"cipher_suites,omitempty"`
+	PreferServerCipherSuites *bool    `yaml:"prefer_server_cipher_suites,omitempty"`
+	CurvePreferences         []string `yaml:"curve_preferences,omitempty"`
+}
+
+type webHTTPServerConfig struct {
+	HTTP2   *bool             `yaml:"http2,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// webTLSEnabled returns true if the Alertmanager web server is configured to
+// serve HTTPS.
+func webTLSEnabled(am *monitoringv1.Alertmanager) bool {
+	return am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil
+}
+
+// makeWebConfig returns the web configuration file of the given
+// Alertmanager. The TLS assets referenced by the configuration are added to
+// the store and they're read from the TLS assets directory.
+func makeWebConfig(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.Store) ([]byte, error) {
+	version, err := semver.ParseTolerant(operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse alertmanager version")
+	}
+
+	if version.LT(semver.MustParse("0.22.0")) {
+		return nil, errors.Errorf("web configuration requires Alertmanager >= 0.22.0 (current version: %s)", version)
+	}
+
+	var cfg webConfig
+
+	if tlsConfig := am.Spec.Web.TLSConfig; tlsConfig != nil {
+		tlsServerConfig, err := makeTLSServerConfig(ctx, am.Namespace, *tlsConfig, store)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get web TLS assets")
+		}
+		cfg.TLSServerConfig = tlsServerConfig
+	}
+
+	if httpConfig := am.Spec.Web.HTTPConfig; httpConfig != nil {
+		cfg.HTTPServerConfig = &webHTTPServerConfig{
+			HTTP2: httpConfig.HTTP2,
+		}
+
+		if httpConfig.Headers != nil {
+			if version.LT(semver.MustParse("0.24.0")) {
+				return nil, errors.Errorf("web HTTP headers require Alertmanager >= 0.24.0 (current version: %s)", version)
+			}
+			cfg.HTTPServerConfig.Headers = makeWebHTTPHeaders(httpConfig.Headers)
+		}
+	}
+
+	return yaml.Marshal(cfg)
+}
+
+// makeTLSServerConfig returns the TLS server configuration for the given
+// WebTLSConfig and adds the referenced assets to the store.
+func makeTLSServerConfig(ctx context.Context, namespace string, tlsConfig monitoringv1.WebTLSConfig, store *assets.Store) (*webTLSServerConfig, error) {
+	safeTLSConfig := &monitoringv1.SafeTLSConfig{
+		CA:        tlsConfig.ClientCA,
+		Cert:      tlsConfig.Cert,
+		KeySecret: &tlsConfig.KeySecret,
+	}
+	if err := store.AddSafeTLSConfig(ctx, namespace, safeTLSConfig); err != nil {
+		return nil, err
+	}
+
+	out := &webTLSServerConfig{
+		CertFile:                 path.Join(tlsAssetsDir, assets.TLSAssetKeyFromSelector(namespace, tlsConfig.Cert).String()),
+		KeyFile:                  path.Join(tlsAssetsDir, assets.TLSAssetKeyFromSecretSelector(namespace, &tlsConfig.KeySecret).String()),
+		ClientAuthType:           tlsConfig.ClientAuthType,
+		MinVersion:               tlsConfig.MinVersion,
+		MaxVersion:               tlsConfig.MaxVersion,
+		CipherSuites:             tlsConfig.CipherSuites,
+		PreferServerCipherSuites: tlsConfig.PreferServerCipherSuites,
+		CurvePreferences:         tlsConfig.CurvePreferences,
+	}
+
+	if tlsConfig.ClientCA != (monitoringv1.SecretOrConfigMap{}) {
+		out.ClientCAFile = path.Join(tlsAssetsDir, assets.TLSAssetKeyFromSelector(namespace, tlsConfig.ClientCA).String())
+	}
+
+	return out, nil
+}
+
+func makeWebHTTPHeaders(in *monitoringv1.WebHTTPHeaders) map[string]string {
+	headers := map[string]string{}
+
+	if in.ContentSecurityPolicy != "" {
+		headers["Content-Security-Policy"] = in.ContentSecurityPolicy
+	}
+	if in.XFrameOptions != "" {
+		headers["X-Frame-Options"] = strings.ToLower(in.XFrameOptions)
+	}
+	if in.XContentTypeOptions != "" {
+		headers["X-Content-Type-Options"] = strings.ToLower(in.XContentTypeOptions)
+	}
+	if in.XXSSProtection != "" {
+		headers["X-XSS-Protection"] = in.XXSSProtection
+	}
+	if in.StrictTransportSecurity != "" {
+		headers["Strict-Transport-Security"] = in.StrictTransportSecurity
+	}
+
+	if len(headers) == 0 {
+		return nil
+	}
+	return headers
+}
+
+func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.Store) error {
+	if am.Spec.Web == nil {
+		return nil
+	}
+
+	conf, err := makeWebConfig(ctx, am, store)
+	if err != nil {
+		return err
+	}
+
+	return c.createOrUpdateConfigFileSecret(ctx, am, webConfigSecretName(am.Name), webConfigFile, conf)
+}
+
+func webConfigSecretName(name string) string {
+	return prefixedName(name) + "-web-config"
+}
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"regexp"
@@ -22,19 +23,34 @@ import (
 	"github.com/go-kit/kit/log"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	"github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
+// maxAlertmanagerConfigSize is the maximum size of the AlertmanagerConfig
+// objects accepted by the Alertmanager configuration endpoint.
+const maxAlertmanagerConfigSize = 1 << 20
+
+// AlertmanagerConfigRenderer renders the configuration of Alertmanager
+// resources with the credentials redacted. If not nil, the
+// AlertmanagerConfig object is merged with the objects existing in the
+// cluster.
+type AlertmanagerConfigRenderer interface {
+	RenderConfig(ctx context.Context, namespace, name string, amc *monitoringv1alpha1.AlertmanagerConfig) ([]byte, error)
+}
+
 type API struct {
-	kclient *kubernetes.Clientset
-	mclient monitoringclient.Interface
-	logger  log.Logger
+	kclient          *kubernetes.Clientset
+	mclient          monitoringclient.Interface
+	logger           log.Logger
+	amConfigRenderer AlertmanagerConfigRenderer
 }
 
 func New(conf operator.Config, l log.Logger) (*API, error) {
@@ -61,14 +77,24 @@ func New(conf operator.Config, l log.Logger) (*API, error) {
 }
 
 var (
-	prometheusRoute = regexp.MustCompile("/apis/monitoring.coreos.com/" + v1.Version + "/namespaces/(.*)/prometheuses/(.*)/status")
+	prometheusRoute         = regexp.MustCompile("/apis/monitoring.coreos.com/" + v1.Version + "/namespaces/(.*)/prometheuses/(.*)/status")
+	alertmanagerConfigRoute = regexp.MustCompile("/apis/monitoring.coreos.com/" + v1.Version + "/namespaces/(.*)/alertmanagers/(.*)/config")
 )
 
+// EnableAlertmanagerConfigRendering exposes the Alertmanager configuration
+// generated by the given renderer. A POST request renders the configuration
+// with the AlertmanagerConfig object of the request body merged.
+func (api *API) EnableAlertmanagerConfigRendering(r AlertmanagerConfigRenderer) {
+	api.amConfigRenderer = r
+}
+
 func (api *API) Register(mux *http.ServeMux) {
 	mux.HandleFunc("/healthz", ok)
 	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
 		if prometheusRoute.MatchString(req.URL.Path) {
 			api.prometheusStatus(w, req)
+		} else if api.amConfigRenderer != nil && alertmanagerConfigRoute.MatchString(req.URL.Path) {
+			api.alertmanagerConfig(w, req)
 		} else {
 			w.WriteHeader(404)
 		}
@@ -81,7 +107,11 @@ type objectReference struct {
 }
 
 func parsePrometheusStatusUrl(path string) objectReference {
-	matches := prometheusRoute.FindAllStringSubmatch(path, -1)
+	return parseObjectReference(prometheusRoute, path)
+}
+
+func parseObjectReference(route *regexp.Regexp, path string) objectReference {
+	matches := route.FindAllStringSubmatch(path, -1)
 	ns := ""
 	name := ""
 	if len(matches) == 1 {
@@ -124,6 +154,41 @@ func (api *API) prometheusStatus(w http.ResponseWriter, req *http.Request) {
 	w.Write(b)
 }
 
+func (api *API) alertmanagerConfig(w http.ResponseWriter, req *http.Request) {
+	or := parseObjectReference(alertmanagerConfigRoute, req.URL.Path)
+
+	var amc *monitoringv1alpha1.AlertmanagerConfig
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		amc = &monitoringv1alpha1.AlertmanagerConfig{}
+		body := http.MaxBytesReader(w, req.Body, maxAlertmanagerConfigSize)
+		if err := yaml.NewYAMLOrJSONDecoder(body, 4096).Decode(amc); err != nil {
+			http.Error(w, "failed to decode AlertmanagerConfig: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	b, err := api.amConfigRenderer.RenderConfig(req.Context(), or.namespace, or.name, amc)
+	if err != nil {
+		if k8sutil.IsResourceNotFoundError(err) {
+			w.WriteHeader(404)
+			return
+		}
+		api.logger.Log("error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(200)
+	w.Write(b)
+}
+
 func ok(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
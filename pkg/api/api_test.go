@@ -0,0 +1,127 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+type fakeRenderer struct {
+	namespace string
+	name      string
+	amc       *monitoringv1alpha1.AlertmanagerConfig
+}
+
+func (r *fakeRenderer) RenderConfig(_ context.Context, namespace, name string, amc *monitoringv1alpha1.AlertmanagerConfig) ([]byte, error) {
+	r.namespace, r.name, r.amc = namespace, name, amc
+	return []byte("route: {}\n"), nil
+}
+
+func TestAlertmanagerConfig(t *testing.T) {
+	const path = "/apis/monitoring.coreos.com/v1/namespaces/ns/alertmanagers/main/config"
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		body   string
+
+		code    int
+		amcName string
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+			code:   http.StatusOK,
+		},
+		{
+			name:   "post yaml",
+			method: http.MethodPost,
+			body: `apiVersion: monitoring.coreos.com/v1alpha1
+kind: AlertmanagerConfig
+metadata:
+  name: pending
+spec:
+  route:
+    receiver: "null"
+  receivers:
+  - name: "null"
+`,
+			code:    http.StatusOK,
+			amcName: "pending",
+		},
+		{
+			name:    "post json",
+			method:  http.MethodPost,
+			body:    `{"kind":"AlertmanagerConfig","metadata":{"name":"pending"}}`,
+			code:    http.StatusOK,
+			amcName: "pending",
+		},
+		{
+			name:   "post invalid",
+			method: http.MethodPost,
+			body:   `{"metadata": []}`,
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			code:   http.StatusMethodNotAllowed,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &fakeRenderer{}
+			api := &API{logger: log.NewNopLogger()}
+			api.EnableAlertmanagerConfigRendering(r)
+
+			mux := http.NewServeMux()
+			api.Register(mux)
+
+			req := httptest.NewRequest(tc.method, path, strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tc.code {
+				t.Fatalf("expected status code %d, got %d", tc.code, w.Code)
+			}
+
+			if tc.code != http.StatusOK {
+				return
+			}
+
+			if r.namespace != "ns" || r.name != "main" {
+				t.Fatalf("expected Alertmanager ns/main, got %s/%s", r.namespace, r.name)
+			}
+
+			if tc.amcName == "" {
+				if r.amc != nil {
+					t.Fatalf("expected no AlertmanagerConfig, got %v", r.amc)
+				}
+				return
+			}
+
+			if r.amc == nil || r.amc.Name != tc.amcName {
+				t.Fatalf("expected AlertmanagerConfig %q, got %v", tc.amcName, r.amc)
+			}
+		})
+	}
+}
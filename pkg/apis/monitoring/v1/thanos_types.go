@@ -65,6 +65,10 @@ type ThanosRulerSpec struct {
 	// to use for pulling thanos images from registries
 	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Image pull policy of the containers generated by the operator. When
+	// not defined, the Kubernetes defaults apply.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
 	// When a ThanosRuler deployment is paused, no actions except for deletion
 	// will be performed on the underlying objects.
 	Paused bool `json:"paused,omitempty"`
@@ -75,12 +79,48 @@ type ThanosRulerSpec struct {
 	// Resources defines the resource requirements for single Pods.
 	// If not provided, no requests/limits will be set
 	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// Defines the resources requests and limits of the config-reloader
+	// sidecar. When set, they replace the values defined by the operator's
+	// `--config-reloader-cpu` and `--config-reloader-memory` flags.
+	// +optional
+	ConfigReloaderResources *v1.ResourceRequirements `json:"configReloaderResources,omitempty"`
+	// Defines how long the config-reloader sidecar waits without detecting
+	// new changes to the configuration, rules and secrets before reloading.
+	// Successive changes within the interval are coalesced into a single
+	// reload. When set, it replaces the value defined by the operator's
+	// `--config-reloader-delay-interval` flag.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	ReloadDelayInterval *string `json:"reloadDelayInterval,omitempty"`
+	// Defines the maximum number of replicas reloading their configuration at
+	// the same time. The replicas are staggered by the reload delay interval
+	// based on their ordinal number. 0 means no limit. When set, it replaces
+	// the value defined by the operator's
+	// `--config-reloader-max-concurrent-reloads` flag.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentReloads *int32 `json:"maxConcurrentReloads,omitempty"`
 	// If specified, the pod's scheduling constraints.
 	Affinity *v1.Affinity `json:"affinity,omitempty"`
 	// If specified, the pod's tolerations.
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
 	// If specified, the pod's topology spread constraints.
 	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Defines the DNS policy of the pods. Defaults to `ClusterFirst` or to
+	// `ClusterFirstWithHostNet` when hostNetwork is true.
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	// +optional
+	DNSPolicy *v1.DNSPolicy `json:"dnsPolicy,omitempty"`
+	// Defines the DNS parameters of the pods, in addition to the ones
+	// generated from the DNS policy.
+	// +optional
+	DNSConfig *v1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// Use the host's network namespace. When true, the pods listen on the
+	// network interfaces of the nodes so the replicas should be scheduled on
+	// different nodes and the ports shouldn't conflict with other workloads
+	// running on the host network.
+	// +optional
+	HostNetwork bool `json:"hostNetwork,omitempty"`
 	// SecurityContext holds pod-level security attributes and common container settings.
 	// This defaults to the default PodSecurityContext.
 	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
@@ -89,6 +129,9 @@ type ThanosRulerSpec struct {
 	// ServiceAccountName is the name of the ServiceAccount to use to run the
 	// Thanos Ruler Pods.
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// AutomountServiceAccountToken indicates whether a service account token
+	// should be automatically mounted in the pods.
+	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
 	// Storage spec to specify how storage shall be used.
 	Storage *StorageSpec `json:"storage,omitempty"`
 	// Volumes allows configuration of additional volumes on the output StatefulSet definition. Volumes specified will
@@ -131,8 +174,13 @@ type ThanosRulerSpec struct {
 	EnforcedNamespaceLabel string `json:"enforcedNamespaceLabel,omitempty"`
 	// PrometheusRulesExcludedFromEnforce - list of Prometheus rules to be excluded from enforcing
 	// of adding namespace labels. Works only if enforcedNamespaceLabel set to true.
-	// Make sure both ruleNamespace and ruleName are set for each pair
+	// Make sure both ruleNamespace and ruleName are set for each pair.
+	// Deprecated: use excludedFromEnforcement instead.
 	PrometheusRulesExcludedFromEnforce []PrometheusRuleExcludeConfig `json:"prometheusRulesExcludedFromEnforce,omitempty"`
+	// List of references to PrometheusRule objects to be excluded from
+	// enforcing a namespace label of origin. Applies only if
+	// enforcedNamespaceLabel is set.
+	ExcludedFromEnforcement []ObjectReference `json:"excludedFromEnforcement,omitempty"`
 	// Log level for ThanosRuler to be configured with.
 	LogLevel string `json:"logLevel,omitempty"`
 	// Log format for ThanosRuler to be configured with.
@@ -161,6 +209,15 @@ type ThanosRulerSpec struct {
 	// of what the maintainers will support and by doing so, you accept that this behaviour may break
 	// at any time without notice.
 	InitContainers []v1.Container `json:"initContainers,omitempty"`
+	// AdditionalArgs allows setting additional arguments for the ThanosRuler container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Thanos container which may cause issues if they are invalid or not supported
+	// by the given Thanos version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	AdditionalArgs []Argument `json:"additionalArgs,omitempty"`
 	// TracingConfig configures tracing in Thanos. This is an experimental feature, it may change in any upcoming release in a breaking way.
 	TracingConfig *v1.SecretKeySelector `json:"tracingConfig,omitempty"`
 	// Labels configure the external label pairs to ThanosRuler. If not provided, default replica label
@@ -201,7 +258,7 @@ type ThanosRulerStatus struct {
 	// Total number of non-terminated pods targeted by this ThanosRuler deployment
 	// that have the desired version spec.
 	UpdatedReplicas int32 `json:"updatedReplicas"`
-	// Total number of available pods (ready for at least minReadySeconds)
+	// Total number of available pods (running and ready)
 	// targeted by this ThanosRuler deployment.
 	AvailableReplicas int32 `json:"availableReplicas"`
 	// Total number of unavailable pods targeted by this ThanosRuler deployment.
@@ -15,7 +15,10 @@
 package v1
 
 import (
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -54,16 +57,18 @@ const (
 // +k8s:openapi-gen=true
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Prometheus"
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The desired replicas number of Prometheuses"
+// +kubebuilder:printcolumn:name="Shards",type="integer",JSONPath=".spec.shards",description="The desired shards number of Prometheuses"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.shards,statuspath=.status.shards,selectorpath=.status.selector
 type Prometheus struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of the desired behavior of the Prometheus cluster. More info:
 	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
 	Spec PrometheusSpec `json:"spec"`
-	// Most recent observed status of the Prometheus cluster. Read-only. Not
-	// included when requesting from the apiserver, only from the Prometheus
-	// Operator API itself. More info:
+	// Most recent observed status of the Prometheus cluster. Read-only.
+	// More info:
 	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
 	Status *PrometheusStatus `json:"status,omitempty"`
 }
@@ -103,6 +108,23 @@ type PrometheusSpec struct {
 	ProbeSelector *metav1.LabelSelector `json:"probeSelector,omitempty"`
 	// *Experimental* Namespaces to be selected for Probe discovery. If nil, only check own namespace.
 	ProbeNamespaceSelector *metav1.LabelSelector `json:"probeNamespaceSelector,omitempty"`
+	// List of scrape classes to expose to scraping objects such as
+	// ServiceMonitors, PodMonitors and Probes.
+	// Scrape classes let cluster administrators define the TLS, authorization
+	// and relabeling settings applied to all the scrape jobs of the class.
+	// This is *experimental feature*, it may change in any upcoming release
+	// in a breaking way.
+	// +listType=map
+	// +listMapKey=name
+	ScrapeClasses []ScrapeClass `json:"scrapeClasses,omitempty"`
+	// The Kubernetes service discovery role used to discover the targets of
+	// the ServiceMonitors. The default is `Endpoints`.
+	// `EndpointSlice` scales better on large clusters. It requires Prometheus
+	// >= 2.21.0 and the service account of Prometheus must be allowed to
+	// `list` and `watch` the `endpointslices` of the `discovery.k8s.io` API
+	// group.
+	// +optional
+	ServiceDiscoveryRole *ServiceDiscoveryRole `json:"serviceDiscoveryRole,omitempty"`
 	// Version of Prometheus to be deployed.
 	Version string `json:"version,omitempty"`
 	// Tag of Prometheus container image to be deployed. Defaults to the value of `version`.
@@ -131,6 +153,10 @@ type PrometheusSpec struct {
 	// to use for pulling prometheus and alertmanager images from registries
 	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Image pull policy of the containers generated by the operator. When
+	// not defined, the Kubernetes defaults apply.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
 	// Number of replicas of each shard to deploy for a Prometheus deployment.
 	// Number of replicas multiplied by shards is the total number of Pods
 	// created.
@@ -143,7 +169,20 @@ type PrometheusSpec struct {
 	// query globally use Thanos sidecar and Thanos querier or remote write
 	// data to a central location. Sharding is done on the content of the
 	// `__address__` target meta-label.
+	// The number of shards can be adjusted automatically (e.g. by the
+	// HorizontalPodAutoscaler based on the number of discovered targets)
+	// through the scale subresource of the Prometheus resource.
 	Shards *int32 `json:"shards,omitempty"`
+	// Defines the policy applied to the pods of the removed shards when the
+	// number of shards is decreased. By default, the pods are deleted
+	// immediately.
+	ShardRetentionPolicy *ShardRetentionPolicy `json:"shardRetentionPolicy,omitempty"`
+	// Defines how the pods are updated when the configuration of the
+	// statefulsets changes. By default, the pods of every shard are updated
+	// one at a time by the StatefulSet controller and all shards are
+	// updated in parallel.
+	// +optional
+	UpdateStrategy *StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
 	// Name of Prometheus external label used to denote replica name.
 	// Defaults to the value of `prometheus_replica`. External label will
 	// _not_ be added when value is set to empty string (`""`).
@@ -152,16 +191,49 @@ type PrometheusSpec struct {
 	// name. Defaults to the value of `prometheus`. External label will
 	// _not_ be added when value is set to empty string (`""`).
 	PrometheusExternalLabelName *string `json:"prometheusExternalLabelName,omitempty"`
-	// Time duration Prometheus shall retain data for. Default is '24h',
-	// and must match the regular expression `[0-9]+(ms|s|m|h|d|w|y)` (milliseconds seconds minutes hours days weeks years).
+	// Time duration Prometheus shall retain data for. Default is '24h' if
+	// retentionSize isn't set either, and must match the regular expression
+	// `[0-9]+(ms|s|m|h|d|w|y)` (milliseconds seconds minutes hours days weeks years).
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
 	Retention string `json:"retention,omitempty"`
 	// Maximum amount of disk space used by blocks. Supported units: B, KB, MB, GB, TB, PB, EB. Ex: `512MB`.
+	// When set without retention, the data is only deleted once the size
+	// limit is reached.
+	// +kubebuilder:validation:Pattern="(^0|([0-9]*[.])?[0-9]+((K|M|G|T|E|P)i?)?B)$"
 	RetentionSize string `json:"retentionSize,omitempty"`
+	// Defines the runtime reloadable configuration of the timeseries database
+	// (TSDB).
+	TSDB TSDBSpec `json:"tsdb,omitempty"`
+	// Exemplars related settings that are runtime reloadable.
+	// The exemplar storage feature is enabled automatically when this field
+	// is set.
+	// Only valid in Prometheus versions 2.29.0 and newer.
+	Exemplars *Exemplars `json:"exemplars,omitempty"`
+	// Settings related to the OTLP receiver feature. When set, the operator
+	// enables the OTLP receiver so that OpenTelemetry collectors can push
+	// metrics to the `/api/v1/otlp/v1/metrics` endpoint.
+	// Only valid in Prometheus versions 2.47.0 and newer.
+	OTLP *OTLPConfig `json:"otlp,omitempty"`
+	// TracingConfig configures the export of the traces emitted by
+	// Prometheus itself to an OpenTelemetry collector.
+	// This is an experimental feature, it may change in any upcoming release
+	// in a breaking way.
+	// Only valid in Prometheus versions 2.34.0 and newer.
+	TracingConfig *PrometheusTracingConfig `json:"tracingConfig,omitempty"`
 	// Disable prometheus compaction.
 	DisableCompaction bool `json:"disableCompaction,omitempty"`
 	// Enable compression of the write-ahead log using Snappy. This flag is
 	// only available in versions of Prometheus >= 2.11.0.
 	WALCompression *bool `json:"walCompression,omitempty"`
+	// Size of the write-ahead log segment files read by the remote write
+	// queues. Supported units: B, KB, MB. Ex: `64MB`. This flag is only
+	// available in versions of Prometheus >= 2.6.0.
+	// +kubebuilder:validation:Pattern="^[0-9]+(B|KB|MB)$"
+	WALSegmentSize string `json:"walSegmentSize,omitempty"`
+	// How long to wait flushing the remote write queues on shutdown or
+	// configuration reload. Ex: `1m`. This flag is only available in
+	// versions of Prometheus >= 2.4.0.
+	RemoteWriteFlushDeadline string `json:"remoteWriteFlushDeadline,omitempty"`
 	// Log level for Prometheus to be configured with.
 	LogLevel string `json:"logLevel,omitempty"`
 	// Log format for Prometheus to be configured with.
@@ -170,10 +242,41 @@ type PrometheusSpec struct {
 	ScrapeInterval string `json:"scrapeInterval,omitempty"`
 	// Number of seconds to wait for target to respond before erroring.
 	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+	// The protocols to negotiate during a scrape. It tells clients the
+	// protocols supported by Prometheus in order of preference (from most to
+	// least preferred).
+	// If unset, Prometheus uses its default value.
+	// It requires Prometheus >= v2.49.0.
+	// +listType=set
+	ScrapeProtocols []ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+	// Enable access to Prometheus feature flags. By default, no features
+	// are enabled. For instance, `native-histograms` enables the ingestion
+	// of native histograms.
+	// Enabling features which are disabled by default is entirely outside
+	// the scope of what the maintainers will support and by doing so, you
+	// accept that this behaviour may break at any time without notice.
+	// For more information see https://prometheus.io/docs/prometheus/latest/feature_flags/
+	// It requires Prometheus >= v2.25.0, the field is ignored otherwise.
+	EnableFeatures []string `json:"enableFeatures,omitempty"`
 	// Interval between consecutive evaluations.
 	EvaluationInterval string `json:"evaluationInterval,omitempty"`
 	// /--rules.*/ command-line arguments.
 	Rules Rules `json:"rules,omitempty"`
+	// Offsets the evaluation timestamp of the rule groups by the specified
+	// duration into the past, e.g. when the samples are received with a
+	// delay through remote write. The rule groups can override it with their
+	// own `query_offset`.
+	// It requires Prometheus >= v2.53.0.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	RuleQueryOffset *string `json:"ruleQueryOffset,omitempty"`
+	// Number of rules which can be evaluated concurrently when the rule
+	// groups allow it. The `concurrent-rule-eval` feature flag needs to be
+	// enabled with `enableFeatures` for the setting to take effect.
+	// It requires Prometheus >= v2.52.0, the field is ignored otherwise.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RuleConcurrentEval *int32 `json:"ruleConcurrentEval,omitempty"`
 	// The labels to add to any time series or alerts when communicating with
 	// external systems (federation, remote storage, Alertmanager).
 	ExternalLabels map[string]string `json:"externalLabels,omitempty"`
@@ -184,6 +287,21 @@ type PrometheusSpec struct {
 	// ensure only clients authorized to perform these actions can do so.
 	// For more information see https://prometheus.io/docs/prometheus/latest/querying/api/#tsdb-admin-apis
 	EnableAdminAPI bool `json:"enableAdminAPI,omitempty"`
+	// Enable Prometheus to be used as a receiver for the Prometheus remote
+	// write protocol. Defaults to the value of `false`.
+	// WARNING: This is not considered an efficient way of ingesting samples.
+	// Use it with caution for specific low-volume use cases.
+	// It is not suitable for replacing the ingestion via scraping and turning
+	// Prometheus into a push-based metrics collection system.
+	// For more information see https://prometheus.io/docs/prometheus/latest/querying/api/#remote-write-receiver
+	// Only valid in Prometheus versions 2.25.0 and newer.
+	EnableRemoteWriteReceiver bool `json:"enableRemoteWriteReceiver,omitempty"`
+	// List of the protobuf message versions to accept when receiving the
+	// remote writes.
+	// It requires Prometheus >= v2.54.0.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	RemoteWriteReceiverMessageVersions []RemoteWriteMessageVersion `json:"remoteWriteReceiverMessageVersions,omitempty"`
 	// The external URL the Prometheus instances will be available under. This is
 	// necessary to generate correct URLs. This is necessary if Prometheus is not
 	// served from root of a DNS name.
@@ -219,11 +337,42 @@ type PrometheusSpec struct {
 	Alerting *AlertingSpec `json:"alerting,omitempty"`
 	// Define resources requests and limits for single Pods.
 	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// Defines the resources requests and limits of the config-reloader
+	// sidecar. When set, they replace the values defined by the operator's
+	// `--config-reloader-cpu` and `--config-reloader-memory` flags.
+	// +optional
+	ConfigReloaderResources *v1.ResourceRequirements `json:"configReloaderResources,omitempty"`
+	// Defines how long the config-reloader sidecar waits without detecting
+	// new changes to the configuration, rules and secrets before reloading.
+	// Successive changes within the interval are coalesced into a single
+	// reload. When set, it replaces the value defined by the operator's
+	// `--config-reloader-delay-interval` flag.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	ReloadDelayInterval *string `json:"reloadDelayInterval,omitempty"`
+	// Defines the maximum number of replicas reloading their configuration at
+	// the same time. The replicas are staggered by the reload delay interval
+	// based on their ordinal number. 0 means no limit. When set, it replaces
+	// the value defined by the operator's
+	// `--config-reloader-max-concurrent-reloads` flag.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentReloads *int32 `json:"maxConcurrentReloads,omitempty"`
+	// Defines how the config-reloader sidecar triggers a reload of the
+	// configuration. With `HTTP` (default), it sends a request to the
+	// `/-/reload` endpoint. With `ProcessSignal`, it sends a SIGHUP signal to
+	// the Prometheus process, in which case the pod shares its process
+	// namespace and the lifecycle API endpoints of Prometheus are disabled.
+	// +optional
+	ReloadStrategy *ReloadStrategyType `json:"reloadStrategy,omitempty"`
 	// Define which Nodes the Pods are scheduled on.
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 	// ServiceAccountName is the name of the ServiceAccount to use to run the
 	// Prometheus Pods.
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// AutomountServiceAccountToken indicates whether a service account token
+	// should be automatically mounted in the pods.
+	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
 	// Secrets is a list of Secrets in the same namespace as the Prometheus
 	// object, which shall be mounted into the Prometheus Pods.
 	// The Secrets are mounted into /etc/prometheus/secrets/<secret-name>.
@@ -238,6 +387,24 @@ type PrometheusSpec struct {
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
 	// If specified, the pod's topology spread constraints.
 	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Pods' hostAliases configuration, the entries are added to the hosts
+	// file of the pods.
+	HostAliases []v1.HostAlias `json:"hostAliases,omitempty"`
+	// Defines the DNS policy of the pods. Defaults to `ClusterFirst` or to
+	// `ClusterFirstWithHostNet` when hostNetwork is true.
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	// +optional
+	DNSPolicy *v1.DNSPolicy `json:"dnsPolicy,omitempty"`
+	// Defines the DNS parameters of the pods, in addition to the ones
+	// generated from the DNS policy.
+	// +optional
+	DNSConfig *v1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// Use the host's network namespace. When true, the pods listen on the
+	// network interfaces of the nodes so the replicas should be scheduled on
+	// different nodes and the ports shouldn't conflict with other workloads
+	// running on the host network.
+	// +optional
+	HostNetwork bool `json:"hostNetwork,omitempty"`
 	// If specified, the remote_write spec. This is an experimental feature, it may change in any upcoming release in a breaking way.
 	RemoteWrite []RemoteWriteSpec `json:"remoteWrite,omitempty"`
 	// If specified, the remote_read spec. This is an experimental feature, it may change in any upcoming release in a breaking way.
@@ -248,6 +415,14 @@ type PrometheusSpec struct {
 	// ListenLocal makes the Prometheus server listen on loopback, so that it
 	// does not bind against the Pod IP.
 	ListenLocal bool `json:"listenLocal,omitempty"`
+	// Overrides the parameters of the liveness probe of the Prometheus container.
+	LivenessProbe *ProbeParameters `json:"livenessProbe,omitempty"`
+	// Overrides the parameters of the readiness probe of the Prometheus container.
+	ReadinessProbe *ProbeParameters `json:"readinessProbe,omitempty"`
+	// Configures a startup probe for the Prometheus container. It can be used to
+	// allow a longer startup window without slowing down the detection of
+	// unhealthy containers afterwards.
+	StartupProbe *ProbeParameters `json:"startupProbe,omitempty"`
 	// Containers allows injecting additional containers or modifying operator
 	// generated containers. This can be used to allow adding an authentication
 	// proxy to a Prometheus pod or to change the behavior of an operator
@@ -266,6 +441,15 @@ type PrometheusSpec struct {
 	// of what the maintainers will support and by doing so, you accept that this behaviour may break
 	// at any time without notice.
 	InitContainers []v1.Container `json:"initContainers,omitempty"`
+	// AdditionalArgs allows setting additional arguments for the Prometheus container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Prometheus container which may cause issues if they are invalid or not supported
+	// by the given Prometheus version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	AdditionalArgs []Argument `json:"additionalArgs,omitempty"`
 	// AdditionalScrapeConfigs allows specifying a key of a Secret containing
 	// additional Prometheus scrape configurations. Scrape configurations
 	// specified are appended to the configurations generated by the Prometheus
@@ -318,6 +502,10 @@ type PrometheusSpec struct {
 	Thanos *ThanosSpec `json:"thanos,omitempty"`
 	// Priority class assigned to the Pods
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Configures the PodDisruptionBudget created by the operator for the
+	// Prometheus pods. When sharding is enabled, one PodDisruptionBudget is
+	// created for each shard.
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 	// Port name used for the pods and governing service.
 	// This defaults to web
 	PortName string `json:"portName,omitempty"`
@@ -340,15 +528,33 @@ type PrometheusSpec struct {
 	EnforcedNamespaceLabel string `json:"enforcedNamespaceLabel,omitempty"`
 	// PrometheusRulesExcludedFromEnforce - list of prometheus rules to be excluded from enforcing
 	// of adding namespace labels. Works only if enforcedNamespaceLabel set to true.
-	// Make sure both ruleNamespace and ruleName are set for each pair
+	// Make sure both ruleNamespace and ruleName are set for each pair.
+	// Deprecated: use excludedFromEnforcement instead.
 	PrometheusRulesExcludedFromEnforce []PrometheusRuleExcludeConfig `json:"prometheusRulesExcludedFromEnforce,omitempty"`
+	// List of references to PodMonitor, ServiceMonitor, Probe and
+	// PrometheusRule objects to be excluded from enforcing a namespace label
+	// of origin. Applies only if enforcedNamespaceLabel is set.
+	ExcludedFromEnforcement []ObjectReference `json:"excludedFromEnforcement,omitempty"`
 	// QueryLogFile specifies the file to which PromQL queries are logged.
-	// Note that this location must be writable, and can be persisted using an attached volume.
+	// If the filename has an empty path, e.g. 'query.log', the operator
+	// mounts an emptyDir volume at `/var/log/prometheus` to store the file.
+	// If a full path is provided, e.g. '/prometheus/query.log', the location
+	// must be writable: a path under `/prometheus` is persisted on the
+	// Prometheus storage volume, other directories require an additional
+	// volume mount.
 	// Alternatively, the location can be set to a stdout location such as `/dev/stdout` to log
-	// querie information to the default Prometheus log stream.
+	// query information to the default Prometheus log stream.
 	// This is only available in versions of Prometheus >= 2.16.0.
 	// For more details, see the Prometheus docs (https://prometheus.io/docs/guides/query-log/)
 	QueryLogFile string `json:"queryLogFile,omitempty"`
+	// ScrapeFailureLogFile specifies the file to which scrape failures are
+	// logged. It follows the same rules as QueryLogFile: a filename with an
+	// empty path is stored in an emptyDir volume mounted at
+	// `/var/log/prometheus` while a full path must be writable.
+	// It can be set to `/dev/stdout` to log the failures to the default
+	// Prometheus log stream.
+	// This is only available in versions of Prometheus >= 2.55.0.
+	ScrapeFailureLogFile string `json:"scrapeFailureLogFile,omitempty"`
 	// EnforcedSampleLimit defines global limit on number of scraped samples
 	// that will be accepted. This overrides any SampleLimit set per
 	// ServiceMonitor or/and PodMonitor. It is meant to be used by admins to
@@ -360,14 +566,139 @@ type PrometheusSpec struct {
 	// This is still experimental in Prometheus so it may change in any upcoming release.
 	AllowOverlappingBlocks bool `json:"allowOverlappingBlocks,omitempty"`
 	// EnforcedTargetLimit defines a global limit on the number of scraped targets.
-	// This overrides any TargetLimit set per ServiceMonitor or/and PodMonitor.
+	// This overrides any TargetLimit set per ServiceMonitor, PodMonitor or/and Probe.
 	// It is meant to be used by admins to
 	// enforce the TargetLimit to keep overall number of targets under
 	// the desired limit.
 	// Note that if TargetLimit is higher that value will be taken instead.
 	EnforcedTargetLimit *uint64 `json:"enforcedTargetLimit,omitempty"`
+	// EnforcedBodySizeLimit defines the maximum size of the uncompressed
+	// response body accepted by every scrape config generated by the
+	// operator. Example: `100MB`.
+	// Only valid in Prometheus versions 2.28.0 and newer.
+	// +kubebuilder:validation:Pattern="(^0|([0-9]*[.])?[0-9]+((K|M|G|T|E|P)i?)?B)$"
+	EnforcedBodySizeLimit string `json:"enforcedBodySizeLimit,omitempty"`
+	// EnforcedLabelLimit defines the maximum number of labels per sample
+	// accepted by every scrape config generated by the operator.
+	// It overrides the LabelLimit of the ServiceMonitors, PodMonitors and
+	// Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	EnforcedLabelLimit *uint64 `json:"enforcedLabelLimit,omitempty"`
+	// EnforcedLabelNameLengthLimit defines the maximum length of the label
+	// names per sample accepted by every scrape config generated by the
+	// operator.
+	// It overrides the LabelNameLengthLimit of the ServiceMonitors,
+	// PodMonitors and Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	EnforcedLabelNameLengthLimit *uint64 `json:"enforcedLabelNameLengthLimit,omitempty"`
+	// EnforcedLabelValueLengthLimit defines the maximum length of the label
+	// values per sample accepted by every scrape config generated by the
+	// operator.
+	// It overrides the LabelValueLengthLimit of the ServiceMonitors,
+	// PodMonitors and Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	EnforcedLabelValueLengthLimit *uint64 `json:"enforcedLabelValueLengthLimit,omitempty"`
+	// EnforcedKeepDroppedTargets defines the maximum number of targets
+	// dropped by relabeling which are kept in memory for every scrape config
+	// generated by the operator. 0 means no limit.
+	// It overrides the KeepDroppedTargets of the ServiceMonitors,
+	// PodMonitors and Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.47.0 and newer.
+	EnforcedKeepDroppedTargets *uint64 `json:"enforcedKeepDroppedTargets,omitempty"`
+}
+
+// ScrapeProtocol represents a protocol used by Prometheus for scraping
+// metrics.
+// Supported values are:
+// * `OpenMetricsText0.0.1`
+// * `OpenMetricsText1.0.0`
+// * `PrometheusProto`
+// * `PrometheusText0.0.4`
+// +kubebuilder:validation:Enum=PrometheusProto;OpenMetricsText0.0.1;OpenMetricsText1.0.0;PrometheusText0.0.4
+type ScrapeProtocol string
+
+const (
+	PrometheusProto      ScrapeProtocol = "PrometheusProto"
+	PrometheusText0_0_4  ScrapeProtocol = "PrometheusText0.0.4"
+	OpenMetricsText0_0_1 ScrapeProtocol = "OpenMetricsText0.0.1"
+	OpenMetricsText1_0_0 ScrapeProtocol = "OpenMetricsText1.0.0"
+)
+
+// ScrapeClass defines the settings which are applied to the scrape jobs
+// generated from the scraping objects referencing the class.
+// +k8s:openapi-gen=true
+type ScrapeClass struct {
+	// Name of the scrape class.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Default indicates that the scrape class applies to all scraping objects
+	// that don't reference a scrape class explicitly.
+	// Only one scrape class can be set as the default.
+	Default *bool `json:"default,omitempty"`
+	// TLS configuration used by the scrape jobs of the class. It only applies
+	// to the endpoints which don't define their own TLS configuration.
+	// Only the file fields (caFile, certFile and keyFile) as well as
+	// serverName and insecureSkipVerify are supported.
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+	// Authorization section used by the scrape jobs of the class. It only
+	// applies to the endpoints which don't define their own bearer token or
+	// basic authentication.
+	// The credentials secret is read from the namespace of the Prometheus
+	// object.
+	// Only valid in Prometheus versions 2.26.0 and newer.
+	Authorization *Authorization `json:"authorization,omitempty"`
+	// Relabelings applied to all the scrape targets of the class. They are
+	// added after the relabelings generated by the operator and before the
+	// relabelings of the scraping object.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	Relabelings []*RelabelConfig `json:"relabelings,omitempty"`
+	// MetricRelabelings applied to all the samples of the class before
+	// ingestion. They are added before the metric relabelings of the scraping
+	// object.
+	MetricRelabelings []*RelabelConfig `json:"metricRelabelings,omitempty"`
+	// AttachMetadata configures the metadata attached to the discovered
+	// targets of the class.
+	AttachMetadata *AttachMetadata `json:"attachMetadata,omitempty"`
 }
 
+// AttachMetadata configures the metadata attached to the discovered targets.
+// +k8s:openapi-gen=true
+type AttachMetadata struct {
+	// When set to true, Prometheus attaches the node metadata to the
+	// discovered targets. The Prometheus service account must have the `list`
+	// and `watch` permissions on the `Nodes` objects.
+	// Only valid for PodMonitors with Prometheus versions 2.35.0 and newer
+	// and for ServiceMonitors with Prometheus versions 2.37.0 and newer.
+	Node bool `json:"node,omitempty"`
+}
+
+// ServiceDiscoveryRole is the Kubernetes service discovery role used to
+// discover the targets of the ServiceMonitors.
+// +kubebuilder:validation:Enum=Endpoints;EndpointSlice
+type ServiceDiscoveryRole string
+
+const (
+	EndpointsRole     ServiceDiscoveryRole = "Endpoints"
+	EndpointSliceRole ServiceDiscoveryRole = "EndpointSlice"
+)
+
+// SelectorMechanism defines how the label selector of a ServiceMonitor or
+// PodMonitor is enforced.
+// Supported values are:
+// * `RelabelConfig`: the selector is translated into relabeling rules which
+// drop the non-matching targets after discovery.
+// * `RoleSelector`: the selector is passed to the Kubernetes service
+// discovery which only watches the matching objects. For ServiceMonitors, the
+// selector applies to the Endpoints objects which inherit the labels of their
+// service.
+// +kubebuilder:validation:Enum=RelabelConfig;RoleSelector
+type SelectorMechanism string
+
+const (
+	SelectorMechanismRelabel SelectorMechanism = "RelabelConfig"
+	SelectorMechanismRole    SelectorMechanism = "RoleSelector"
+)
+
 // PrometheusRuleExcludeConfig enables users to configure excluded PrometheusRule names and their namespaces
 // to be ignored while enforcing namespace label for alerts and metrics.
 type PrometheusRuleExcludeConfig struct {
@@ -377,6 +708,29 @@ type PrometheusRuleExcludeConfig struct {
 	RuleName string `json:"ruleName"`
 }
 
+// ObjectReference references a PodMonitor, ServiceMonitor, Probe or
+// PrometheusRule object.
+// +k8s:openapi-gen=true
+type ObjectReference struct {
+	// Group of the referent. When not specified, it defaults to
+	// `monitoring.coreos.com`.
+	// +optional
+	// +kubebuilder:default:="monitoring.coreos.com"
+	// +kubebuilder:validation:Enum=monitoring.coreos.com
+	Group string `json:"group"`
+	// Resource of the referent.
+	// +kubebuilder:validation:Enum=prometheusrules;servicemonitors;podmonitors;probes
+	Resource string `json:"resource"`
+	// Namespace of the referent.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// Name of the referent. When not set, all resources in the namespace
+	// are matched.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
 // ArbitraryFSAccessThroughSMsConfig enables users to configure, whether
 // a service monitor selected by the Prometheus instance is allowed to use
 // arbitrary files on the file system of the Prometheus container. This is the case
@@ -389,9 +743,8 @@ type ArbitraryFSAccessThroughSMsConfig struct {
 	Deny bool `json:"deny,omitempty"`
 }
 
-// PrometheusStatus is the most recent observed status of the Prometheus cluster. Read-only. Not
-// included when requesting from the apiserver, only from the Prometheus
-// Operator API itself. More info:
+// PrometheusStatus is the most recent observed status of the Prometheus cluster. Read-only.
+// More info:
 // https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
 // +k8s:openapi-gen=true
 type PrometheusStatus struct {
@@ -404,11 +757,116 @@ type PrometheusStatus struct {
 	// Total number of non-terminated pods targeted by this Prometheus deployment
 	// that have the desired version spec.
 	UpdatedReplicas int32 `json:"updatedReplicas"`
-	// Total number of available pods (ready for at least minReadySeconds)
+	// Total number of available pods (running and ready)
 	// targeted by this Prometheus deployment.
 	AvailableReplicas int32 `json:"availableReplicas"`
 	// Total number of unavailable pods targeted by this Prometheus deployment.
 	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// The number of shards of this Prometheus deployment, as exposed by the
+	// scale subresource.
+	// +optional
+	Shards int32 `json:"shards,omitempty"`
+	// The label selector of the Prometheus pods, in the string format used
+	// by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+}
+
+// WhenScaledRetentionType defines what happens to the pods of the removed
+// shards.
+// +kubebuilder:validation:Enum=Delete;Retain
+type WhenScaledRetentionType string
+
+const (
+	// DeleteWhenScaledRetentionType deletes the pods of the removed shards
+	// immediately.
+	DeleteWhenScaledRetentionType WhenScaledRetentionType = "Delete"
+	// RetainWhenScaledRetentionType keeps the pods of the removed shards
+	// until the retention period expires.
+	RetainWhenScaledRetentionType WhenScaledRetentionType = "Retain"
+)
+
+// ShardRetentionPolicy defines the retention policy of the Prometheus shards.
+// +k8s:openapi-gen=true
+type ShardRetentionPolicy struct {
+	// Defines the retention policy when the number of shards is decreased.
+	// With `Retain`, the pods of the removed shards stop scraping targets
+	// but they keep running so that their data can still be queried (e.g.
+	// via the Thanos sidecar) until the retention period expires.
+	// Defaults to `Delete`.
+	WhenScaled *WhenScaledRetentionType `json:"whenScaled,omitempty"`
+	// Duration for which the pods of the removed shards are retained when
+	// whenScaled is `Retain`. Defaults to the retention of Prometheus
+	// (`spec.retention`) or to 24h if it isn't set.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	RetainFor string `json:"retainFor,omitempty"`
+}
+
+// StatefulSetUpdateStrategy defines how the pods of the Prometheus
+// statefulsets are updated.
+// +k8s:openapi-gen=true
+type StatefulSetUpdateStrategy struct {
+	// Type of the update strategy. Defaults to `RollingUpdate`.
+	// With `RollingUpdate`, the StatefulSet controller updates the pods of
+	// each shard one at a time, all shards being updated in parallel.
+	// With `OnDelete`, the operator deletes the outdated pods itself and
+	// ensures that no more than maxUnavailable pods across all shards and
+	// replicas are unavailable at any time.
+	// +optional
+	Type StatefulSetUpdateStrategyType `json:"type,omitempty"`
+	// Maximum number of pods across all shards and replicas that can be
+	// unavailable during the update. The value can be an absolute number
+	// (e.g. 5) or a percentage of the total number of pods (e.g. 10%),
+	// rounded down. Only applies to the `OnDelete` type. Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Only the pods with an ordinal greater than or equal to the partition
+	// are updated. It can be used to stage an update to a subset of the
+	// replicas of each shard. Only applies to the `RollingUpdate` type.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Partition *int32 `json:"partition,omitempty"`
+	// When true, the statefulsets are updated but the pods aren't, which
+	// holds off the rollout until it's set back to false.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// StatefulSetUpdateStrategyType defines who updates the Prometheus pods.
+// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+type StatefulSetUpdateStrategyType string
+
+const (
+	// RollingUpdateStatefulSetUpdateStrategyType delegates the update of the
+	// pods to the StatefulSet controller.
+	RollingUpdateStatefulSetUpdateStrategyType StatefulSetUpdateStrategyType = "RollingUpdate"
+	// OnDeleteStatefulSetUpdateStrategyType lets the operator delete the
+	// outdated pods across all shards.
+	OnDeleteStatefulSetUpdateStrategyType StatefulSetUpdateStrategyType = "OnDelete"
+)
+
+// ReloadStrategyType defines how the config-reloader triggers a reload of
+// the Prometheus configuration.
+// +kubebuilder:validation:Enum=HTTP;ProcessSignal
+type ReloadStrategyType string
+
+const (
+	// HTTPReloadStrategyType reloads the configuration with a request to the
+	// `/-/reload` endpoint.
+	HTTPReloadStrategyType ReloadStrategyType = "HTTP"
+	// ProcessSignalReloadStrategyType reloads the configuration by sending a
+	// SIGHUP signal to the Prometheus process.
+	ProcessSignalReloadStrategyType ReloadStrategyType = "ProcessSignal"
+)
+
+// Argument as part of the AdditionalArgs list.
+// +k8s:openapi-gen=true
+type Argument struct {
+	// Name of the argument, e.g. "scrape.discovery-reload-interval".
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Argument value, e.g. 30s. Can be empty for name-only arguments (e.g. --storage.tsdb.no-lockfile)
+	Value string `json:"value,omitempty"`
 }
 
 // AlertingSpec defines parameters for alerting configuration of Prometheus servers.
@@ -418,6 +876,45 @@ type AlertingSpec struct {
 	Alertmanagers []AlertmanagerEndpoints `json:"alertmanagers"`
 }
 
+// ProbeParameters overrides the parameters of a probe generated by the
+// operator. The parameters which aren't defined keep their default values.
+// +k8s:openapi-gen=true
+type ProbeParameters struct {
+	// Disables the probe.
+	Disabled bool `json:"disabled,omitempty"`
+	// Number of seconds after the container has started before the probe is
+	// initiated.
+	// +kubebuilder:validation:Minimum=0
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	// Number of seconds after which the probe times out.
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// How often (in seconds) to perform the probe.
+	// +kubebuilder:validation:Minimum=1
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// Minimum consecutive successes for the probe to be considered
+	// successful after having failed.
+	// +kubebuilder:validation:Minimum=1
+	SuccessThreshold *int32 `json:"successThreshold,omitempty"`
+	// Minimum consecutive failures for the probe to be considered failed
+	// after having succeeded.
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// PodDisruptionBudgetSpec defines the PodDisruptionBudget created by the
+// operator for the pods of a resource. Exactly one of `minAvailable` and
+// `maxUnavailable` must be set.
+// +k8s:openapi-gen=true
+type PodDisruptionBudgetSpec struct {
+	// Number or percentage of pods which must be available after an
+	// eviction.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// Number or percentage of pods which can be unavailable after an
+	// eviction.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // StorageSpec defines the configured storage for a group Prometheus servers.
 // If neither `emptyDir` nor `volumeClaimTemplate` is specified, then by default an [EmptyDir](https://kubernetes.io/docs/concepts/storage/volumes/#emptydir) will be used.
 // +k8s:openapi-gen=true
@@ -479,16 +976,121 @@ type EmbeddedObjectMetadata struct {
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,12,rep,name=annotations"`
 }
 
+// TSDBSpec defines the runtime reloadable configuration of the timeseries
+// database (TSDB).
+// +k8s:openapi-gen=true
+type TSDBSpec struct {
+	// Configures how old an out-of-order/out-of-bounds sample can be with
+	// respect to the TSDB max time. An out-of-order/out-of-bounds sample is
+	// ingested into the TSDB as long as the timestamp of the sample is >=
+	// (TSDB.MaxTime - outOfOrderTimeWindow).
+	// Out of order ingestion is an experimental feature which is useful to
+	// receive samples through remote write.
+	// Only valid in Prometheus versions 2.39.0 and newer.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	OutOfOrderTimeWindow string `json:"outOfOrderTimeWindow,omitempty"`
+}
+
+// Exemplars defines the runtime reloadable configuration of the exemplar
+// storage.
+// +k8s:openapi-gen=true
+type Exemplars struct {
+	// Maximum number of exemplars stored in memory for all series.
+	// If not set, Prometheus uses its default value.
+	// A value of zero or less than zero disables the storage.
+	MaxSize *int64 `json:"maxSize,omitempty"`
+}
+
+// OTLPConfig is the configuration for writing to the OTLP receiver.
+// +k8s:openapi-gen=true
+type OTLPConfig struct {
+	// List of OpenTelemetry Attributes that should be promoted to metric labels.
+	// Only valid in Prometheus versions 2.54.0 and newer.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	PromoteResourceAttributes []string `json:"promoteResourceAttributes,omitempty"`
+	// Configures how the OTLP receiver endpoint translates the incoming
+	// metrics.
+	// Only valid in Prometheus versions 3.0.0 and newer.
+	TranslationStrategy *TranslationStrategyOption `json:"translationStrategy,omitempty"`
+	// Enables adding `service.name`, `service.namespace` and
+	// `service.instance.id` resource attributes to the `target_info` metric,
+	// on top of converting them into the `instance` and `job` labels.
+	// Only valid in Prometheus versions 3.1.0 and newer.
+	KeepIdentifyingResourceAttributes *bool `json:"keepIdentifyingResourceAttributes,omitempty"`
+}
+
+// TranslationStrategyOption is the strategy used to translate the OTLP
+// metric and label names.
+// +kubebuilder:validation:Enum=NoUTF8EscapingWithSuffixes;UnderscoreEscapingWithSuffixes
+type TranslationStrategyOption string
+
+const (
+	// NoUTF8EscapingWithSuffixes keeps the UTF-8 characters of the metric
+	// and label names while adding the unit and type suffixes.
+	NoUTF8EscapingWithSuffixes TranslationStrategyOption = "NoUTF8EscapingWithSuffixes"
+	// UnderscoreEscapingWithSuffixes replaces the invalid characters of the
+	// metric and label names with underscores and adds the unit and type
+	// suffixes.
+	UnderscoreEscapingWithSuffixes TranslationStrategyOption = "UnderscoreEscapingWithSuffixes"
+)
+
+// PrometheusTracingConfig defines the tracing configuration of Prometheus.
+// +k8s:openapi-gen=true
+type PrometheusTracingConfig struct {
+	// Client used to export the traces. Supported values are `http` or
+	// `grpc`. Defaults to `grpc` if not set.
+	// +kubebuilder:validation:Enum=http;grpc
+	ClientType *string `json:"clientType,omitempty"`
+	// Endpoint to send the traces to. Should be provided in the format
+	// <host>:<port>.
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+	// Sets the probability a given trace will be sampled. Must be a float
+	// from 0 through 1.
+	SamplingFraction *resource.Quantity `json:"samplingFraction,omitempty"`
+	// If true, the client uses an insecure connection to the endpoint.
+	Insecure *bool `json:"insecure,omitempty"`
+	// Key-value pairs to be used as headers associated with the gRPC or
+	// HTTP requests.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Compression key for supported compression types. The only supported
+	// value is `gzip`.
+	// +kubebuilder:validation:Enum=gzip
+	Compression *string `json:"compression,omitempty"`
+	// Maximum time the exporter waits for each batch export.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	Timeout string `json:"timeout,omitempty"`
+	// TLS configuration to use when sending the traces.
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// RemoteWriteMessageVersion is the version of the protobuf message used by
+// the remote write protocol.
+// +kubebuilder:validation:Enum=V1.0;V2.0
+type RemoteWriteMessageVersion string
+
+const (
+	// RemoteWriteMessageVersion1_0 represents the `prometheus.WriteRequest`
+	// protobuf message introduced in the Remote Write 1.0 specification.
+	RemoteWriteMessageVersion1_0 = RemoteWriteMessageVersion("V1.0")
+	// RemoteWriteMessageVersion2_0 represents the `io.prometheus.write.v2.Request`
+	// protobuf message introduced in the Remote Write 2.0 specification.
+	RemoteWriteMessageVersion2_0 = RemoteWriteMessageVersion("V2.0")
+)
+
 // QuerySpec defines the query command line flags when starting Prometheus.
 // +k8s:openapi-gen=true
 type QuerySpec struct {
 	// The delta difference allowed for retrieving metrics during expression evaluations.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
 	LookbackDelta *string `json:"lookbackDelta,omitempty"`
 	// Number of concurrent queries that can be run at once.
 	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
 	// Maximum number of samples a single query can load into memory. Note that queries will fail if they would load more samples than this into memory, so this also limits the number of samples a query can return.
 	MaxSamples *int32 `json:"maxSamples,omitempty"`
 	// Maximum time a query may take before being aborted.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
 	Timeout *string `json:"timeout,omitempty"`
 }
 
@@ -497,6 +1099,22 @@ type QuerySpec struct {
 type WebSpec struct {
 	// The prometheus web page title
 	PageTitle *string `json:"pageTitle,omitempty"`
+	// Defines the TLS parameters for HTTPS. When set, Prometheus serves its
+	// web endpoints over HTTPS only and the operator switches the probes,
+	// the config-reloader and the Thanos sidecar to HTTPS.
+	// It requires Prometheus >= 2.24.0.
+	// +optional
+	TLSConfig *WebTLSConfig `json:"tlsConfig,omitempty"`
+	// Defines HTTP parameters for the web server.
+	// It requires Prometheus >= 2.24.0.
+	// +optional
+	HTTPConfig *WebHTTPConfig `json:"httpConfig,omitempty"`
+	// Defines the maximum number of simultaneous connections.
+	// A zero value means that Prometheus doesn't accept any incoming
+	// connection.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
 }
 
 // ThanosSpec defines parameters for a Prometheus server within a Thanos deployment.
@@ -532,17 +1150,45 @@ type ThanosSpec struct {
 	// ObjectStorageConfigFile specifies the path of the object storage configuration file.
 	// When used alongside with ObjectStorageConfig, ObjectStorageConfigFile takes precedence.
 	ObjectStorageConfigFile *string `json:"objectStorageConfigFile,omitempty"`
+	// DisableUpload stops the Thanos sidecar from uploading TSDB blocks to
+	// object storage even when ObjectStorageConfig or ObjectStorageConfigFile
+	// is set. In this case, the local compaction of Prometheus isn't
+	// disabled.
+	// +optional
+	DisableUpload bool `json:"disableUpload,omitempty"`
+	// BlockDuration controls the size of the TSDB blocks produced by
+	// Prometheus when the blocks are uploaded to object storage (the local
+	// compaction being disabled, the minimum and maximum block durations are
+	// equal). Defaults to 2h to match the upstream Prometheus defaults.
+	// WARNING: changing the block duration impacts the performance of the
+	// Thanos compactor and store components, it is recommended to keep it as
+	// a multiple of 2h.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	BlockDuration string `json:"blockSize,omitempty"`
 	// ListenLocal makes the Thanos sidecar listen on loopback, so that it
 	// does not bind against the Pod IP.
+	// Deprecated: use `grpcListenLocal` and `httpListenLocal` instead.
 	ListenLocal bool `json:"listenLocal,omitempty"`
+	// GRPCListenLocal makes the Thanos sidecar gRPC server listen on
+	// loopback, so that it does not bind against the Pod IP.
+	// +optional
+	GRPCListenLocal bool `json:"grpcListenLocal,omitempty"`
+	// HTTPListenLocal makes the Thanos sidecar HTTP server listen on
+	// loopback, so that it does not bind against the Pod IP.
+	// +optional
+	HTTPListenLocal bool `json:"httpListenLocal,omitempty"`
 	// TracingConfig configures tracing in Thanos. This is an experimental feature, it may change in any upcoming release in a breaking way.
 	TracingConfig *v1.SecretKeySelector `json:"tracingConfig,omitempty"`
 	// TracingConfig specifies the path of the tracing configuration file.
 	// When used alongside with TracingConfig, TracingConfigFile takes precedence.
 	TracingConfigFile string `json:"tracingConfigFile,omitempty"`
-	// GRPCServerTLSConfig configures the gRPC server from which Thanos Querier reads
-	// recorded rule data.
-	// Note: Currently only the CAFile, CertFile, and KeyFile fields are supported.
+	// GRPCServerTLSConfig configures the TLS parameters of the gRPC server
+	// from which Thanos Querier reads the Prometheus data.
+	// The certificate, key and CA can be read from Secrets or ConfigMaps in
+	// the namespace of the Prometheus object or from files mounted in the
+	// sidecar container (CAFile, CertFile and KeyFile take precedence).
+	// Note: the InsecureSkipVerify and ServerName fields aren't supported.
 	// Maps to the '--grpc-server-tls-*' CLI args.
 	GRPCServerTLSConfig *TLSConfig `json:"grpcServerTlsConfig,omitempty"`
 	// LogLevel for Thanos sidecar to be configured with.
@@ -551,6 +1197,16 @@ type ThanosSpec struct {
 	LogFormat string `json:"logFormat,omitempty"`
 	// MinTime for Thanos sidecar to be configured with. Option can be a constant time in RFC3339 format or time duration relative to current time, such as -1d or 2h45m. Valid duration units are ms, s, m, h, d, w, y.
 	MinTime string `json:"minTime,omitempty"`
+	// AdditionalArgs allows setting additional arguments for the Thanos sidecar container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Thanos sidecar container which may cause issues if they are invalid or not supported
+	// by the given Thanos version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	// +optional
+	AdditionalArgs []Argument `json:"additionalArgs,omitempty"`
 }
 
 // RemoteWriteSpec defines the remote_write configuration for prometheus.
@@ -572,12 +1228,91 @@ type RemoteWriteSpec struct {
 	BearerToken string `json:"bearerToken,omitempty"`
 	// File to read bearer token for remote write.
 	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+	// OAuth2 client credentials used to fetch a token for the remote write
+	// endpoint. It can't be combined with basicAuth, bearerToken and
+	// bearerTokenFile.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	OAuth2 *OAuth2WithTLSConfig `json:"oauth2,omitempty"`
+	// Sigv4 allows to configure AWS's Signature Verification 4 for the URL.
+	// It can't be combined with basicAuth, bearerToken, bearerTokenFile and
+	// oauth2.
+	// Only valid in Prometheus versions 2.26.0 and newer.
+	Sigv4 *Sigv4 `json:"sigv4,omitempty"`
+	// AzureAD for the URL. It can't be combined with basicAuth, bearerToken,
+	// bearerTokenFile, oauth2 and sigv4.
+	// Only valid in Prometheus versions 2.45.0 and newer.
+	AzureAD *AzureAD `json:"azureAd,omitempty"`
 	// TLS Config to use for remote write.
 	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
 	// Optional ProxyURL
 	ProxyURL string `json:"proxyUrl,omitempty"`
 	// QueueConfig allows tuning of the remote write queue parameters.
 	QueueConfig *QueueConfig `json:"queueConfig,omitempty"`
+	// MetadataConfig configures the sending of series metadata to the remote storage.
+	// Only valid in Prometheus versions 2.23.0 and newer.
+	MetadataConfig *MetadataConfig `json:"metadataConfig,omitempty"`
+	// Enables sending of exemplars over remote write. Note that
+	// exemplar-storage itself must be enabled using the `enableFeatures`
+	// option for exemplars to be scraped in the first place.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	SendExemplars *bool `json:"sendExemplars,omitempty"`
+	// Enables sending of native histograms, also known as sparse histograms
+	// over remote write.
+	// Only valid in Prometheus versions 2.40.0 and newer.
+	SendNativeHistograms *bool `json:"sendNativeHistograms,omitempty"`
+}
+
+// MetadataConfig configures the sending of series metadata to the remote storage.
+// +k8s:openapi-gen=true
+type MetadataConfig struct {
+	// Whether metric metadata is sent to the remote storage or not.
+	Send bool `json:"send,omitempty"`
+	// How frequently metric metadata is sent to the remote storage.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	SendInterval string `json:"sendInterval,omitempty"`
+	// The maximum number of metadata entries per send.
+	// Only valid in Prometheus versions 2.29.0 and newer.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxSamplesPerSend *int32 `json:"maxSamplesPerSend,omitempty"`
+}
+
+// AzureAD defines the configuration for remote write's azuread parameters.
+// +k8s:openapi-gen=true
+type AzureAD struct {
+	// The Azure Cloud. Options are 'AzurePublic', 'AzureChina', or 'AzureGovernment'.
+	// +kubebuilder:validation:Enum=AzureChina;AzureGovernment;AzurePublic
+	// +optional
+	Cloud *string `json:"cloud,omitempty"`
+	// ManagedIdentity defines the Azure User-assigned Managed identity.
+	// Exactly one of managedIdentity and oauth must be set.
+	// +optional
+	ManagedIdentity *ManagedIdentity `json:"managedIdentity,omitempty"`
+	// OAuth defines the client credentials used to authenticate.
+	// Exactly one of managedIdentity and oauth must be set.
+	// Only valid in Prometheus versions 2.48.0 and newer.
+	// +optional
+	OAuth *AzureOAuth `json:"oauth,omitempty"`
+}
+
+// ManagedIdentity defines the Azure User-assigned Managed identity.
+// +k8s:openapi-gen=true
+type ManagedIdentity struct {
+	// The client id
+	ClientID string `json:"clientId"`
+}
+
+// AzureOAuth defines the Azure OAuth settings.
+// +k8s:openapi-gen=true
+type AzureOAuth struct {
+	// The clientId of the Azure Active Directory application that is being used to authenticate.
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientId"`
+	// The secret containing the clientSecret of the Azure Active Directory application that is being used to authenticate.
+	ClientSecret v1.SecretKeySelector `json:"clientSecret"`
+	// The tenantId of the Azure Active Directory application that is being used to authenticate.
+	// +kubebuilder:validation:MinLength=1
+	TenantID string `json:"tenantId"`
 }
 
 // QueueConfig allows the tuning of remote_write queue_config parameters. This object
@@ -600,6 +1335,14 @@ type QueueConfig struct {
 	MinBackoff string `json:"minBackoff,omitempty"`
 	// MaxBackoff is the maximum retry delay.
 	MaxBackoff string `json:"maxBackoff,omitempty"`
+	// Retry upon receiving a 429 status code from the remote-write storage.
+	// This is experimental feature and might change in the future.
+	// Only valid in Prometheus versions 2.26.0 and newer.
+	RetryOnRateLimit bool `json:"retryOnRateLimit,omitempty"`
+	// SampleAgeLimit drops samples older than the limit.
+	// Only valid in Prometheus versions 2.50.0 and newer.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	SampleAgeLimit string `json:"sampleAgeLimit,omitempty"`
 }
 
 // RemoteReadSpec defines the remote_read configuration for prometheus.
@@ -625,10 +1368,95 @@ type RemoteReadSpec struct {
 	BearerToken string `json:"bearerToken,omitempty"`
 	// File to read bearer token for remote read.
 	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+	// Authorization section for remote read. It can't be combined with
+	// basicAuth, bearerToken, bearerTokenFile and oauth2.
+	// Only valid in Prometheus versions 2.26.0 and newer.
+	Authorization *SafeAuthorization `json:"authorization,omitempty"`
+	// OAuth2 client credentials used to fetch a token for the remote read
+	// endpoint. It can't be combined with basicAuth, bearerToken,
+	// bearerTokenFile and authorization.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	OAuth2 *OAuth2WithTLSConfig `json:"oauth2,omitempty"`
 	// TLS Config to use for remote read.
 	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
 	// Optional ProxyURL
 	ProxyURL string `json:"proxyUrl,omitempty"`
+	// Whether the client should follow HTTP 3xx redirects.
+	// Only valid in Prometheus versions 2.26.0 and newer.
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// Whether to use the external labels as selectors for the remote read
+	// endpoint. Defaults to true.
+	// Only valid in Prometheus versions 2.34.0 and newer.
+	FilterExternalLabels *bool `json:"filterExternalLabels,omitempty"`
+	// Custom HTTP headers to be sent along with each remote read request.
+	// Be aware that headers that are set by Prometheus itself can't be overwritten.
+	// Only valid in Prometheus versions 2.26.0 and newer.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// SafeAuthorization specifies the credentials of the Authorization header
+// read from a Secret.
+// +k8s:openapi-gen=true
+type SafeAuthorization struct {
+	// Set the authentication type. Defaults to Bearer, Basic will cause an
+	// error.
+	Type string `json:"type,omitempty"`
+	// The secret's key that contains the credentials of the request.
+	Credentials *v1.SecretKeySelector `json:"credentials,omitempty"`
+}
+
+// Validate semantically validates the given SafeAuthorization.
+func (c *SafeAuthorization) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if strings.ToLower(strings.TrimSpace(c.Type)) == "basic" {
+		return &AuthorizationValidationError{`Authorization type cannot be set to "basic", use "basic_auth" instead`}
+	}
+	if c.Credentials == nil {
+		return &AuthorizationValidationError{"Authorization credentials are required"}
+	}
+	return nil
+}
+
+// Authorization extends the SafeAuthorization with the credentials read from
+// a file.
+// +k8s:openapi-gen=true
+type Authorization struct {
+	SafeAuthorization `json:",inline"`
+	// File to read the credentials of the request from. Mutually exclusive
+	// with `credentials`.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+}
+
+// Validate semantically validates the given Authorization.
+func (c *Authorization) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Credentials != nil && c.CredentialsFile != "" {
+		return &AuthorizationValidationError{"Authorization can not specify both Credentials and CredentialsFile"}
+	}
+	if strings.ToLower(strings.TrimSpace(c.Type)) == "basic" {
+		return &AuthorizationValidationError{`Authorization type cannot be set to "basic", use "basic_auth" instead`}
+	}
+	if c.Credentials == nil && c.CredentialsFile == "" {
+		return &AuthorizationValidationError{"Authorization credentials are required"}
+	}
+	return nil
+}
+
+// AuthorizationValidationError is returned by SafeAuthorization.Validate()
+// and Authorization.Validate() on semantically invalid configurations.
+// +k8s:openapi-gen=false
+type AuthorizationValidationError struct {
+	err string
+}
+
+func (e *AuthorizationValidationError) Error() string {
+	return e.err
 }
 
 // RelabelConfig allows dynamic rewriting of the label set, being applied to samples before ingestion.
@@ -689,14 +1517,44 @@ type AlertmanagerEndpoints struct {
 	PathPrefix string `json:"pathPrefix,omitempty"`
 	// TLS Config to use for alertmanager connection.
 	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+	// BasicAuth allow an endpoint to authenticate over basic authentication
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
 	// BearerTokenFile to read from filesystem to use when authenticating to
 	// Alertmanager.
 	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+	// Authorization section for this alertmanager endpoint.
+	// Cannot be set at the same time as basicAuth or bearerTokenFile.
+	// It requires Prometheus >= 2.26.0.
+	// +optional
+	Authorization *SafeAuthorization `json:"authorization,omitempty"`
+	// Sigv4 allows to configure AWS's Signature Verification 4 for the URL.
+	// Cannot be set at the same time as basicAuth, bearerTokenFile or
+	// authorization.
+	// It requires Prometheus >= 2.48.0.
+	// +optional
+	Sigv4 *Sigv4 `json:"sigv4,omitempty"`
+	// Optional ProxyURL.
+	// +optional
+	ProxyURL *string `json:"proxyUrl,omitempty"`
 	// Version of the Alertmanager API that Prometheus uses to send alerts. It
 	// can be "v1" or "v2".
 	APIVersion string `json:"apiVersion,omitempty"`
 	// Timeout is a per-target Alertmanager timeout when pushing alerts.
 	Timeout *string `json:"timeout,omitempty"`
+	// Whether to enable HTTP2.
+	// It requires Prometheus >= 2.35.0.
+	// +optional
+	EnableHttp2 *bool `json:"enableHttp2,omitempty"`
+	// Relabel configuration applied to the discovered Alertmanagers.
+	// +optional
+	Relabelings []RelabelConfig `json:"relabelings,omitempty"`
+	// Relabel configuration applied to the alerts before sending them to
+	// the Alertmanagers of this endpoint, after the global alert relabel
+	// configuration (including additionalAlertRelabelConfigs).
+	// It requires Prometheus >= 2.51.0.
+	// +optional
+	AlertRelabelConfigs []RelabelConfig `json:"alertRelabelings,omitempty"`
 }
 
 // ServiceMonitor defines monitoring for a set of services.
@@ -729,6 +1587,43 @@ type ServiceMonitorSpec struct {
 	SampleLimit uint64 `json:"sampleLimit,omitempty"`
 	// TargetLimit defines a limit on the number of scraped targets that will be accepted.
 	TargetLimit uint64 `json:"targetLimit,omitempty"`
+	// Per-scrape limit on number of labels that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelLimit uint64 `json:"labelLimit,omitempty"`
+	// Per-scrape limit on length of labels name that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelNameLengthLimit uint64 `json:"labelNameLengthLimit,omitempty"`
+	// Per-scrape limit on length of labels value that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelValueLengthLimit uint64 `json:"labelValueLengthLimit,omitempty"`
+	// Per-scrape limit on the number of targets dropped by relabeling
+	// that will be kept in memory. 0 means no limit.
+	// Only valid in Prometheus versions 2.47.0 and newer.
+	KeepDroppedTargets uint64 `json:"keepDroppedTargets,omitempty"`
+	// The protocols to negotiate during a scrape. It tells clients the
+	// protocols supported by Prometheus in order of preference (from most to
+	// least preferred).
+	// If unset, the protocols of the Prometheus object are used.
+	// It requires Prometheus >= v2.49.0.
+	// +listType=set
+	ScrapeProtocols []ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+	// The scrape class to apply. When not set, the default scrape class of
+	// the Prometheus object is applied if any.
+	// +kubebuilder:validation:MinLength=1
+	ScrapeClassName *string `json:"scrapeClass,omitempty"`
+	// AttachMetadata configures the metadata attached to the discovered
+	// targets, e.g. the labels and annotations of the node running the
+	// target. When set, it takes precedence over the attachMetadata of the
+	// scrape class.
+	// +optional
+	AttachMetadata *AttachMetadata `json:"attachMetadata,omitempty"`
+	// SelectorMechanism defines how the label selector is enforced. The
+	// default is `RelabelConfig`.
+	// `RoleSelector` reduces the memory usage of the service discovery on
+	// large clusters because Prometheus only watches the selected objects.
+	// Only valid in Prometheus versions 2.17.0 and newer.
+	// +optional
+	SelectorMechanism *SelectorMechanism `json:"selectorMechanism,omitempty"`
 }
 
 // Endpoint defines a scrapeable endpoint serving Prometheus metrics.
@@ -763,13 +1658,32 @@ type Endpoint struct {
 	// BasicAuth allow an endpoint to authenticate over basic authentication
 	// More info: https://prometheus.io/docs/operating/configuration/#endpoints
 	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// OAuth2 for the URL. Only valid in Prometheus versions 2.27.0 and newer.
+	// Cannot be set at the same time as basicAuth, authorization,
+	// bearerTokenFile or bearerTokenSecret.
+	// +optional
+	OAuth2 *OAuth2WithTLSConfig `json:"oauth2,omitempty"`
+	// Authorization section for this endpoint. It replaces bearerTokenFile
+	// and bearerTokenSecret, the credentials being read from a Secret in the
+	// namespace of the service monitor.
+	// Only valid in Prometheus versions 2.26.0 and newer. Cannot be set at
+	// the same time as basicAuth, oauth2, bearerTokenFile or
+	// bearerTokenSecret.
+	// +optional
+	Authorization *SafeAuthorization `json:"authorization,omitempty"`
 	// MetricRelabelConfigs to apply to samples before ingestion.
 	MetricRelabelConfigs []*RelabelConfig `json:"metricRelabelings,omitempty"`
 	// RelabelConfigs to apply to samples before scraping.
 	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
 	RelabelConfigs []*RelabelConfig `json:"relabelings,omitempty"`
-	// ProxyURL eg http://proxyserver:2195 Directs scrapes to proxy through this endpoint.
-	ProxyURL *string `json:"proxyUrl,omitempty"`
+	// FollowRedirects specifies whether the client should follow HTTP 3xx
+	// redirects. It requires Prometheus >= 2.26.0.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// Whether to enable HTTP2. It requires Prometheus >= 2.35.0.
+	// +optional
+	EnableHttp2 *bool `json:"enableHttp2,omitempty"`
+	ProxyConfig `json:",inline"`
 }
 
 // PodMonitor defines monitoring for a set of pods.
@@ -799,14 +1713,59 @@ type PodMonitorSpec struct {
 	SampleLimit uint64 `json:"sampleLimit,omitempty"`
 	// TargetLimit defines a limit on the number of scraped targets that will be accepted.
 	TargetLimit uint64 `json:"targetLimit,omitempty"`
+	// Per-scrape limit on number of labels that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelLimit uint64 `json:"labelLimit,omitempty"`
+	// Per-scrape limit on length of labels name that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelNameLengthLimit uint64 `json:"labelNameLengthLimit,omitempty"`
+	// Per-scrape limit on length of labels value that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelValueLengthLimit uint64 `json:"labelValueLengthLimit,omitempty"`
+	// Per-scrape limit on the number of targets dropped by relabeling
+	// that will be kept in memory. 0 means no limit.
+	// Only valid in Prometheus versions 2.47.0 and newer.
+	KeepDroppedTargets uint64 `json:"keepDroppedTargets,omitempty"`
+	// The protocols to negotiate during a scrape. It tells clients the
+	// protocols supported by Prometheus in order of preference (from most to
+	// least preferred).
+	// If unset, the protocols of the Prometheus object are used.
+	// It requires Prometheus >= v2.49.0.
+	// +listType=set
+	ScrapeProtocols []ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+	// The scrape class to apply. When not set, the default scrape class of
+	// the Prometheus object is applied if any.
+	// +kubebuilder:validation:MinLength=1
+	ScrapeClassName *string `json:"scrapeClass,omitempty"`
+	// AttachMetadata configures the metadata attached to the discovered
+	// targets, e.g. the labels and annotations of the node running the
+	// target. When set, it takes precedence over the attachMetadata of the
+	// scrape class.
+	// +optional
+	AttachMetadata *AttachMetadata `json:"attachMetadata,omitempty"`
+	// SelectorMechanism defines how the label selector is enforced. The
+	// default is `RelabelConfig`.
+	// `RoleSelector` reduces the memory usage of the service discovery on
+	// large clusters because Prometheus only watches the selected objects.
+	// Only valid in Prometheus versions 2.17.0 and newer.
+	// +optional
+	SelectorMechanism *SelectorMechanism `json:"selectorMechanism,omitempty"`
 }
 
 // PodMetricsEndpoint defines a scrapeable endpoint of a Kubernetes Pod serving Prometheus metrics.
 // +k8s:openapi-gen=true
 type PodMetricsEndpoint struct {
-	// Name of the pod port this endpoint refers to. Mutually exclusive with targetPort.
+	// Name of the pod port this endpoint refers to. Mutually exclusive with
+	// portNumber and targetPort.
 	Port string `json:"port,omitempty"`
-	// Deprecated: Use 'port' instead.
+	// Number of the pod port this endpoint refers to. It is useful for the
+	// container ports which have no name. Mutually exclusive with port and
+	// targetPort.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	PortNumber *int32 `json:"portNumber,omitempty"`
+	// Deprecated: Use 'port' or 'portNumber' instead.
 	TargetPort *intstr.IntOrString `json:"targetPort,omitempty"`
 	// HTTP path to scrape for metrics.
 	Path string `json:"path,omitempty"`
@@ -831,13 +1790,31 @@ type PodMetricsEndpoint struct {
 	// BasicAuth allow an endpoint to authenticate over basic authentication.
 	// More info: https://prometheus.io/docs/operating/configuration/#endpoint
 	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// Authorization section for this endpoint. It replaces
+	// bearerTokenSecret, the credentials being read from a Secret in the
+	// namespace of the pod monitor.
+	// Only valid in Prometheus versions 2.26.0 and newer. Cannot be set at
+	// the same time as basicAuth or bearerTokenSecret.
+	// +optional
+	Authorization *SafeAuthorization `json:"authorization,omitempty"`
 	// MetricRelabelConfigs to apply to samples before ingestion.
 	MetricRelabelConfigs []*RelabelConfig `json:"metricRelabelings,omitempty"`
 	// RelabelConfigs to apply to samples before ingestion.
 	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
 	RelabelConfigs []*RelabelConfig `json:"relabelings,omitempty"`
-	// ProxyURL eg http://proxyserver:2195 Directs scrapes to proxy through this endpoint.
-	ProxyURL *string `json:"proxyUrl,omitempty"`
+	// When true (default), the pods in the `Failed` or `Succeeded` phase are
+	// dropped from the discovered targets. Disabling it can be useful for
+	// debugging.
+	// +optional
+	FilterRunning *bool `json:"filterRunning,omitempty"`
+	// FollowRedirects specifies whether the client should follow HTTP 3xx
+	// redirects. It requires Prometheus >= 2.26.0.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// Whether to enable HTTP2. It requires Prometheus >= 2.35.0.
+	// +optional
+	EnableHttp2 *bool `json:"enableHttp2,omitempty"`
+	ProxyConfig `json:",inline"`
 }
 
 // PodMetricsEndpointTLSConfig specifies TLS configuration parameters.
@@ -875,6 +1852,47 @@ type ProbeSpec struct {
 	Interval string `json:"interval,omitempty"`
 	// Timeout for scraping metrics from the Prometheus exporter.
 	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+	// SampleLimit defines per-scrape limit on number of scraped samples that will be accepted.
+	SampleLimit uint64 `json:"sampleLimit,omitempty"`
+	// TargetLimit defines a limit on the number of scraped targets that will be accepted.
+	// Only valid in Prometheus versions 2.21.0 and newer.
+	TargetLimit uint64 `json:"targetLimit,omitempty"`
+	// Per-scrape limit on number of labels that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelLimit uint64 `json:"labelLimit,omitempty"`
+	// Per-scrape limit on length of labels name that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelNameLengthLimit uint64 `json:"labelNameLengthLimit,omitempty"`
+	// Per-scrape limit on length of labels value that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	LabelValueLengthLimit uint64 `json:"labelValueLengthLimit,omitempty"`
+	// Per-scrape limit on the number of targets dropped by relabeling
+	// that will be kept in memory. 0 means no limit.
+	// Only valid in Prometheus versions 2.47.0 and newer.
+	KeepDroppedTargets uint64 `json:"keepDroppedTargets,omitempty"`
+	// The protocols to negotiate during a scrape. It tells clients the
+	// protocols supported by Prometheus in order of preference (from most to
+	// least preferred).
+	// If unset, the protocols of the Prometheus object are used.
+	// It requires Prometheus >= v2.49.0.
+	// +listType=set
+	ScrapeProtocols []ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+	// The scrape class to apply. When not set, the default scrape class of
+	// the Prometheus object is applied if any.
+	// +kubebuilder:validation:MinLength=1
+	ScrapeClassName *string `json:"scrapeClass,omitempty"`
+	// Authorization section for the prober. The credentials are read from a
+	// Secret in the namespace of the probe.
+	// Only valid in Prometheus versions 2.26.0 and newer.
+	// +optional
+	Authorization *SafeAuthorization `json:"authorization,omitempty"`
+	// FollowRedirects specifies whether the client should follow HTTP 3xx
+	// redirects. It requires Prometheus >= 2.26.0.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// Whether to enable HTTP2. It requires Prometheus >= 2.35.0.
+	// +optional
+	EnableHttp2 *bool `json:"enableHttp2,omitempty"`
 }
 
 // ProbeTargets defines a set of static and dynamically discovered targets for the prober.
@@ -918,7 +1936,34 @@ type ProberSpec struct {
 	Scheme string `json:"scheme,omitempty"`
 	// Path to collect metrics from.
 	// Defaults to `/probe`.
-	Path string `json:"path,omitempty"`
+	Path        string `json:"path,omitempty"`
+	ProxyConfig `json:",inline"`
+}
+
+// ProxyConfig defines the proxy settings used to scrape the targets.
+// +k8s:openapi-gen=true
+type ProxyConfig struct {
+	// ProxyURL eg http://proxyserver:2195 Directs scrapes to proxy through this endpoint.
+	ProxyURL *string `json:"proxyUrl,omitempty"`
+	// Comma-separated list of IP addresses, CIDR notations and domain names
+	// that should be excluded from proxying. IP and domain names can contain
+	// port numbers. It requires `proxyUrl` to be set.
+	// It requires Prometheus >= v2.43.0.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+	// Whether to use the proxy configuration defined by the environment
+	// variables (HTTP_PROXY, HTTPS_PROXY, and NO_PROXY) of the Prometheus
+	// container. It can't be set at the same time as `proxyUrl`.
+	// It requires Prometheus >= v2.43.0.
+	// +optional
+	ProxyFromEnvironment *bool `json:"proxyFromEnvironment,omitempty"`
+	// Headers to send to the proxy during CONNECT requests, e.g. for proxy
+	// authentication. The values are read from Secrets in the namespace of
+	// the resource. It requires `proxyUrl` or `proxyFromEnvironment` to be
+	// set.
+	// It requires Prometheus >= v2.43.0.
+	// +optional
+	ProxyConnectHeader map[string]v1.SecretKeySelector `json:"proxyConnectHeader,omitempty"`
 }
 
 // BasicAuth allow an endpoint to authenticate over basic authentication
@@ -933,6 +1978,79 @@ type BasicAuth struct {
 	Password v1.SecretKeySelector `json:"password,omitempty"`
 }
 
+// Sigv4 optionally configures AWS's Signature Verification 4 signing process to
+// sign requests.
+// +k8s:openapi-gen=true
+type Sigv4 struct {
+	// Region is the AWS region. If blank, the region from the default credentials chain is used.
+	Region string `json:"region,omitempty"`
+	// AccessKey is the AWS API key. If blank, the environment variable `AWS_ACCESS_KEY_ID` is used.
+	AccessKey *v1.SecretKeySelector `json:"accessKey,omitempty"`
+	// SecretKey is the AWS API secret. If blank, the environment variable `AWS_SECRET_ACCESS_KEY` is used.
+	SecretKey *v1.SecretKeySelector `json:"secretKey,omitempty"`
+	// Profile is the named AWS profile used to authenticate.
+	Profile string `json:"profile,omitempty"`
+	// RoleArn is the named AWS profile used to authenticate.
+	RoleArn string `json:"roleArn,omitempty"`
+}
+
+// OAuth2 allows an endpoint to authenticate with OAuth2.
+// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#oauth2
+// +k8s:openapi-gen=true
+type OAuth2 struct {
+	// The secret or configmap containing the OAuth2 client id
+	ClientID SecretOrConfigMap `json:"clientId"`
+	// The secret containing the OAuth2 client secret
+	ClientSecret v1.SecretKeySelector `json:"clientSecret"`
+	// The URL to fetch the token from
+	// +kubebuilder:validation:MinLength=1
+	TokenURL string `json:"tokenUrl"`
+	// OAuth2 scopes used for the token request
+	Scopes []string `json:"scopes,omitempty"`
+	// Parameters to append to the token URL
+	EndpointParams map[string]string `json:"endpointParams,omitempty"`
+}
+
+// OAuth2WithTLSConfig is the OAuth2 configuration of the Prometheus scrape
+// and remote read/write endpoints which can also configure the TLS settings
+// used to fetch the token.
+// +k8s:openapi-gen=true
+type OAuth2WithTLSConfig struct {
+	OAuth2 `json:",inline"`
+	// TLS configuration to use when connecting to the token URL.
+	// It requires Prometheus >= 2.43.0.
+	// +optional
+	TLSConfig *SafeTLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// OAuth2ValidationError is returned by OAuth2.Validate() on semantically
+// invalid configurations.
+// +k8s:openapi-gen=false
+type OAuth2ValidationError struct {
+	err string
+}
+
+func (e *OAuth2ValidationError) Error() string {
+	return e.err
+}
+
+// Validate semantically validates the given OAuth2 configuration.
+func (o *OAuth2) Validate() error {
+	if o.TokenURL == "" {
+		return &OAuth2ValidationError{err: "OAuth2 token url must be specified"}
+	}
+
+	if o.ClientID == (SecretOrConfigMap{}) {
+		return &OAuth2ValidationError{err: "OAuth2 client id must be specified"}
+	}
+
+	if err := o.ClientID.Validate(); err != nil {
+		return &OAuth2ValidationError{err: "invalid OAuth2 client id: " + err.Error()}
+	}
+
+	return nil
+}
+
 // SecretOrConfigMap allows to specify data as a Secret or ConfigMap. Fields are mutually exclusive.
 type SecretOrConfigMap struct {
 	// Secret containing data to use for the targets.
@@ -1132,10 +2250,18 @@ type PrometheusRuleSpec struct {
 // or 'abort'.  More info: https://github.com/thanos-io/thanos/blob/master/docs/components/rule.md#partial-response
 // +k8s:openapi-gen=true
 type RuleGroup struct {
-	Name                    string `json:"name"`
-	Interval                string `json:"interval,omitempty"`
-	Rules                   []Rule `json:"rules"`
-	PartialResponseStrategy string `json:"partial_response_strategy,omitempty"`
+	Name     string `json:"name"`
+	Interval string `json:"interval,omitempty"`
+	// Offsets the evaluation timestamp of this group by the specified
+	// duration into the past. It overrides the `ruleQueryOffset` field of
+	// the Prometheus resource.
+	// It requires Prometheus >= v2.53.0, the field is removed from the rule
+	// file otherwise.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	QueryOffset             *string `json:"query_offset,omitempty"`
+	Rules                   []Rule  `json:"rules"`
+	PartialResponseStrategy string  `json:"partial_response_strategy,omitempty"`
 }
 
 // Rule describes an alerting or recording rule.
@@ -1154,16 +2280,19 @@ type Rule struct {
 // +k8s:openapi-gen=true
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Alertmanager"
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The desired replicas number of Alertmanagers"
+// +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
 type Alertmanager struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of the desired behavior of the Alertmanager cluster. More info:
 	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
 	Spec AlertmanagerSpec `json:"spec"`
-	// Most recent observed status of the Alertmanager cluster. Read-only. Not
-	// included when requesting from the apiserver, only from the Prometheus
-	// Operator API itself. More info:
+	// Most recent observed status of the Alertmanager cluster. Read-only.
+	// More info:
 	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
 	Status *AlertmanagerStatus `json:"status,omitempty"`
 }
@@ -1199,6 +2328,10 @@ type AlertmanagerSpec struct {
 	// to use for pulling prometheus and alertmanager images from registries
 	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Image pull policy of the containers generated by the operator. When
+	// not defined, the Kubernetes defaults apply.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
 	// Secrets is a list of Secrets in the same namespace as the Alertmanager
 	// object, which shall be mounted into the Alertmanager Pods.
 	// The Secrets are mounted into /etc/alertmanager/secrets/<secret-name>.
@@ -1211,10 +2344,20 @@ type AlertmanagerSpec struct {
 	// Alertmanager object, which contains configuration for this Alertmanager
 	// instance. Defaults to 'alertmanager-<alertmanager-name>'
 	// The secret is mounted into /etc/alertmanager/config.
+	// When `alertmanagerConfiguration` is defined, the configuration from the
+	// default secret is ignored. If the secret is explicitly set, its
+	// configuration is used as the base which is overridden by the global
+	// AlertmanagerConfig object: the global parameters and the top-level
+	// route of the AlertmanagerConfig object take precedence and its
+	// receivers and time intervals replace the ones with the same name.
 	ConfigSecret string `json:"configSecret,omitempty"`
-	// Log level for Alertmanager to be configured with.
+	// Log level for Alertmanager and the config-reloader sidecar to be
+	// configured with.
+	// +kubebuilder:validation:Enum="";debug;info;warn;error
 	LogLevel string `json:"logLevel,omitempty"`
-	// Log format for Alertmanager to be configured with.
+	// Log format for Alertmanager and the config-reloader sidecar to be
+	// configured with.
+	// +kubebuilder:validation:Enum="";logfmt;json
 	LogFormat string `json:"logFormat,omitempty"`
 	// Size is the expected size of the alertmanager cluster. The controller will
 	// eventually make the size of the running cluster equal to the expected
@@ -1222,7 +2365,12 @@ type AlertmanagerSpec struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 	// Time duration Alertmanager shall retain data for. Default is '120h',
 	// and must match the regular expression `[0-9]+(ms|s|m|h)` (milliseconds seconds minutes hours).
+	// +kubebuilder:validation:Pattern="^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$"
 	Retention string `json:"retention,omitempty"`
+	// Interval between the garbage collections of the resolved alerts.
+	// Alertmanager uses its default value (30m) if not specified.
+	// +kubebuilder:validation:Pattern="^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$"
+	AlertsGCInterval string `json:"alertsGCInterval,omitempty"`
 	// Storage is the definition of how storage will be used by the Alertmanager
 	// instances.
 	Storage *StorageSpec `json:"storage,omitempty"`
@@ -1250,22 +2398,75 @@ type AlertmanagerSpec struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 	// Define resources requests and limits for single Pods.
 	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// Defines the resources requests and limits of the config-reloader
+	// sidecar. When set, they replace the values defined by the operator's
+	// `--config-reloader-cpu` and `--config-reloader-memory` flags.
+	// +optional
+	ConfigReloaderResources *v1.ResourceRequirements `json:"configReloaderResources,omitempty"`
+	// Defines how long the config-reloader sidecar waits without detecting
+	// new changes to the configuration, rules and secrets before reloading.
+	// Successive changes within the interval are coalesced into a single
+	// reload. When set, it replaces the value defined by the operator's
+	// `--config-reloader-delay-interval` flag.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	ReloadDelayInterval *string `json:"reloadDelayInterval,omitempty"`
+	// Defines the maximum number of replicas reloading their configuration at
+	// the same time. The replicas are staggered by the reload delay interval
+	// based on their ordinal number. 0 means no limit. When set, it replaces
+	// the value defined by the operator's
+	// `--config-reloader-max-concurrent-reloads` flag.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentReloads *int32 `json:"maxConcurrentReloads,omitempty"`
 	// If specified, the pod's scheduling constraints.
 	Affinity *v1.Affinity `json:"affinity,omitempty"`
 	// If specified, the pod's tolerations.
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
 	// If specified, the pod's topology spread constraints.
 	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Pods' hostAliases configuration, the entries are added to the hosts
+	// file of the pods.
+	HostAliases []v1.HostAlias `json:"hostAliases,omitempty"`
+	// Defines the DNS policy of the pods. Defaults to `ClusterFirst` or to
+	// `ClusterFirstWithHostNet` when hostNetwork is true.
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	// +optional
+	DNSPolicy *v1.DNSPolicy `json:"dnsPolicy,omitempty"`
+	// Defines the DNS parameters of the pods, in addition to the ones
+	// generated from the DNS policy.
+	// +optional
+	DNSConfig *v1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// Use the host's network namespace. When true, the pods listen on the
+	// network interfaces of the nodes so the replicas should be scheduled on
+	// different nodes and the ports shouldn't conflict with other workloads
+	// running on the host network.
+	// +optional
+	HostNetwork bool `json:"hostNetwork,omitempty"`
 	// SecurityContext holds pod-level security attributes and common container settings.
 	// This defaults to the default PodSecurityContext.
 	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
 	// ServiceAccountName is the name of the ServiceAccount to use to run the
 	// Prometheus Pods.
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// AutomountServiceAccountToken indicates whether a service account token
+	// should be automatically mounted in the pods.
+	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
 	// ListenLocal makes the Alertmanager server listen on loopback, so that it
 	// does not bind against the Pod IP. Note this is only for the Alertmanager
 	// UI, not the gossip communication.
 	ListenLocal bool `json:"listenLocal,omitempty"`
+	// Overrides the parameters of the liveness probe of the Alertmanager container.
+	LivenessProbe *ProbeParameters `json:"livenessProbe,omitempty"`
+	// Overrides the parameters of the readiness probe of the Alertmanager container.
+	ReadinessProbe *ProbeParameters `json:"readinessProbe,omitempty"`
+	// Configures a startup probe for the Alertmanager container. It can be used to
+	// allow a longer startup window without slowing down the detection of
+	// unhealthy containers afterwards.
+	StartupProbe *ProbeParameters `json:"startupProbe,omitempty"`
+	// Defines the web command line flags when starting Alertmanager.
+	// It requires Alertmanager >= 0.22.0.
+	Web *AlertmanagerWebSpec `json:"web,omitempty"`
 	// Containers allows injecting additional containers. This is meant to
 	// allow adding an authentication proxy to an Alertmanager pod.
 	// Containers described here modify an operator generated container if they
@@ -1282,9 +2483,23 @@ type AlertmanagerSpec struct {
 	// of what the maintainers will support and by doing so, you accept that this behaviour may break
 	// at any time without notice.
 	InitContainers []v1.Container `json:"initContainers,omitempty"`
+	// AdditionalArgs allows setting additional arguments for the Alertmanager container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Alertmanager container which may cause issues if they are invalid or not supported
+	// by the given Alertmanager version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	AdditionalArgs []Argument `json:"additionalArgs,omitempty"`
 	// Priority class assigned to the Pods
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Configures the PodDisruptionBudget created by the operator for the
+	// Alertmanager pods.
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 	// AdditionalPeers allows injecting a set of additional Alertmanagers to peer with to form a highly available cluster.
+	// Each peer is a `host:port` address, the mesh port (9094) is used when no port is given.
+	// It can be used to mesh Alertmanager replicas running in different Kubernetes clusters.
 	AdditionalPeers []string `json:"additionalPeers,omitempty"`
 	// ClusterAdvertiseAddress is the explicit address to advertise in cluster.
 	// Needs to be provided for non RFC1918 [1] (public) addresses.
@@ -1296,6 +2511,29 @@ type AlertmanagerSpec struct {
 	ClusterPushpullInterval string `json:"clusterPushpullInterval,omitempty"`
 	// Timeout for cluster peering.
 	ClusterPeerTimeout string `json:"clusterPeerTimeout,omitempty"`
+	// Timeout for establishing a stream connection with a remote node for a
+	// full state sync, and for stream read and write operations.
+	ClusterTCPTimeout string `json:"clusterTcpTimeout,omitempty"`
+	// Interval between random node probes.
+	ClusterProbeInterval string `json:"clusterProbeInterval,omitempty"`
+	// Timeout to wait for an ack from a probed node before assuming it is
+	// unhealthy.
+	ClusterProbeTimeout string `json:"clusterProbeTimeout,omitempty"`
+	// Defines the identifier that uniquely identifies the Alertmanager
+	// cluster. Messages from peers with a different label are rejected.
+	// It requires Alertmanager >= 0.26.0, the field is ignored otherwise.
+	ClusterLabel string `json:"clusterLabel,omitempty"`
+	// Configures the mutual TLS configuration for the Alertmanager cluster's
+	// gossip protocol.
+	// It requires Alertmanager >= 0.24.0.
+	ClusterTLS *ClusterTLSConfig `json:"clusterTLS,omitempty"`
+	// Enable access to Alertmanager feature flags. By default, no features
+	// are enabled. Enabling features which are disabled by default is
+	// entirely outside the scope of what the maintainers will support and by
+	// doing so, you accept that this behaviour may break at any time without
+	// notice.
+	// It requires Alertmanager >= 0.27.0, the field is ignored otherwise.
+	EnableFeatures []string `json:"enableFeatures,omitempty"`
 	// Port name used for the pods and governing service.
 	// This defaults to web
 	PortName string `json:"portName,omitempty"`
@@ -1307,6 +2545,324 @@ type AlertmanagerSpec struct {
 	// Namespaces to be selected for AlertmanagerConfig discovery. If nil, only
 	// check own namespace.
 	AlertmanagerConfigNamespaceSelector *metav1.LabelSelector `json:"alertmanagerConfigNamespaceSelector,omitempty"`
+	// ClusterAlertmanagerConfigs to be selected to merge and configure
+	// Alertmanager with. They are merged before the AlertmanagerConfig
+	// objects and their routes come first. If nil, no
+	// ClusterAlertmanagerConfig is selected.
+	// The ClusterAlertmanagerConfig objects are only discovered when the
+	// operator watches all namespaces.
+	ClusterAlertmanagerConfigSelector *metav1.LabelSelector `json:"clusterAlertmanagerConfigSelector,omitempty"`
+	// TemplateConfigMapSelector selects the ConfigMaps in the Alertmanager
+	// namespace which contain notification templates. Every key of the
+	// selected ConfigMaps is written as a template file next to the
+	// generated configuration and added to its `templates` list. If nil, no
+	// ConfigMap is selected.
+	// Only the ConfigMaps in the namespace of the Alertmanager object are
+	// selected, the alertmanagerConfigNamespaceSelector field doesn't apply.
+	TemplateConfigMapSelector *metav1.LabelSelector `json:"templateConfigMapSelector,omitempty"`
+	// The AlertmanagerConfigMatcherStrategy defines how AlertmanagerConfig
+	// objects match the alerts. In the future more options may be added.
+	AlertmanagerConfigMatcherStrategy AlertmanagerConfigMatcherStrategy `json:"alertmanagerConfigMatcherStrategy,omitempty"`
+	// Defines how the credentials read from the Secrets referenced by the
+	// AlertmanagerConfig receivers are passed to Alertmanager.
+	// `Inline` (default) writes them in the generated configuration file.
+	// `File` writes them to separate files next to the configuration file and
+	// references them with the `*_file` fields (`routing_key_file`,
+	// `api_key_file`, `auth_password_file`, ...). The credentials fall back
+	// to `Inline` when the Alertmanager version doesn't support the
+	// corresponding `*_file` field.
+	// +kubebuilder:validation:Enum=Inline;File
+	AlertmanagerConfigCredentialsMode AlertmanagerConfigCredentialsMode `json:"alertmanagerConfigCredentialsMode,omitempty"`
+	// Default value of `sendResolved` for the receivers generated from the
+	// AlertmanagerConfig objects when the receiver doesn't set it. It takes
+	// precedence over the default value configured at the operator level.
+	// If not defined, the Alertmanager defaults apply.
+	AlertmanagerConfigDefaultSendResolved *bool `json:"alertmanagerConfigDefaultSendResolved,omitempty"`
+	// EXPERIMENTAL: alertmanagerConfiguration specifies the global
+	// Alertmanager configuration. If defined, it takes precedence over the
+	// configuration defined in the `configSecret` field.
+	// This field may change in future releases.
+	AlertmanagerConfiguration *AlertmanagerConfiguration `json:"alertmanagerConfiguration,omitempty"`
+}
+
+// AlertmanagerConfigMatcherStrategy defines the strategy used by
+// AlertmanagerConfig objects to match alerts.
+// +k8s:openapi-gen=true
+type AlertmanagerConfigMatcherStrategy struct {
+	// Type defines the strategy used by AlertmanagerConfig objects to match
+	// alerts in the routes and inhibition rules.
+	//
+	// `OnNamespace` (default) adds a `namespace` matcher equal to the
+	// namespace of the AlertmanagerConfig object.
+	// `OnNamespaceExceptForAlertmanagerNamespace` behaves like `OnNamespace`
+	// except for AlertmanagerConfig objects living in the same namespace as
+	// the Alertmanager object which match alerts from all namespaces.
+	// `None` doesn't add any `namespace` matcher.
+	// +kubebuilder:validation:Enum=OnNamespace;OnNamespaceExceptForAlertmanagerNamespace;None
+	// +kubebuilder:default:="OnNamespace"
+	Type AlertmanagerConfigMatcherStrategyType `json:"type,omitempty"`
+}
+
+// AlertmanagerConfigMatcherStrategyType is the type of the matcher strategy.
+type AlertmanagerConfigMatcherStrategyType string
+
+const (
+	// OnNamespaceConfigMatcherStrategyType enforces a namespace matcher on
+	// all AlertmanagerConfig objects.
+	OnNamespaceConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "OnNamespace"
+	// OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType
+	// enforces a namespace matcher on all AlertmanagerConfig objects except
+	// those in the Alertmanager's namespace.
+	OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "OnNamespaceExceptForAlertmanagerNamespace"
+	// NoneConfigMatcherStrategyType doesn't enforce any namespace matcher.
+	NoneConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "None"
+)
+
+// AlertmanagerConfigCredentialsMode defines how the credentials of the
+// AlertmanagerConfig receivers are passed to Alertmanager.
+type AlertmanagerConfigCredentialsMode string
+
+const (
+	// InlineCredentialsMode writes the credentials in the configuration file.
+	InlineCredentialsMode AlertmanagerConfigCredentialsMode = "Inline"
+	// FileCredentialsMode writes the credentials to separate files.
+	FileCredentialsMode AlertmanagerConfigCredentialsMode = "File"
+)
+
+// AlertmanagerWebSpec defines the web command line flags when starting
+// Alertmanager.
+// +k8s:openapi-gen=true
+type AlertmanagerWebSpec struct {
+	// Defines the TLS parameters for HTTPS.
+	// +optional
+	TLSConfig *WebTLSConfig `json:"tlsConfig,omitempty"`
+	// Defines HTTP parameters for the web server.
+	// +optional
+	HTTPConfig *WebHTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// WebTLSConfig defines the TLS parameters for HTTPS.
+// See https://prometheus.io/docs/prometheus/latest/configuration/https/
+// +k8s:openapi-gen=true
+type WebTLSConfig struct {
+	// Contains the TLS certificate for the server.
+	Cert SecretOrConfigMap `json:"cert"`
+	// Secret containing the TLS key for the server.
+	KeySecret v1.SecretKeySelector `json:"keySecret"`
+	// Contains the CA certificate for client certificate authentication to
+	// the server.
+	// +optional
+	ClientCA SecretOrConfigMap `json:"clientCA,omitempty"`
+	// Server policy for client authentication. Maps to ClientAuth Policies.
+	// For more detail on clientAuth options:
+	// https://golang.org/pkg/crypto/tls/#ClientAuthType
+	// +kubebuilder:validation:Enum=NoClientCert;RequestClientCert;RequireAnyClientCert;VerifyClientCertIfGiven;RequireAndVerifyClientCert
+	// +optional
+	ClientAuthType string `json:"clientAuthType,omitempty"`
+	// Minimum TLS version that is acceptable. Defaults to TLS12.
+	// +kubebuilder:validation:Enum=TLS10;TLS11;TLS12;TLS13
+	// +optional
+	MinVersion string `json:"minVersion,omitempty"`
+	// Maximum TLS version that is acceptable. Defaults to TLS13.
+	// +kubebuilder:validation:Enum=TLS10;TLS11;TLS12;TLS13
+	// +optional
+	MaxVersion string `json:"maxVersion,omitempty"`
+	// List of supported cipher suites for TLS versions up to TLS 1.2. If empty,
+	// Go default cipher suites are used. Available cipher suites are documented
+	// in the go documentation: https://golang.org/pkg/crypto/tls/#pkg-constants
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+	// Controls whether the server selects the client's most preferred cipher
+	// suite, or the server's most preferred cipher suite. If true then the
+	// server's preference, as expressed in the order of elements in
+	// cipherSuites, is used.
+	// +optional
+	PreferServerCipherSuites *bool `json:"preferServerCipherSuites,omitempty"`
+	// Elliptic curves that will be used in an ECDHE handshake, in preference
+	// order. Available curves are documented in the go documentation:
+	// https://golang.org/pkg/crypto/tls/#CurveID
+	// +optional
+	CurvePreferences []string `json:"curvePreferences,omitempty"`
+}
+
+// ClusterTLSConfig defines the mutual TLS configuration for the Alertmanager
+// cluster TLS protocol.
+// +k8s:openapi-gen=true
+type ClusterTLSConfig struct {
+	// Server-side configuration for mutual TLS.
+	ServerTLS WebTLSConfig `json:"server"`
+	// Client-side configuration for mutual TLS.
+	ClientTLS SafeTLSConfig `json:"client"`
+}
+
+// WebHTTPConfig defines HTTP parameters for the web server.
+// +k8s:openapi-gen=true
+type WebHTTPConfig struct {
+	// Enable HTTP/2 support. Note that HTTP/2 is only supported with TLS.
+	// When not set, HTTP/2 is enabled.
+	// +optional
+	HTTP2 *bool `json:"http2,omitempty"`
+	// List of headers that can be added to HTTP responses.
+	// It requires Alertmanager >= 0.24.0 or Prometheus >= 2.34.0.
+	// +optional
+	Headers *WebHTTPHeaders `json:"headers,omitempty"`
+}
+
+// WebHTTPHeaders defines the list of headers that can be added to HTTP
+// responses.
+// +k8s:openapi-gen=true
+type WebHTTPHeaders struct {
+	// Set the Content-Security-Policy header to HTTP responses.
+	// Unset if blank.
+	// +optional
+	ContentSecurityPolicy string `json:"contentSecurityPolicy,omitempty"`
+	// Set the X-Frame-Options header to HTTP responses.
+	// Unset if blank. Accepted values are Deny and SameOrigin.
+	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/X-Frame-Options
+	// +kubebuilder:validation:Enum="";Deny;SameOrigin
+	// +optional
+	XFrameOptions string `json:"xFrameOptions,omitempty"`
+	// Set the X-Content-Type-Options header to HTTP responses.
+	// Unset if blank. Accepted value is NoSniff.
+	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/X-Content-Type-Options
+	// +kubebuilder:validation:Enum="";NoSniff
+	// +optional
+	XContentTypeOptions string `json:"xContentTypeOptions,omitempty"`
+	// Set the X-XSS-Protection header to all responses.
+	// Unset if blank.
+	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/X-XSS-Protection
+	// +optional
+	XXSSProtection string `json:"xXSSProtection,omitempty"`
+	// Set the Strict-Transport-Security header to HTTP responses.
+	// Unset if blank.
+	// Please make sure that you use this with care as this header might force
+	// browsers to load Alertmanager or Prometheus over HTTPS.
+	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security
+	// +optional
+	StrictTransportSecurity string `json:"strictTransportSecurity,omitempty"`
+}
+
+// AlertmanagerConfiguration defines the global Alertmanager configuration.
+// +k8s:openapi-gen=true
+type AlertmanagerConfiguration struct {
+	// The name of the AlertmanagerConfig resource which is used to generate
+	// the global configuration. It must be defined in the same namespace as
+	// the Alertmanager object. Its route is used as the top-level route and
+	// the operator doesn't enforce a `namespace` matcher on its routes and
+	// inhibition rules.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+	// Defines the global parameters of the Alertmanager configuration.
+	// +optional
+	Global *AlertmanagerGlobalConfig `json:"global,omitempty"`
+}
+
+// AlertmanagerGlobalConfig configures parameters that are valid in all other
+// configuration contexts.
+// See https://prometheus.io/docs/alerting/latest/configuration/#configuration-file
+// +k8s:openapi-gen=true
+type AlertmanagerGlobalConfig struct {
+	// ResolveTimeout is the default value used by alertmanager if the alert does
+	// not include EndsAt, after this time passes it can declare the alert as resolved if it has not been updated.
+	// This has no impact on alerts from Prometheus, as they always include EndsAt.
+	// Defaults to 5m.
+	// +kubebuilder:validation:Pattern="^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$"
+	// +optional
+	ResolveTimeout string `json:"resolveTimeout,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+	// Configures global SMTP parameters.
+	// +optional
+	SMTPConfig *GlobalSMTPConfig `json:"smtp,omitempty"`
+	// The secret's key that contains the Slack webhook URL.
+	// The secret needs to be in the same namespace as the Alertmanager
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	SlackAPIURL *v1.SecretKeySelector `json:"slackApiUrl,omitempty"`
+	// The secret's key that contains the OpsGenie API key.
+	// The secret needs to be in the same namespace as the Alertmanager
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	OpsGenieAPIKey *v1.SecretKeySelector `json:"opsGenieApiKey,omitempty"`
+	// The default Pagerduty URL.
+	// +optional
+	PagerdutyURL string `json:"pagerdutyUrl,omitempty"`
+}
+
+// GlobalSMTPConfig configures global SMTP parameters.
+// See https://prometheus.io/docs/alerting/latest/configuration/#configuration-file
+// +k8s:openapi-gen=true
+type GlobalSMTPConfig struct {
+	// The default SMTP From header field.
+	// +optional
+	From string `json:"from,omitempty"`
+	// The default SMTP smarthost used for sending emails.
+	// +optional
+	SmartHost *HostPort `json:"smartHost,omitempty"`
+	// The default hostname to identify to the SMTP server.
+	// +optional
+	Hello string `json:"hello,omitempty"`
+	// SMTP Auth using CRAM-MD5, LOGIN and PLAIN. If empty, Alertmanager doesn't authenticate to the SMTP server.
+	// +optional
+	AuthUsername string `json:"authUsername,omitempty"`
+	// SMTP Auth using LOGIN and PLAIN.
+	// The secret needs to be in the same namespace as the Alertmanager
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	AuthPassword *v1.SecretKeySelector `json:"authPassword,omitempty"`
+	// SMTP Auth using PLAIN
+	// +optional
+	AuthIdentity string `json:"authIdentity,omitempty"`
+	// SMTP Auth using CRAM-MD5.
+	// The secret needs to be in the same namespace as the Alertmanager
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	AuthSecret *v1.SecretKeySelector `json:"authSecret,omitempty"`
+	// The default SMTP TLS requirement.
+	// Note that Go does not support unencrypted connections to remote SMTP endpoints.
+	// +optional
+	RequireTLS *bool `json:"requireTLS,omitempty"`
+}
+
+// HostPort represents a "host:port" network address.
+// +k8s:openapi-gen=true
+type HostPort struct {
+	// Defines the host's address, it can be a DNS name or a literal IP address.
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+	// Defines the host's port, it can be a literal port number or a port name.
+	// +kubebuilder:validation:MinLength=1
+	Port string `json:"port"`
+}
+
+// HTTPConfig defines a client HTTP configuration.
+// See https://prometheus.io/docs/alerting/latest/configuration/#http_config
+// +k8s:openapi-gen=true
+type HTTPConfig struct {
+	// BasicAuth for the client.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// The secret's key that contains the bearer token to be used by the client
+	// for authentication.
+	// The secret needs to be in the same namespace as the Alertmanager
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	BearerTokenSecret *v1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+	// TLS configuration for the client.
+	// +optional
+	TLSConfig *SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// Optional proxy URL.
+	// +optional
+	ProxyURL string `json:"proxyURL,omitempty"`
+	// FollowRedirects specifies whether the client should follow HTTP 3xx redirects.
+	// It requires Alertmanager >= 0.22.0.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// OAuth2 client credentials used to fetch a token for the targets.
+	// It requires Alertmanager >= 0.22.0.
+	// +optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
 }
 
 // AlertmanagerList is a list of Alertmanagers.
@@ -1335,11 +2891,68 @@ type AlertmanagerStatus struct {
 	// Total number of non-terminated pods targeted by this Alertmanager
 	// cluster that have the desired version spec.
 	UpdatedReplicas int32 `json:"updatedReplicas"`
-	// Total number of available pods (ready for at least minReadySeconds)
+	// Total number of available pods (running and ready)
 	// targeted by this Alertmanager cluster.
 	AvailableReplicas int32 `json:"availableReplicas"`
 	// Total number of unavailable pods targeted by this Alertmanager cluster.
 	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// The label selector of the Alertmanager pods, in the string format used
+	// by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// The list of conditions reported by the operator. The `Available`
+	// condition tells whether the Alertmanager pods are ready and the
+	// `Reconciled` condition whether the last reconciliation succeeded.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []AlertmanagerCondition `json:"conditions,omitempty"`
+}
+
+// AlertmanagerConditionType is the type of a condition reported in the
+// Alertmanager status.
+type AlertmanagerConditionType string
+
+const (
+	// AlertmanagerAvailable indicates whether enough Alertmanager pods are
+	// ready to provide the service.
+	AlertmanagerAvailable AlertmanagerConditionType = "Available"
+	// AlertmanagerReconciled indicates whether the operator has reconciled
+	// the state of the underlying resources with the resource's spec.
+	AlertmanagerReconciled AlertmanagerConditionType = "Reconciled"
+)
+
+// ConditionStatus is the status of a condition.
+type ConditionStatus string
+
+const (
+	ConditionTrue     ConditionStatus = "True"
+	ConditionDegraded ConditionStatus = "Degraded"
+	ConditionFalse    ConditionStatus = "False"
+	ConditionUnknown  ConditionStatus = "Unknown"
+)
+
+// AlertmanagerCondition describes the state of an Alertmanager resource at a
+// certain point.
+type AlertmanagerCondition struct {
+	// Type of the condition.
+	// +kubebuilder:validation:MinLength=1
+	Type AlertmanagerConditionType `json:"type"`
+	// Status of the condition, one of `True`, `Degraded`, `False` or `Unknown`.
+	// +kubebuilder:validation:Enum=True;Degraded;False;Unknown
+	Status ConditionStatus `json:"status"`
+	// The generation of the resource that the condition was computed from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Machine-readable reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about the last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // NamespaceSelector is a selector for selecting either all namespaces or a
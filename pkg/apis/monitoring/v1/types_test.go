@@ -235,3 +235,108 @@ func TestValidateTLSConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSafeAuthorization(t *testing.T) {
+	for _, tc := range []struct {
+		config *SafeAuthorization
+		err    bool
+	}{
+		{
+			// Default type.
+			config: &SafeAuthorization{
+				Credentials: &v1.SecretKeySelector{},
+			},
+			err: false,
+		},
+		{
+			// Custom type.
+			config: &SafeAuthorization{
+				Type:        "Bearer",
+				Credentials: &v1.SecretKeySelector{},
+			},
+			err: false,
+		},
+		{
+			// Basic type.
+			config: &SafeAuthorization{
+				Type:        " Basic",
+				Credentials: &v1.SecretKeySelector{},
+			},
+			err: true,
+		},
+		{
+			// Without credentials.
+			config: &SafeAuthorization{},
+			err:    true,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.err && err == nil {
+				t.Fatalf("expected validation of %+v to fail, but got no error", tc.config)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("expected validation of %+v not to fail, err: %s", tc.config, err)
+			}
+		})
+	}
+}
+
+func TestValidateAuthorization(t *testing.T) {
+	for _, tc := range []struct {
+		config *Authorization
+		err    bool
+	}{
+		{
+			// Credentials from a secret.
+			config: &Authorization{
+				SafeAuthorization: SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{},
+				},
+			},
+			err: false,
+		},
+		{
+			// Credentials from a file.
+			config: &Authorization{
+				CredentialsFile: "/etc/token",
+			},
+			err: false,
+		},
+		{
+			// Both credentials and credentials file.
+			config: &Authorization{
+				SafeAuthorization: SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{},
+				},
+				CredentialsFile: "/etc/token",
+			},
+			err: true,
+		},
+		{
+			// Basic type.
+			config: &Authorization{
+				SafeAuthorization: SafeAuthorization{
+					Type: "basic",
+				},
+				CredentialsFile: "/etc/token",
+			},
+			err: true,
+		},
+		{
+			// Without credentials.
+			config: &Authorization{},
+			err:    true,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.err && err == nil {
+				t.Fatalf("expected validation of %+v to fail, but got no error", tc.config)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("expected validation of %+v not to fail, err: %s", tc.config, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,1031 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// ConvertTo converts from this version (v1alpha1) to the v1beta1 version.
+func (src *AlertmanagerConfig) ConvertTo(dst *v1beta1.AlertmanagerConfig) error {
+	dst.ObjectMeta = src.ObjectMeta
+	dst.TypeMeta = src.TypeMeta
+	dst.APIVersion = v1beta1.SchemeGroupVersion.String()
+
+	r, err := convertRouteTo(src.Spec.Route)
+	if err != nil {
+		return err
+	}
+	dst.Spec.Route = r
+
+	dst.Spec.Receivers = nil
+	for _, in := range src.Spec.Receivers {
+		dst.Spec.Receivers = append(dst.Spec.Receivers, convertReceiverTo(in))
+	}
+
+	dst.Spec.InhibitRules = nil
+	for _, in := range src.Spec.InhibitRules {
+		dst.Spec.InhibitRules = append(dst.Spec.InhibitRules, v1beta1.InhibitRule{
+			TargetMatch: convertMatchersTo(in.TargetMatch),
+			SourceMatch: convertMatchersTo(in.SourceMatch),
+			Equal:       in.Equal,
+		})
+	}
+
+	dst.Spec.MuteTimeIntervals = nil
+	for _, in := range src.Spec.MuteTimeIntervals {
+		dst.Spec.MuteTimeIntervals = append(dst.Spec.MuteTimeIntervals, convertMuteTimeIntervalTo(in))
+	}
+
+	dst.Spec.Templates = src.Spec.Templates
+
+	dst.Status = convertStatusTo(src.Status)
+
+	return nil
+}
+
+// ConvertFrom converts from the v1beta1 version to this version (v1alpha1).
+func (dst *AlertmanagerConfig) ConvertFrom(src *v1beta1.AlertmanagerConfig) error {
+	dst.ObjectMeta = src.ObjectMeta
+	dst.TypeMeta = src.TypeMeta
+	dst.APIVersion = SchemeGroupVersion.String()
+
+	r, err := convertRouteFrom(src.Spec.Route)
+	if err != nil {
+		return err
+	}
+	dst.Spec.Route = r
+
+	dst.Spec.Receivers = nil
+	for _, in := range src.Spec.Receivers {
+		dst.Spec.Receivers = append(dst.Spec.Receivers, convertReceiverFrom(in))
+	}
+
+	dst.Spec.InhibitRules = nil
+	for _, in := range src.Spec.InhibitRules {
+		dst.Spec.InhibitRules = append(dst.Spec.InhibitRules, InhibitRule{
+			TargetMatch: convertMatchersFrom(in.TargetMatch),
+			SourceMatch: convertMatchersFrom(in.SourceMatch),
+			Equal:       in.Equal,
+		})
+	}
+
+	dst.Spec.MuteTimeIntervals = nil
+	for _, in := range src.Spec.MuteTimeIntervals {
+		dst.Spec.MuteTimeIntervals = append(dst.Spec.MuteTimeIntervals, convertMuteTimeIntervalFrom(in))
+	}
+
+	dst.Spec.Templates = src.Spec.Templates
+
+	dst.Status = convertStatusFrom(src.Status)
+
+	return nil
+}
+
+func convertStatusTo(in *AlertmanagerConfigStatus) *v1beta1.AlertmanagerConfigStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := &v1beta1.AlertmanagerConfigStatus{}
+	for _, c := range in.Conditions {
+		out.Conditions = append(out.Conditions, v1beta1.AlertmanagerConfigCondition{
+			Type:               v1beta1.AlertmanagerConfigConditionType(c.Type),
+			Status:             v1beta1.ConditionStatus(c.Status),
+			ObservedGeneration: c.ObservedGeneration,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+
+	return out
+}
+
+func convertStatusFrom(in *v1beta1.AlertmanagerConfigStatus) *AlertmanagerConfigStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := &AlertmanagerConfigStatus{}
+	for _, c := range in.Conditions {
+		out.Conditions = append(out.Conditions, AlertmanagerConfigCondition{
+			Type:               AlertmanagerConfigConditionType(c.Type),
+			Status:             ConditionStatus(c.Status),
+			ObservedGeneration: c.ObservedGeneration,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+
+	return out
+}
+
+func convertRouteTo(in *Route) (*v1beta1.Route, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &v1beta1.Route{
+		Receiver:            in.Receiver,
+		GroupBy:             in.GroupBy,
+		GroupWait:           in.GroupWait,
+		GroupInterval:       in.GroupInterval,
+		RepeatInterval:      in.RepeatInterval,
+		Matchers:            convertMatchersTo(in.Matchers),
+		Continue:            in.Continue,
+		MuteTimeIntervals:   in.MuteTimeIntervals,
+		ActiveTimeIntervals: in.ActiveTimeIntervals,
+	}
+
+	children, err := in.ChildRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range children {
+		child, err := convertRouteTo(&children[i])
+		if err != nil {
+			return nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		b, err := json.Marshal(child)
+		if err != nil {
+			return nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		out.Routes = append(out.Routes, apiextensionsv1.JSON{Raw: b})
+	}
+
+	return out, nil
+}
+
+func convertRouteFrom(in *v1beta1.Route) (*Route, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &Route{
+		Receiver:            in.Receiver,
+		GroupBy:             in.GroupBy,
+		GroupWait:           in.GroupWait,
+		GroupInterval:       in.GroupInterval,
+		RepeatInterval:      in.RepeatInterval,
+		Matchers:            convertMatchersFrom(in.Matchers),
+		Continue:            in.Continue,
+		MuteTimeIntervals:   in.MuteTimeIntervals,
+		ActiveTimeIntervals: in.ActiveTimeIntervals,
+	}
+
+	children, err := in.ChildRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range children {
+		child, err := convertRouteFrom(&children[i])
+		if err != nil {
+			return nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		b, err := json.Marshal(child)
+		if err != nil {
+			return nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		out.Routes = append(out.Routes, apiextensionsv1.JSON{Raw: b})
+	}
+
+	return out, nil
+}
+
+// convertMatchersTo converts the matchers to the v1beta1 version. The
+// deprecated regex field is translated into the equivalent match type.
+func convertMatchersTo(in []Matcher) []v1beta1.Matcher {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1beta1.Matcher, 0, len(in))
+	for _, m := range in {
+		out = append(out, v1beta1.Matcher{
+			Name:      m.Name,
+			Value:     m.Value,
+			MatchType: v1beta1.MatchType(m.Type()),
+		})
+	}
+
+	return out
+}
+
+func convertMatchersFrom(in []v1beta1.Matcher) []Matcher {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]Matcher, 0, len(in))
+	for _, m := range in {
+		out = append(out, Matcher{
+			Name:      m.Name,
+			Value:     m.Value,
+			MatchType: MatchType(m.Type()),
+		})
+	}
+
+	return out
+}
+
+func convertMuteTimeIntervalTo(in MuteTimeInterval) v1beta1.MuteTimeInterval {
+	out := v1beta1.MuteTimeInterval{Name: in.Name}
+
+	for _, ti := range in.TimeIntervals {
+		var tiOut v1beta1.TimeInterval
+
+		for _, t := range ti.Times {
+			tiOut.Times = append(tiOut.Times, v1beta1.TimeRange{
+				StartTime: v1beta1.Time(t.StartTime),
+				EndTime:   v1beta1.Time(t.EndTime),
+			})
+		}
+
+		for _, wd := range ti.Weekdays {
+			tiOut.Weekdays = append(tiOut.Weekdays, v1beta1.WeekdayRange(wd))
+		}
+
+		for _, dm := range ti.DaysOfMonth {
+			tiOut.DaysOfMonth = append(tiOut.DaysOfMonth, v1beta1.DayOfMonthRange(dm))
+		}
+
+		for _, m := range ti.Months {
+			tiOut.Months = append(tiOut.Months, v1beta1.MonthRange(m))
+		}
+
+		for _, y := range ti.Years {
+			tiOut.Years = append(tiOut.Years, v1beta1.YearRange(y))
+		}
+
+		out.TimeIntervals = append(out.TimeIntervals, tiOut)
+	}
+
+	return out
+}
+
+func convertMuteTimeIntervalFrom(in v1beta1.MuteTimeInterval) MuteTimeInterval {
+	out := MuteTimeInterval{Name: in.Name}
+
+	for _, ti := range in.TimeIntervals {
+		var tiOut TimeInterval
+
+		for _, t := range ti.Times {
+			tiOut.Times = append(tiOut.Times, TimeRange{
+				StartTime: Time(t.StartTime),
+				EndTime:   Time(t.EndTime),
+			})
+		}
+
+		for _, wd := range ti.Weekdays {
+			tiOut.Weekdays = append(tiOut.Weekdays, WeekdayRange(wd))
+		}
+
+		for _, dm := range ti.DaysOfMonth {
+			tiOut.DaysOfMonth = append(tiOut.DaysOfMonth, DayOfMonthRange(dm))
+		}
+
+		for _, m := range ti.Months {
+			tiOut.Months = append(tiOut.Months, MonthRange(m))
+		}
+
+		for _, y := range ti.Years {
+			tiOut.Years = append(tiOut.Years, YearRange(y))
+		}
+
+		out.TimeIntervals = append(out.TimeIntervals, tiOut)
+	}
+
+	return out
+}
+
+func convertSecretKeySelectorTo(in v1.SecretKeySelector) v1beta1.SecretKeySelector {
+	return v1beta1.SecretKeySelector{
+		Name: in.Name,
+		Key:  in.Key,
+	}
+}
+
+func convertSecretKeySelectorFrom(in v1beta1.SecretKeySelector) v1.SecretKeySelector {
+	return v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{
+			Name: in.Name,
+		},
+		Key: in.Key,
+	}
+}
+
+func convertOptionalSecretKeySelectorTo(in *v1.SecretKeySelector) *v1beta1.SecretKeySelector {
+	if in == nil {
+		return nil
+	}
+
+	out := convertSecretKeySelectorTo(*in)
+	return &out
+}
+
+func convertOptionalSecretKeySelectorFrom(in *v1beta1.SecretKeySelector) *v1.SecretKeySelector {
+	if in == nil {
+		return nil
+	}
+
+	out := convertSecretKeySelectorFrom(*in)
+	return &out
+}
+
+func convertHTTPConfigTo(in *HTTPConfig) *v1beta1.HTTPConfig {
+	if in == nil {
+		return nil
+	}
+
+	return &v1beta1.HTTPConfig{
+		BasicAuth:         in.BasicAuth,
+		BearerTokenSecret: convertOptionalSecretKeySelectorTo(in.BearerTokenSecret),
+		TLSConfig:         in.TLSConfig,
+		ProxyURL:          in.ProxyURL,
+		FollowRedirects:   in.FollowRedirects,
+		OAuth2:            in.OAuth2,
+	}
+}
+
+func convertHTTPConfigFrom(in *v1beta1.HTTPConfig) *HTTPConfig {
+	if in == nil {
+		return nil
+	}
+
+	return &HTTPConfig{
+		BasicAuth:         in.BasicAuth,
+		BearerTokenSecret: convertOptionalSecretKeySelectorFrom(in.BearerTokenSecret),
+		TLSConfig:         in.TLSConfig,
+		ProxyURL:          in.ProxyURL,
+		FollowRedirects:   in.FollowRedirects,
+		OAuth2:            in.OAuth2,
+	}
+}
+
+func convertKeyValuesTo(in []KeyValue) []v1beta1.KeyValue {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1beta1.KeyValue, 0, len(in))
+	for _, kv := range in {
+		out = append(out, v1beta1.KeyValue(kv))
+	}
+
+	return out
+}
+
+func convertKeyValuesFrom(in []v1beta1.KeyValue) []KeyValue {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]KeyValue, 0, len(in))
+	for _, kv := range in {
+		out = append(out, KeyValue(kv))
+	}
+
+	return out
+}
+
+func convertReceiverTo(in Receiver) v1beta1.Receiver {
+	out := v1beta1.Receiver{Name: in.Name, SecretNamespace: in.SecretNamespace}
+
+	for _, c := range in.OpsGenieConfigs {
+		out.OpsGenieConfigs = append(out.OpsGenieConfigs, convertOpsGenieConfigTo(c))
+	}
+
+	for _, c := range in.PagerDutyConfigs {
+		out.PagerDutyConfigs = append(out.PagerDutyConfigs, convertPagerDutyConfigTo(c))
+	}
+
+	for _, c := range in.SlackConfigs {
+		out.SlackConfigs = append(out.SlackConfigs, convertSlackConfigTo(c))
+	}
+
+	for _, c := range in.WebhookConfigs {
+		out.WebhookConfigs = append(out.WebhookConfigs, v1beta1.WebhookConfig{
+			SendResolved: c.SendResolved,
+			URL:          c.URL,
+			URLSecret:    convertOptionalSecretKeySelectorTo(c.URLSecret),
+			HTTPConfig:   convertHTTPConfigTo(c.HTTPConfig),
+			MaxAlerts:    c.MaxAlerts,
+			Timeout:      c.Timeout,
+		})
+	}
+
+	for _, c := range in.WeChatConfigs {
+		out.WeChatConfigs = append(out.WeChatConfigs, v1beta1.WeChatConfig{
+			SendResolved: c.SendResolved,
+			APISecret:    convertOptionalSecretKeySelectorTo(c.APISecret),
+			APIURL:       c.APIURL,
+			CorpID:       c.CorpID,
+			AgentID:      c.AgentID,
+			ToUser:       c.ToUser,
+			ToParty:      c.ToParty,
+			ToTag:        c.ToTag,
+			Message:      c.Message,
+			MessageType:  c.MessageType,
+			HTTPConfig:   convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.EmailConfigs {
+		out.EmailConfigs = append(out.EmailConfigs, v1beta1.EmailConfig{
+			SendResolved: c.SendResolved,
+			To:           c.To,
+			From:         c.From,
+			Hello:        c.Hello,
+			Smarthost:    c.Smarthost,
+			AuthUsername: c.AuthUsername,
+			AuthPassword: convertOptionalSecretKeySelectorTo(c.AuthPassword),
+			AuthSecret:   convertOptionalSecretKeySelectorTo(c.AuthSecret),
+			AuthIdentity: c.AuthIdentity,
+			Headers:      convertKeyValuesTo(c.Headers),
+			HTML:         c.HTML,
+			Text:         c.Text,
+			RequireTLS:   c.RequireTLS,
+			TLSConfig:    c.TLSConfig,
+		})
+	}
+
+	for _, c := range in.VictorOpsConfigs {
+		out.VictorOpsConfigs = append(out.VictorOpsConfigs, v1beta1.VictorOpsConfig{
+			SendResolved:      c.SendResolved,
+			APIKey:            convertOptionalSecretKeySelectorTo(c.APIKey),
+			APIURL:            c.APIURL,
+			RoutingKey:        c.RoutingKey,
+			MessageType:       c.MessageType,
+			EntityDisplayName: c.EntityDisplayName,
+			StateMessage:      c.StateMessage,
+			MonitoringTool:    c.MonitoringTool,
+			CustomFields:      convertKeyValuesTo(c.CustomFields),
+			HTTPConfig:        convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.PushoverConfigs {
+		out.PushoverConfigs = append(out.PushoverConfigs, v1beta1.PushoverConfig{
+			SendResolved: c.SendResolved,
+			UserKey:      convertOptionalSecretKeySelectorTo(c.UserKey),
+			Token:        convertOptionalSecretKeySelectorTo(c.Token),
+			Title:        c.Title,
+			Message:      c.Message,
+			URL:          c.URL,
+			URLTitle:     c.URLTitle,
+			Sound:        c.Sound,
+			Priority:     c.Priority,
+			Retry:        c.Retry,
+			Expire:       c.Expire,
+			HTML:         c.HTML,
+			HTTPConfig:   convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.SNSConfigs {
+		out.SNSConfigs = append(out.SNSConfigs, v1beta1.SNSConfig{
+			SendResolved: c.SendResolved,
+			APIURL:       c.APIURL,
+			Sigv4:        c.Sigv4,
+			TopicARN:     c.TopicARN,
+			Subject:      c.Subject,
+			PhoneNumber:  c.PhoneNumber,
+			TargetARN:    c.TargetARN,
+			Message:      c.Message,
+			Attributes:   c.Attributes,
+			HTTPConfig:   convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.TelegramConfigs {
+		out.TelegramConfigs = append(out.TelegramConfigs, v1beta1.TelegramConfig{
+			SendResolved:         c.SendResolved,
+			APIURL:               c.APIURL,
+			BotToken:             convertOptionalSecretKeySelectorTo(c.BotToken),
+			ChatID:               c.ChatID,
+			Message:              c.Message,
+			DisableNotifications: c.DisableNotifications,
+			ParseMode:            c.ParseMode,
+			HTTPConfig:           convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.DiscordConfigs {
+		out.DiscordConfigs = append(out.DiscordConfigs, v1beta1.DiscordConfig{
+			SendResolved: c.SendResolved,
+			APIURL:       convertSecretKeySelectorTo(c.APIURL),
+			Title:        c.Title,
+			Message:      c.Message,
+			HTTPConfig:   convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.MSTeamsConfigs {
+		out.MSTeamsConfigs = append(out.MSTeamsConfigs, v1beta1.MSTeamsConfig{
+			SendResolved: c.SendResolved,
+			WebhookURL:   convertSecretKeySelectorTo(c.WebhookURL),
+			Title:        c.Title,
+			Summary:      c.Summary,
+			Text:         c.Text,
+			HTTPConfig:   convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.MSTeamsV2Configs {
+		out.MSTeamsV2Configs = append(out.MSTeamsV2Configs, v1beta1.MSTeamsV2Config{
+			SendResolved: c.SendResolved,
+			WebhookURL:   convertSecretKeySelectorTo(c.WebhookURL),
+			Title:        c.Title,
+			Text:         c.Text,
+			HTTPConfig:   convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.RocketChatConfigs {
+		out.RocketChatConfigs = append(out.RocketChatConfigs, convertRocketChatConfigTo(c))
+	}
+
+	for _, c := range in.JiraConfigs {
+		out.JiraConfigs = append(out.JiraConfigs, v1beta1.JiraConfig{
+			SendResolved:      c.SendResolved,
+			APIURL:            c.APIURL,
+			Project:           c.Project,
+			IssueType:         c.IssueType,
+			Summary:           c.Summary,
+			Description:       c.Description,
+			Priority:          c.Priority,
+			Labels:            c.Labels,
+			ResolveTransition: c.ResolveTransition,
+			ReopenTransition:  c.ReopenTransition,
+			WontFixResolution: c.WontFixResolution,
+			ReopenDuration:    c.ReopenDuration,
+			HTTPConfig:        convertHTTPConfigTo(c.HTTPConfig),
+		})
+	}
+
+	return out
+}
+
+func convertReceiverFrom(in v1beta1.Receiver) Receiver {
+	out := Receiver{Name: in.Name, SecretNamespace: in.SecretNamespace}
+
+	for _, c := range in.OpsGenieConfigs {
+		out.OpsGenieConfigs = append(out.OpsGenieConfigs, convertOpsGenieConfigFrom(c))
+	}
+
+	for _, c := range in.PagerDutyConfigs {
+		out.PagerDutyConfigs = append(out.PagerDutyConfigs, convertPagerDutyConfigFrom(c))
+	}
+
+	for _, c := range in.SlackConfigs {
+		out.SlackConfigs = append(out.SlackConfigs, convertSlackConfigFrom(c))
+	}
+
+	for _, c := range in.WebhookConfigs {
+		out.WebhookConfigs = append(out.WebhookConfigs, WebhookConfig{
+			SendResolved: c.SendResolved,
+			URL:          c.URL,
+			URLSecret:    convertOptionalSecretKeySelectorFrom(c.URLSecret),
+			HTTPConfig:   convertHTTPConfigFrom(c.HTTPConfig),
+			MaxAlerts:    c.MaxAlerts,
+			Timeout:      c.Timeout,
+		})
+	}
+
+	for _, c := range in.WeChatConfigs {
+		out.WeChatConfigs = append(out.WeChatConfigs, WeChatConfig{
+			SendResolved: c.SendResolved,
+			APISecret:    convertOptionalSecretKeySelectorFrom(c.APISecret),
+			APIURL:       c.APIURL,
+			CorpID:       c.CorpID,
+			AgentID:      c.AgentID,
+			ToUser:       c.ToUser,
+			ToParty:      c.ToParty,
+			ToTag:        c.ToTag,
+			Message:      c.Message,
+			MessageType:  c.MessageType,
+			HTTPConfig:   convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.EmailConfigs {
+		out.EmailConfigs = append(out.EmailConfigs, EmailConfig{
+			SendResolved: c.SendResolved,
+			To:           c.To,
+			From:         c.From,
+			Hello:        c.Hello,
+			Smarthost:    c.Smarthost,
+			AuthUsername: c.AuthUsername,
+			AuthPassword: convertOptionalSecretKeySelectorFrom(c.AuthPassword),
+			AuthSecret:   convertOptionalSecretKeySelectorFrom(c.AuthSecret),
+			AuthIdentity: c.AuthIdentity,
+			Headers:      convertKeyValuesFrom(c.Headers),
+			HTML:         c.HTML,
+			Text:         c.Text,
+			RequireTLS:   c.RequireTLS,
+			TLSConfig:    c.TLSConfig,
+		})
+	}
+
+	for _, c := range in.VictorOpsConfigs {
+		out.VictorOpsConfigs = append(out.VictorOpsConfigs, VictorOpsConfig{
+			SendResolved:      c.SendResolved,
+			APIKey:            convertOptionalSecretKeySelectorFrom(c.APIKey),
+			APIURL:            c.APIURL,
+			RoutingKey:        c.RoutingKey,
+			MessageType:       c.MessageType,
+			EntityDisplayName: c.EntityDisplayName,
+			StateMessage:      c.StateMessage,
+			MonitoringTool:    c.MonitoringTool,
+			CustomFields:      convertKeyValuesFrom(c.CustomFields),
+			HTTPConfig:        convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.PushoverConfigs {
+		out.PushoverConfigs = append(out.PushoverConfigs, PushoverConfig{
+			SendResolved: c.SendResolved,
+			UserKey:      convertOptionalSecretKeySelectorFrom(c.UserKey),
+			Token:        convertOptionalSecretKeySelectorFrom(c.Token),
+			Title:        c.Title,
+			Message:      c.Message,
+			URL:          c.URL,
+			URLTitle:     c.URLTitle,
+			Sound:        c.Sound,
+			Priority:     c.Priority,
+			Retry:        c.Retry,
+			Expire:       c.Expire,
+			HTML:         c.HTML,
+			HTTPConfig:   convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.SNSConfigs {
+		out.SNSConfigs = append(out.SNSConfigs, SNSConfig{
+			SendResolved: c.SendResolved,
+			APIURL:       c.APIURL,
+			Sigv4:        c.Sigv4,
+			TopicARN:     c.TopicARN,
+			Subject:      c.Subject,
+			PhoneNumber:  c.PhoneNumber,
+			TargetARN:    c.TargetARN,
+			Message:      c.Message,
+			Attributes:   c.Attributes,
+			HTTPConfig:   convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.TelegramConfigs {
+		out.TelegramConfigs = append(out.TelegramConfigs, TelegramConfig{
+			SendResolved:         c.SendResolved,
+			APIURL:               c.APIURL,
+			BotToken:             convertOptionalSecretKeySelectorFrom(c.BotToken),
+			ChatID:               c.ChatID,
+			Message:              c.Message,
+			DisableNotifications: c.DisableNotifications,
+			ParseMode:            c.ParseMode,
+			HTTPConfig:           convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.DiscordConfigs {
+		out.DiscordConfigs = append(out.DiscordConfigs, DiscordConfig{
+			SendResolved: c.SendResolved,
+			APIURL:       convertSecretKeySelectorFrom(c.APIURL),
+			Title:        c.Title,
+			Message:      c.Message,
+			HTTPConfig:   convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.MSTeamsConfigs {
+		out.MSTeamsConfigs = append(out.MSTeamsConfigs, MSTeamsConfig{
+			SendResolved: c.SendResolved,
+			WebhookURL:   convertSecretKeySelectorFrom(c.WebhookURL),
+			Title:        c.Title,
+			Summary:      c.Summary,
+			Text:         c.Text,
+			HTTPConfig:   convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.MSTeamsV2Configs {
+		out.MSTeamsV2Configs = append(out.MSTeamsV2Configs, MSTeamsV2Config{
+			SendResolved: c.SendResolved,
+			WebhookURL:   convertSecretKeySelectorFrom(c.WebhookURL),
+			Title:        c.Title,
+			Text:         c.Text,
+			HTTPConfig:   convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	for _, c := range in.RocketChatConfigs {
+		out.RocketChatConfigs = append(out.RocketChatConfigs, convertRocketChatConfigFrom(c))
+	}
+
+	for _, c := range in.JiraConfigs {
+		out.JiraConfigs = append(out.JiraConfigs, JiraConfig{
+			SendResolved:      c.SendResolved,
+			APIURL:            c.APIURL,
+			Project:           c.Project,
+			IssueType:         c.IssueType,
+			Summary:           c.Summary,
+			Description:       c.Description,
+			Priority:          c.Priority,
+			Labels:            c.Labels,
+			ResolveTransition: c.ResolveTransition,
+			ReopenTransition:  c.ReopenTransition,
+			WontFixResolution: c.WontFixResolution,
+			ReopenDuration:    c.ReopenDuration,
+			HTTPConfig:        convertHTTPConfigFrom(c.HTTPConfig),
+		})
+	}
+
+	return out
+}
+
+func convertOpsGenieConfigTo(in OpsGenieConfig) v1beta1.OpsGenieConfig {
+	out := v1beta1.OpsGenieConfig{
+		SendResolved: in.SendResolved,
+		APIKey:       convertOptionalSecretKeySelectorTo(in.APIKey),
+		APIURL:       in.APIURL,
+		Message:      in.Message,
+		Description:  in.Description,
+		Source:       in.Source,
+		Tags:         in.Tags,
+		Note:         in.Note,
+		Priority:     in.Priority,
+		Details:      convertKeyValuesTo(in.Details),
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+
+	for _, r := range in.Responders {
+		out.Responders = append(out.Responders, v1beta1.OpsGenieConfigResponder(r))
+	}
+
+	return out
+}
+
+func convertOpsGenieConfigFrom(in v1beta1.OpsGenieConfig) OpsGenieConfig {
+	out := OpsGenieConfig{
+		SendResolved: in.SendResolved,
+		APIKey:       convertOptionalSecretKeySelectorFrom(in.APIKey),
+		APIURL:       in.APIURL,
+		Message:      in.Message,
+		Description:  in.Description,
+		Source:       in.Source,
+		Tags:         in.Tags,
+		Note:         in.Note,
+		Priority:     in.Priority,
+		Details:      convertKeyValuesFrom(in.Details),
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+
+	for _, r := range in.Responders {
+		out.Responders = append(out.Responders, OpsGenieConfigResponder(r))
+	}
+
+	return out
+}
+
+func convertPagerDutyConfigTo(in PagerDutyConfig) v1beta1.PagerDutyConfig {
+	out := v1beta1.PagerDutyConfig{
+		SendResolved: in.SendResolved,
+		RoutingKey:   convertOptionalSecretKeySelectorTo(in.RoutingKey),
+		ServiceKey:   convertOptionalSecretKeySelectorTo(in.ServiceKey),
+		URL:          in.URL,
+		Client:       in.Client,
+		ClientURL:    in.ClientURL,
+		Description:  in.Description,
+		Severity:     in.Severity,
+		Class:        in.Class,
+		Group:        in.Group,
+		Component:    in.Component,
+		Details:      convertKeyValuesTo(in.Details),
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+
+	for _, c := range in.PagerDutyImageConfigs {
+		out.PagerDutyImageConfigs = append(out.PagerDutyImageConfigs, v1beta1.PagerDutyImageConfig(c))
+	}
+
+	for _, c := range in.PagerDutyLinkConfigs {
+		out.PagerDutyLinkConfigs = append(out.PagerDutyLinkConfigs, v1beta1.PagerDutyLinkConfig(c))
+	}
+
+	return out
+}
+
+func convertPagerDutyConfigFrom(in v1beta1.PagerDutyConfig) PagerDutyConfig {
+	out := PagerDutyConfig{
+		SendResolved: in.SendResolved,
+		RoutingKey:   convertOptionalSecretKeySelectorFrom(in.RoutingKey),
+		ServiceKey:   convertOptionalSecretKeySelectorFrom(in.ServiceKey),
+		URL:          in.URL,
+		Client:       in.Client,
+		ClientURL:    in.ClientURL,
+		Description:  in.Description,
+		Severity:     in.Severity,
+		Class:        in.Class,
+		Group:        in.Group,
+		Component:    in.Component,
+		Details:      convertKeyValuesFrom(in.Details),
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+
+	for _, c := range in.PagerDutyImageConfigs {
+		out.PagerDutyImageConfigs = append(out.PagerDutyImageConfigs, PagerDutyImageConfig(c))
+	}
+
+	for _, c := range in.PagerDutyLinkConfigs {
+		out.PagerDutyLinkConfigs = append(out.PagerDutyLinkConfigs, PagerDutyLinkConfig(c))
+	}
+
+	return out
+}
+
+func convertSlackConfigTo(in SlackConfig) v1beta1.SlackConfig {
+	out := v1beta1.SlackConfig{
+		SendResolved: in.SendResolved,
+		APIURL:       convertOptionalSecretKeySelectorTo(in.APIURL),
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Color:        in.Color,
+		Title:        in.Title,
+		TitleLink:    in.TitleLink,
+		Pretext:      in.Pretext,
+		Text:         in.Text,
+		ShortFields:  in.ShortFields,
+		Footer:       in.Footer,
+		Fallback:     in.Fallback,
+		CallbackID:   in.CallbackID,
+		IconEmoji:    in.IconEmoji,
+		IconURL:      in.IconURL,
+		ImageURL:     in.ImageURL,
+		ThumbURL:     in.ThumbURL,
+		LinkNames:    in.LinkNames,
+		MrkdwnIn:     in.MrkdwnIn,
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, v1beta1.SlackField(f))
+	}
+
+	for _, a := range in.Actions {
+		action := v1beta1.SlackAction{
+			Type:  a.Type,
+			Text:  a.Text,
+			URL:   a.URL,
+			Style: a.Style,
+			Name:  a.Name,
+			Value: a.Value,
+		}
+
+		if a.ConfirmField != nil {
+			cf := v1beta1.SlackConfirmationField(*a.ConfirmField)
+			action.ConfirmField = &cf
+		}
+
+		out.Actions = append(out.Actions, action)
+	}
+
+	return out
+}
+
+func convertSlackConfigFrom(in v1beta1.SlackConfig) SlackConfig {
+	out := SlackConfig{
+		SendResolved: in.SendResolved,
+		APIURL:       convertOptionalSecretKeySelectorFrom(in.APIURL),
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Color:        in.Color,
+		Title:        in.Title,
+		TitleLink:    in.TitleLink,
+		Pretext:      in.Pretext,
+		Text:         in.Text,
+		ShortFields:  in.ShortFields,
+		Footer:       in.Footer,
+		Fallback:     in.Fallback,
+		CallbackID:   in.CallbackID,
+		IconEmoji:    in.IconEmoji,
+		IconURL:      in.IconURL,
+		ImageURL:     in.ImageURL,
+		ThumbURL:     in.ThumbURL,
+		LinkNames:    in.LinkNames,
+		MrkdwnIn:     in.MrkdwnIn,
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, SlackField(f))
+	}
+
+	for _, a := range in.Actions {
+		action := SlackAction{
+			Type:  a.Type,
+			Text:  a.Text,
+			URL:   a.URL,
+			Style: a.Style,
+			Name:  a.Name,
+			Value: a.Value,
+		}
+
+		if a.ConfirmField != nil {
+			cf := SlackConfirmationField(*a.ConfirmField)
+			action.ConfirmField = &cf
+		}
+
+		out.Actions = append(out.Actions, action)
+	}
+
+	return out
+}
+
+func convertRocketChatConfigTo(in RocketChatConfig) v1beta1.RocketChatConfig {
+	out := v1beta1.RocketChatConfig{
+		SendResolved: in.SendResolved,
+		APIURL:       convertOptionalSecretKeySelectorTo(in.APIURL),
+		Token:        convertSecretKeySelectorTo(in.Token),
+		TokenID:      convertSecretKeySelectorTo(in.TokenID),
+		Channel:      in.Channel,
+		Color:        in.Color,
+		Emoji:        in.Emoji,
+		IconURL:      in.IconURL,
+		Title:        in.Title,
+		TitleLink:    in.TitleLink,
+		Text:         in.Text,
+		ShortFields:  in.ShortFields,
+		ImageURL:     in.ImageURL,
+		ThumbURL:     in.ThumbURL,
+		LinkNames:    in.LinkNames,
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, v1beta1.RocketChatFieldConfig(f))
+	}
+
+	for _, a := range in.Actions {
+		out.Actions = append(out.Actions, v1beta1.RocketChatActionConfig(a))
+	}
+
+	return out
+}
+
+func convertRocketChatConfigFrom(in v1beta1.RocketChatConfig) RocketChatConfig {
+	out := RocketChatConfig{
+		SendResolved: in.SendResolved,
+		APIURL:       convertOptionalSecretKeySelectorFrom(in.APIURL),
+		Token:        convertSecretKeySelectorFrom(in.Token),
+		TokenID:      convertSecretKeySelectorFrom(in.TokenID),
+		Channel:      in.Channel,
+		Color:        in.Color,
+		Emoji:        in.Emoji,
+		IconURL:      in.IconURL,
+		Title:        in.Title,
+		TitleLink:    in.TitleLink,
+		Text:         in.Text,
+		ShortFields:  in.ShortFields,
+		ImageURL:     in.ImageURL,
+		ThumbURL:     in.ThumbURL,
+		LinkNames:    in.LinkNames,
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, RocketChatFieldConfig(f))
+	}
+
+	for _, a := range in.Actions {
+		out.Actions = append(out.Actions, RocketChatActionConfig(a))
+	}
+
+	return out
+}
@@ -15,10 +15,13 @@
 package v1alpha1
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
@@ -34,6 +37,15 @@ const (
 	AlertmanagerConfigKind    = "AlertmanagerConfig"
 	AlertmanagerConfigName    = "alertmanagerconfigs"
 	AlertmanagerConfigKindKey = "alertmanagerconfig"
+
+	ClusterAlertmanagerConfigKind    = "ClusterAlertmanagerConfig"
+	ClusterAlertmanagerConfigName    = "clusteralertmanagerconfigs"
+	ClusterAlertmanagerConfigKindKey = "clusteralertmanagerconfig"
+
+	// AllowCrossNamespaceSecretsAnnotation is the namespace annotation
+	// allowing AlertmanagerConfig receivers from other namespaces to
+	// reference Secrets in this namespace when set to "true".
+	AllowCrossNamespaceSecretsAnnotation = "monitoring.coreos.com/allow-cross-namespace-secrets"
 )
 
 var (
@@ -44,11 +56,17 @@ var (
 // across multiple namespaces configuring one Alertmanager cluster.
 // +genclient
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
 type AlertmanagerConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec AlertmanagerConfigSpec `json:"spec"`
+	// Most recent observed status of the AlertmanagerConfig. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status *AlertmanagerConfigStatus `json:"status,omitempty"`
 }
 
 // AlertmanagerConfigList is a list of AlertmanagerConfig.
@@ -62,6 +80,94 @@ type AlertmanagerConfigList struct {
 	Items []*AlertmanagerConfig `json:"items"`
 }
 
+// ClusterAlertmanagerConfig defines a cluster-wide Alertmanager configuration
+// which is merged into the configuration of the Alertmanager clusters
+// selecting it. Contrary to AlertmanagerConfig, its routes and inhibition
+// rules aren't restricted to the alerts from a given namespace. The Secrets
+// and ConfigMaps which it references are read from the namespace of the
+// Alertmanager resource.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+type ClusterAlertmanagerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlertmanagerConfigSpec `json:"spec"`
+	// Most recent observed status of the ClusterAlertmanagerConfig. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status *AlertmanagerConfigStatus `json:"status,omitempty"`
+}
+
+// ClusterAlertmanagerConfigList is a list of ClusterAlertmanagerConfig.
+// +k8s:openapi-gen=true
+type ClusterAlertmanagerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ClusterAlertmanagerConfig
+	Items []*ClusterAlertmanagerConfig `json:"items"`
+}
+
+// AlertmanagerConfigStatus is the most recent observed status of the
+// AlertmanagerConfig resource.
+type AlertmanagerConfigStatus struct {
+	// The list of conditions reported by the operator. The `Accepted`
+	// condition tells whether the resource has been merged into the
+	// Alertmanager configuration.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []AlertmanagerConfigCondition `json:"conditions,omitempty"`
+}
+
+// AlertmanagerConfigConditionType is the type of a condition reported in the
+// AlertmanagerConfig status.
+type AlertmanagerConfigConditionType string
+
+const (
+	// AlertmanagerConfigAccepted indicates whether the resource has been
+	// validated and merged into the Alertmanager configuration.
+	AlertmanagerConfigAccepted AlertmanagerConfigConditionType = "Accepted"
+)
+
+// ConditionStatus is the status of a condition.
+type ConditionStatus string
+
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// AlertmanagerConfigCondition describes the state of an AlertmanagerConfig
+// resource at a certain point.
+type AlertmanagerConfigCondition struct {
+	// Type of the condition.
+	// +kubebuilder:validation:MinLength=1
+	Type AlertmanagerConfigConditionType `json:"type"`
+	// Status of the condition, one of `True`, `False` or `Unknown`.
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status ConditionStatus `json:"status"`
+	// The generation of the resource that the condition was computed from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Machine-readable reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about the last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // AlertmanagerConfigSpec is a specification of the desired behavior of the Alertmanager configuration.
 // By definition, the Alertmanager configuration only applies to alerts for which
 // the `namespace` label is equal to the namespace of the AlertmanagerConfig resource.
@@ -78,6 +184,17 @@ type AlertmanagerConfigSpec struct {
 	// the resource’s namespace.
 	// +optional
 	InhibitRules []InhibitRule `json:"inhibitRules,omitempty"`
+	// List of MuteTimeInterval specifying when the routes should be muted or
+	// active.
+	// It requires Alertmanager >= 0.22.0.
+	// +optional
+	MuteTimeIntervals []MuteTimeInterval `json:"muteTimeIntervals,omitempty"`
+	// List of notification templates. Each item references a key of a Secret
+	// or ConfigMap in the same namespace as the AlertmanagerConfig object.
+	// The templates are mounted into the Alertmanager pods and added to the
+	// `templates` list of the generated configuration.
+	// +optional
+	Templates []monitoringv1.SecretOrConfigMap `json:"templates,omitempty"`
 }
 
 // Route defines a node in the routing tree.
@@ -105,9 +222,8 @@ type Route struct {
 	// +optional
 	RepeatInterval string `json:"repeatInterval,omitempty"`
 	// List of matchers that the alert’s labels should match. For the first
-	// level route, the operator removes any existing equality and regexp
-	// matcher on the `namespace` label and adds a `namespace: <object
-	// namespace>` matcher.
+	// level route, the operator removes any existing matcher on the
+	// `namespace` label and adds a `namespace: <object namespace>` matcher.
 	// +optional
 	Matchers []Matcher `json:"matchers,omitempty"`
 	// Boolean indicating whether an alert should continue matching subsequent
@@ -124,14 +240,30 @@ type Route struct {
 	// an alternative type to circumvent the limitation. The downside is that
 	// the Kube API can't validate the data beyond the fact that it is a valid
 	// JSON representation.
+
+	// Names of the MuteTimeInterval objects that mute this route. The
+	// intervals must be defined in the same AlertmanagerConfig object.
+	// It requires Alertmanager >= 0.22.0.
+	// +optional
+	MuteTimeIntervals []string `json:"muteTimeIntervals,omitempty"`
+	// Names of the MuteTimeInterval objects during which this route is
+	// active. Outside of these intervals, notifications are muted. The
+	// intervals must be defined in the same AlertmanagerConfig object.
+	// It requires Alertmanager >= 0.24.0.
+	// +optional
+	ActiveTimeIntervals []string `json:"activeTimeIntervals,omitempty"`
 }
 
 // ChildRoutes extracts the child routes.
+// Because the Kube API can't validate the child routes, unknown fields are
+// rejected to catch typos at any level of the routing tree.
 func (r *Route) ChildRoutes() ([]Route, error) {
 	out := make([]Route, len(r.Routes))
 
 	for i, v := range r.Routes {
-		if err := json.Unmarshal(v.Raw, &out[i]); err != nil {
+		dec := json.NewDecoder(bytes.NewReader(v.Raw))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&out[i]); err != nil {
 			return nil, fmt.Errorf("route[%d]: %w", i, err)
 		}
 	}
@@ -144,6 +276,14 @@ type Receiver struct {
 	// Name of the receiver. Must be unique across all items from the list.
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
+	// Namespace of the Secrets referenced by the receiver's configurations.
+	// Defaults to the namespace of the AlertmanagerConfig resource.
+	// Referencing Secrets from another namespace requires either the
+	// operator to run with `--alertmanager-config-allow-cross-namespace-secrets`
+	// or the target namespace to have the
+	// `monitoring.coreos.com/allow-cross-namespace-secrets: "true"` annotation.
+	// +optional
+	SecretNamespace string `json:"secretNamespace,omitempty"`
 	// List of OpsGenie configurations.
 	OpsGenieConfigs []OpsGenieConfig `json:"opsgenieConfigs,omitempty"`
 	// List of PagerDuty configurations.
@@ -160,6 +300,24 @@ type Receiver struct {
 	VictorOpsConfigs []VictorOpsConfig `json:"victoropsConfigs,omitempty"`
 	// List of Pushover configurations.
 	PushoverConfigs []PushoverConfig `json:"pushoverConfigs,omitempty"`
+	// List of SNS configurations.
+	SNSConfigs []SNSConfig `json:"snsConfigs,omitempty"`
+	// List of Telegram configurations.
+	TelegramConfigs []TelegramConfig `json:"telegramConfigs,omitempty"`
+	// List of Discord configurations.
+	DiscordConfigs []DiscordConfig `json:"discordConfigs,omitempty"`
+	// List of MSTeams configurations.
+	// It requires Alertmanager >= 0.26.0.
+	MSTeamsConfigs []MSTeamsConfig `json:"msteamsConfigs,omitempty"`
+	// List of MSTeamsV2 configurations.
+	// It requires Alertmanager >= 0.28.0.
+	MSTeamsV2Configs []MSTeamsV2Config `json:"msteamsv2Configs,omitempty"`
+	// List of Rocket.Chat configurations.
+	// It requires Alertmanager >= 0.28.0.
+	RocketChatConfigs []RocketChatConfig `json:"rocketchatConfigs,omitempty"`
+	// List of Jira configurations.
+	// It requires Alertmanager >= 0.28.0.
+	JiraConfigs []JiraConfig `json:"jiraConfigs,omitempty"`
 }
 
 // PagerDutyConfig configures notifications via PagerDuty.
@@ -208,11 +366,40 @@ type PagerDutyConfig struct {
 	// Arbitrary key/value pairs that provide further detail about the incident.
 	// +optional
 	Details []KeyValue `json:"details,omitempty"`
+	// A list of image details to attach that provide further detail about an incident.
+	// +optional
+	PagerDutyImageConfigs []PagerDutyImageConfig `json:"pagerDutyImageConfigs,omitempty"`
+	// A list of link details to attach that provide further detail about an incident.
+	// +optional
+	PagerDutyLinkConfigs []PagerDutyLinkConfig `json:"pagerDutyLinkConfigs,omitempty"`
 	// HTTP client configuration.
 	// +optional
 	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
 }
 
+// PagerDutyImageConfig attaches images to an incident
+type PagerDutyImageConfig struct {
+	// Src of the image being attached to the incident
+	// +optional
+	Src string `json:"src,omitempty"`
+	// Optional URL; makes the image a clickable link.
+	// +optional
+	Href string `json:"href,omitempty"`
+	// Alt is the optional alternative text for the image.
+	// +optional
+	Alt string `json:"alt,omitempty"`
+}
+
+// PagerDutyLinkConfig attaches text links to an incident
+type PagerDutyLinkConfig struct {
+	// Href is the URL of the link to be attached
+	// +optional
+	Href string `json:"href,omitempty"`
+	// Text that describes the purpose of the link, and can be used as the link's text.
+	// +optional
+	Text string `json:"text,omitempty"`
+}
+
 // SlackConfig configures notifications via Slack.
 // See https://prometheus.io/docs/alerting/latest/configuration/#slack_config
 type SlackConfig struct {
@@ -405,6 +592,13 @@ type WebhookConfig struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	MaxAlerts int32 `json:"maxAlerts,omitempty"`
+	// The maximum time to wait for a webhook request to complete, before
+	// failing the request and allowing it to be retried. When not set, the
+	// request is only bounded by the notification timeout.
+	// It requires Alertmanager >= 0.28.0.
+	// +kubebuilder:validation:Pattern="^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$"
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
 }
 
 // OpsGenieConfig configures notifications via OpsGenie.
@@ -504,6 +698,14 @@ type HTTPConfig struct {
 	// Optional proxy URL.
 	// +optional
 	ProxyURL string `json:"proxyURL,omitempty"`
+	// FollowRedirects specifies whether the client should follow HTTP 3xx redirects.
+	// It requires Alertmanager >= 0.22.0.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// OAuth2 client credentials used to fetch a token for the targets.
+	// It requires Alertmanager >= 0.22.0.
+	// +optional
+	OAuth2 *monitoringv1.OAuth2 `json:"oauth2,omitempty"`
 }
 
 // WeChatConfig configures notifications via WeChat.
@@ -523,16 +725,22 @@ type WeChatConfig struct {
 	// The corp id for authentication.
 	// +optional
 	CorpID string `json:"corpID,omitempty"`
+	// The ID of the WeChat application sending the notifications.
 	// +optional
 	AgentID string `json:"agentID,omitempty"`
+	// The users to send notifications to, separated by `|`.
 	// +optional
 	ToUser string `json:"toUser,omitempty"`
+	// The departments to send notifications to, separated by `|`.
 	// +optional
 	ToParty string `json:"toParty,omitempty"`
+	// The tags to send notifications to, separated by `|`.
 	// +optional
 	ToTag string `json:"toTag,omitempty"`
 	// API request data as defined by the WeChat API.
+	// +optional
 	Message string `json:"message,omitempty"`
+	// The type of the message. Possible values are `text` and `markdown`.
 	// +optional
 	MessageType string `json:"messageType,omitempty"`
 	// HTTP client configuration.
@@ -563,16 +771,19 @@ type EmailConfig struct {
 	// The secret's key that contains the password to use for authentication.
 	// The secret needs to be in the same namespace as the AlertmanagerConfig
 	// object and accessible by the Prometheus Operator.
+	// +optional
 	AuthPassword *v1.SecretKeySelector `json:"authPassword,omitempty"`
 	// The secret's key that contains the CRAM-MD5 secret.
 	// The secret needs to be in the same namespace as the AlertmanagerConfig
 	// object and accessible by the Prometheus Operator.
+	// +optional
 	AuthSecret *v1.SecretKeySelector `json:"authSecret,omitempty"`
 	// The identity to use for authentication.
 	// +optional
 	AuthIdentity string `json:"authIdentity,omitempty"`
 	// Further headers email header key/value pairs. Overrides any headers
 	// previously set by the notification implementation.
+	// +optional
 	Headers []KeyValue `json:"headers,omitempty"`
 	// The HTML body of the email notification.
 	// +optional
@@ -604,7 +815,6 @@ type VictorOpsConfig struct {
 	// +optional
 	APIURL string `json:"apiUrl,omitempty"`
 	// A key used to map the alert to a team.
-	// +optional
 	RoutingKey string `json:"routingKey"`
 	// Describes the behavior of the alert (CRITICAL, WARNING, INFO).
 	// +optional
@@ -660,10 +870,12 @@ type PushoverConfig struct {
 	Priority string `json:"priority,omitempty"`
 	// How often the Pushover servers will send the same notification to the user.
 	// Must be at least 30 seconds.
+	// +kubebuilder:validation:Pattern="^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$"
 	// +optional
 	Retry string `json:"retry,omitempty"`
 	// How long your notification will continue to be retried for, unless the user
 	// acknowledges the notification.
+	// +kubebuilder:validation:Pattern="^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$"
 	// +optional
 	Expire string `json:"expire,omitempty"`
 	// Whether notification message is HTML or plain text.
@@ -674,6 +886,311 @@ type PushoverConfig struct {
 	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
 }
 
+// SNSConfig configures notifications via AWS SNS.
+// See https://prometheus.io/docs/alerting/latest/configuration/#sns_configs
+type SNSConfig struct {
+	// Whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The SNS API URL i.e. https://sns.us-east-2.amazonaws.com.
+	// If not specified, the SNS API URL from the SNS SDK will be used.
+	// +optional
+	APIURL string `json:"apiURL,omitempty"`
+	// Configures AWS's Signature Verification 4 signing process to sign requests.
+	// The secrets need to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	Sigv4 *monitoringv1.Sigv4 `json:"sigv4,omitempty"`
+	// SNS topic ARN, i.e. arn:aws:sns:us-east-2:698519295917:My-Topic.
+	// If you don't specify this value, you must specify a value for the
+	// `phoneNumber` or `targetARN`.
+	// +optional
+	TopicARN string `json:"topicARN,omitempty"`
+	// Subject line when the message is delivered to email endpoints.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+	// Phone number if message is delivered via SMS in E.164 format.
+	// If you don't specify this value, you must specify a value for the
+	// `topicARN` or `targetARN`.
+	// +optional
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	// The mobile platform endpoint ARN if message is delivered via mobile notifications.
+	// If you don't specify this value, you must specify a value for the
+	// `topicARN` or `phoneNumber`.
+	// +optional
+	TargetARN string `json:"targetARN,omitempty"`
+	// The message content of the SNS notification.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// SNS message attributes.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// Validate ensures SNSConfig is valid.
+func (sc *SNSConfig) Validate() error {
+	if sc.TopicARN == "" && sc.PhoneNumber == "" && sc.TargetARN == "" {
+		return errors.New("must provide either a topicARN, phoneNumber or targetARN in SNS configuration")
+	}
+	return nil
+}
+
+// TelegramConfig configures notifications via Telegram.
+// See https://prometheus.io/docs/alerting/latest/configuration/#telegram_config
+type TelegramConfig struct {
+	// Whether to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The Telegram API URL i.e. https://api.telegram.org.
+	// If not specified, default API URL will be used.
+	// +optional
+	APIURL string `json:"apiURL,omitempty"`
+	// Telegram bot token.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	BotToken *v1.SecretKeySelector `json:"botToken"`
+	// The Telegram chat ID.
+	ChatID int64 `json:"chatID"`
+	// Message template
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Disable telegram notifications
+	// +optional
+	DisableNotifications *bool `json:"disableNotifications,omitempty"`
+	// Parse mode for telegram message
+	// +kubebuilder:validation:Enum=MarkdownV2;Markdown;HTML
+	// +optional
+	ParseMode string `json:"parseMode,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// Validate ensures TelegramConfig is valid.
+func (tc *TelegramConfig) Validate() error {
+	if tc.BotToken == nil {
+		return errors.New("missing bot token in Telegram configuration")
+	}
+
+	if tc.ChatID == 0 {
+		return errors.New("missing chat ID in Telegram configuration")
+	}
+
+	return nil
+}
+
+// DiscordConfig configures notifications via Discord.
+// See https://prometheus.io/docs/alerting/latest/configuration/#discord_config
+type DiscordConfig struct {
+	// Whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The secret's key that contains the Discord webhook URL.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	APIURL v1.SecretKeySelector `json:"apiURL"`
+	// The template of the message's title.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// The template of the message's body.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// MSTeamsConfig configures notifications via Microsoft Teams.
+// See https://prometheus.io/docs/alerting/latest/configuration/#msteams_config
+type MSTeamsConfig struct {
+	// Whether to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The secret's key that contains the MSTeams incoming webhook URL.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	WebhookURL v1.SecretKeySelector `json:"webhookUrl"`
+	// Message title template.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// Message summary template.
+	// It requires Alertmanager >= 0.27.0.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+	// Message body template.
+	// +optional
+	Text string `json:"text,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// MSTeamsV2Config configures notifications via Microsoft Teams using the
+// Adaptive Card payload format of Workflows webhooks.
+// See https://prometheus.io/docs/alerting/latest/configuration/#msteamsv2_config
+type MSTeamsV2Config struct {
+	// Whether to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The secret's key that contains the MSTeams incoming webhook URL.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	WebhookURL v1.SecretKeySelector `json:"webhookUrl"`
+	// Message title template.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// Message body template.
+	// +optional
+	Text string `json:"text,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// RocketChatConfig configures notifications via Rocket.Chat.
+// See https://prometheus.io/docs/alerting/latest/configuration/#rocketchat_config
+type RocketChatConfig struct {
+	// Whether to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The secret's key that contains the Rocket.Chat API URL.
+	// If not specified, Alertmanager uses https://open.rocket.chat/.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	APIURL *v1.SecretKeySelector `json:"apiURL,omitempty"`
+	// The secret's key that contains the token of the Rocket.Chat user.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	Token v1.SecretKeySelector `json:"token"`
+	// The secret's key that contains the ID of the Rocket.Chat user.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	TokenID v1.SecretKeySelector `json:"tokenID"`
+	// The channel or user to send notifications to.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+	// The color of the message's attachment.
+	// +optional
+	Color string `json:"color,omitempty"`
+	// The emoji used as the avatar of the message.
+	// +optional
+	Emoji string `json:"emoji,omitempty"`
+	// The URL of the icon used as the avatar of the message.
+	// +optional
+	IconURL string `json:"iconURL,omitempty"`
+	// The template of the message's title.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// The link of the message's title.
+	// +optional
+	TitleLink string `json:"titleLink,omitempty"`
+	// The template of the message's body.
+	// +optional
+	Text string `json:"text,omitempty"`
+	// A list of fields added to the message's attachment.
+	// +optional
+	Fields []RocketChatFieldConfig `json:"fields,omitempty"`
+	// Whether the fields are displayed next to each other by default.
+	// +optional
+	ShortFields bool `json:"shortFields,omitempty"`
+	// The URL of the image displayed in the message's attachment.
+	// +optional
+	ImageURL string `json:"imageURL,omitempty"`
+	// The URL of the thumbnail displayed in the message's attachment.
+	// +optional
+	ThumbURL string `json:"thumbURL,omitempty"`
+	// Whether to link the names of users and channels in the message.
+	// +optional
+	LinkNames bool `json:"linkNames,omitempty"`
+	// A list of buttons added to the message's attachment.
+	// +optional
+	Actions []RocketChatActionConfig `json:"actions,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// RocketChatFieldConfig defines a field of a Rocket.Chat message's
+// attachment.
+type RocketChatFieldConfig struct {
+	// The title of the field.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// The value of the field.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Whether the field is displayed next to other short fields.
+	// +optional
+	Short *bool `json:"short,omitempty"`
+}
+
+// RocketChatActionConfig defines a button of a Rocket.Chat message's
+// attachment.
+type RocketChatActionConfig struct {
+	// The label of the button.
+	// +optional
+	Text string `json:"text,omitempty"`
+	// The URL opened when clicking on the button.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// The message sent when clicking on the button.
+	// +optional
+	Msg string `json:"msg,omitempty"`
+}
+
+// JiraConfig configures notifications via Jira.
+// See https://prometheus.io/docs/alerting/latest/configuration/#jira_config
+type JiraConfig struct {
+	// Whether to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The URL of the Jira API.
+	// +kubebuilder:validation:MinLength=1
+	APIURL string `json:"apiURL"`
+	// The project key where issues are created.
+	// +kubebuilder:validation:MinLength=1
+	Project string `json:"project"`
+	// The type of the created issues (e.g. `Bug`).
+	// +kubebuilder:validation:MinLength=1
+	IssueType string `json:"issueType"`
+	// The template of the issue's summary.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+	// The template of the issue's description.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// The template of the issue's priority.
+	// +optional
+	Priority string `json:"priority,omitempty"`
+	// The labels added to the issues.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// The name of the transition applied to resolve the issue when the
+	// alert is resolved.
+	// +optional
+	ResolveTransition string `json:"resolveTransition,omitempty"`
+	// The name of the transition applied to reopen a resolved issue when
+	// the alert fires again.
+	// +optional
+	ReopenTransition string `json:"reopenTransition,omitempty"`
+	// The resolution of the issues which shouldn't be reopened.
+	// +optional
+	WontFixResolution string `json:"wontFixResolution,omitempty"`
+	// The duration during which a resolved issue is reopened instead of
+	// creating a new issue.
+	// +kubebuilder:validation:Pattern="^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$"
+	// +optional
+	ReopenDuration string `json:"reopenDuration,omitempty"`
+	// HTTP client configuration. The Jira credentials are defined using
+	// either the basic authentication or the authorization fields.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
 // InhibitRule defines an inhibition rule that allows to mute alerts when other
 // alerts are already firing.
 // See https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule
@@ -707,11 +1224,372 @@ type Matcher struct {
 	// Label value to match.
 	// +optional
 	Value string `json:"value"`
+	// Match operator, one of `=` (equal to), `!=` (not equal to), `=~` (regex
+	// match) or `!~` (not regex match).
+	// Negative operators (`!=` and `!~`) require Alertmanager >= 0.22.0.
+	// +optional
+	MatchType MatchType `json:"matchType,omitempty"`
 	// Whether to match on equality (false) or regular-expression (true).
+	// Deprecated: use matchType instead. It can't be set together with
+	// matchType.
 	// +optional
 	Regex bool `json:"regex,omitempty"`
 }
 
+// MatchType is a comparison operator on a Matcher.
+// +kubebuilder:validation:Enum=!=;=;=~;!~
+type MatchType string
+
+const (
+	MatchEqual     MatchType = "="
+	MatchNotEqual  MatchType = "!="
+	MatchRegexp    MatchType = "=~"
+	MatchNotRegexp MatchType = "!~"
+)
+
+// Type returns the effective match operator of the matcher, taking into
+// account the deprecated Regex field.
+func (m Matcher) Type() MatchType {
+	if m.MatchType != "" {
+		return m.MatchType
+	}
+
+	if m.Regex {
+		return MatchRegexp
+	}
+
+	return MatchEqual
+}
+
+// IsNegative returns true if the matcher uses a negative operator.
+func (m Matcher) IsNegative() bool {
+	t := m.Type()
+	return t == MatchNotEqual || t == MatchNotRegexp
+}
+
+// IsRegex returns true if the matcher uses a regular-expression operator.
+func (m Matcher) IsRegex() bool {
+	t := m.Type()
+	return t == MatchRegexp || t == MatchNotRegexp
+}
+
+var matcherValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+// String returns the matcher using the Alertmanager matcher syntax (e.g.
+// `severity=~"critical|warning"`).
+func (m Matcher) String() string {
+	return m.Name + string(m.Type()) + `"` + matcherValueEscaper.Replace(m.Value) + `"`
+}
+
+// Validate semantically validates the given Matcher.
+func (m *Matcher) Validate() error {
+	if m.Name == "" {
+		return errors.New("missing name in matcher")
+	}
+
+	if m.MatchType != "" && m.Regex {
+		return fmt.Errorf("matcher %q: regex and matchType are mutually exclusive", m.Name)
+	}
+
+	switch m.Type() {
+	case MatchEqual, MatchNotEqual:
+	case MatchRegexp, MatchNotRegexp:
+		if _, err := regexp.Compile("^(?:" + m.Value + ")$"); err != nil {
+			return fmt.Errorf("matcher %q: invalid regular expression %q: %w", m.Name, m.Value, err)
+		}
+	default:
+		return fmt.Errorf("matcher %q: invalid match type %q", m.Name, m.MatchType)
+	}
+
+	return nil
+}
+
+// MuteTimeInterval specifies the periods in time when notifications will be muted.
+// See https://prometheus.io/docs/alerting/latest/configuration/#mute_time_interval
+type MuteTimeInterval struct {
+	// Name of the time interval.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// TimeIntervals is a list of TimeInterval.
+	// +optional
+	TimeIntervals []TimeInterval `json:"timeIntervals,omitempty"`
+}
+
+// Validate semantically validates the given MuteTimeInterval.
+func (mti *MuteTimeInterval) Validate() error {
+	if mti.Name == "" {
+		return errors.New("missing name in mute time interval")
+	}
+
+	for i, ti := range mti.TimeIntervals {
+		if err := ti.Validate(); err != nil {
+			return fmt.Errorf("time interval[%d] is invalid: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// TimeInterval describes intervals of time.
+type TimeInterval struct {
+	// Times is a list of TimeRange.
+	// +optional
+	Times []TimeRange `json:"times,omitempty"`
+	// Weekdays is a list of WeekdayRange.
+	// +optional
+	Weekdays []WeekdayRange `json:"weekdays,omitempty"`
+	// DaysOfMonth is a list of DayOfMonthRange.
+	// +optional
+	DaysOfMonth []DayOfMonthRange `json:"daysOfMonth,omitempty"`
+	// Months is a list of MonthRange.
+	// +optional
+	Months []MonthRange `json:"months,omitempty"`
+	// Years is a list of YearRange.
+	// +optional
+	Years []YearRange `json:"years,omitempty"`
+}
+
+// Validate semantically validates the given TimeInterval.
+func (ti *TimeInterval) Validate() error {
+	for _, tr := range ti.Times {
+		if err := tr.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, wr := range ti.Weekdays {
+		if err := wr.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, dr := range ti.DaysOfMonth {
+		if err := dr.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, mr := range ti.Months {
+		if err := mr.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, yr := range ti.Years {
+		if err := yr.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Time defines a time in 24hr format.
+// +kubebuilder:validation:Pattern=`^((([01][0-9])|(2[0-3])):[0-5][0-9])$|(^24:00$)`
+type Time string
+
+var timeRegex = regexp.MustCompile(`^((([01][0-9])|(2[0-3])):[0-5][0-9])$|(^24:00$)`)
+
+// minutes returns the number of minutes elapsed since midnight.
+func (t Time) minutes() (int, error) {
+	if !timeRegex.MatchString(string(t)) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM in 24hr format", t)
+	}
+
+	var hours, minutes int
+	if _, err := fmt.Sscanf(string(t), "%d:%d", &hours, &minutes); err != nil {
+		return 0, fmt.Errorf("invalid time %q: %w", t, err)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+// TimeRange defines a start and end time in 24hr format.
+type TimeRange struct {
+	// StartTime is the start time in 24hr format.
+	StartTime Time `json:"startTime,omitempty"`
+	// EndTime is the end time in 24hr format.
+	EndTime Time `json:"endTime,omitempty"`
+}
+
+// Validate semantically validates the given TimeRange.
+func (tr *TimeRange) Validate() error {
+	start, err := tr.StartTime.minutes()
+	if err != nil {
+		return fmt.Errorf("start time is invalid: %w", err)
+	}
+
+	end, err := tr.EndTime.minutes()
+	if err != nil {
+		return fmt.Errorf("end time is invalid: %w", err)
+	}
+
+	if start >= end {
+		return fmt.Errorf("start time %q must be before end time %q", tr.StartTime, tr.EndTime)
+	}
+
+	return nil
+}
+
+// WeekdayRange is an inclusive range of days of the week beginning on Sunday.
+// Days can be specified by name (e.g 'Sunday') or as an inclusive range (e.g 'Monday:Friday').
+// +kubebuilder:validation:Pattern=`^(?i)(sun|mon|tues|wednes|thurs|fri|satur)day(?::(sun|mon|tues|wednes|thurs|fri|satur)day)?$`
+type WeekdayRange string
+
+var daysOfWeek = map[string]int{
+	"sunday":    0,
+	"monday":    1,
+	"tuesday":   2,
+	"wednesday": 3,
+	"thursday":  4,
+	"friday":    5,
+	"saturday":  6,
+}
+
+// Validate semantically validates the given WeekdayRange.
+func (r WeekdayRange) Validate() error {
+	start, end, err := parseRange(string(r), func(s string) (int, error) {
+		day, found := daysOfWeek[strings.ToLower(s)]
+		if !found {
+			return 0, fmt.Errorf("unknown day of the week %q", s)
+		}
+		return day, nil
+	})
+	if err != nil {
+		return fmt.Errorf("invalid weekday range %q: %w", r, err)
+	}
+
+	if start > end {
+		return fmt.Errorf("invalid weekday range %q: start day must be before end day", r)
+	}
+
+	return nil
+}
+
+// DayOfMonthRange is an inclusive range of days of the month beginning at 1.
+// Negative values count backwards from the end of the month (e.g -1 is the
+// last day of the month).
+type DayOfMonthRange struct {
+	// Start of the inclusive range.
+	// +kubebuilder:validation:Minimum=-31
+	// +kubebuilder:validation:Maximum=31
+	Start int `json:"start,omitempty"`
+	// End of the inclusive range.
+	// +kubebuilder:validation:Minimum=-31
+	// +kubebuilder:validation:Maximum=31
+	End int `json:"end,omitempty"`
+}
+
+// Validate semantically validates the given DayOfMonthRange.
+func (r *DayOfMonthRange) Validate() error {
+	for _, day := range []int{r.Start, r.End} {
+		if day == 0 || day < -31 || day > 31 {
+			return fmt.Errorf("invalid day of month %d: must be between 1 and 31 or -31 and -1", day)
+		}
+	}
+
+	// Ranges can only be checked when both ends have the same sign.
+	if (r.Start > 0) == (r.End > 0) && r.Start > r.End {
+		return fmt.Errorf("invalid days of month range %d:%d: start day must be before end day", r.Start, r.End)
+	}
+
+	return nil
+}
+
+// MonthRange is an inclusive range of months of the year beginning in January.
+// Months can be specified by name (e.g 'January') by numerical month (e.g '1') or as an inclusive range (e.g 'January:March', '1:3', '1:March').
+// +kubebuilder:validation:Pattern=`^(?i)(january|february|march|april|may|june|july|august|september|october|november|december|1[0-2]|[1-9])(?::(january|february|march|april|may|june|july|august|september|october|november|december|1[0-2]|[1-9]))?$`
+type MonthRange string
+
+var months = map[string]int{
+	"january":   1,
+	"february":  2,
+	"march":     3,
+	"april":     4,
+	"may":       5,
+	"june":      6,
+	"july":      7,
+	"august":    8,
+	"september": 9,
+	"october":   10,
+	"november":  11,
+	"december":  12,
+}
+
+// Validate semantically validates the given MonthRange.
+func (r MonthRange) Validate() error {
+	start, end, err := parseRange(string(r), func(s string) (int, error) {
+		if month, found := months[strings.ToLower(s)]; found {
+			return month, nil
+		}
+
+		month, err := strconv.Atoi(s)
+		if err != nil || month < 1 || month > 12 {
+			return 0, fmt.Errorf("unknown month %q", s)
+		}
+		return month, nil
+	})
+	if err != nil {
+		return fmt.Errorf("invalid month range %q: %w", r, err)
+	}
+
+	if start > end {
+		return fmt.Errorf("invalid month range %q: start month must be before end month", r)
+	}
+
+	return nil
+}
+
+// YearRange is an inclusive range of years.
+// +kubebuilder:validation:Pattern=`^2\d{3}(?::2\d{3}|$)`
+type YearRange string
+
+// Validate semantically validates the given YearRange.
+func (r YearRange) Validate() error {
+	start, end, err := parseRange(string(r), func(s string) (int, error) {
+		year, err := strconv.Atoi(s)
+		if err != nil || year < 0 {
+			return 0, fmt.Errorf("unknown year %q", s)
+		}
+		return year, nil
+	})
+	if err != nil {
+		return fmt.Errorf("invalid year range %q: %w", r, err)
+	}
+
+	if start > end {
+		return fmt.Errorf("invalid year range %q: start year must be before end year", r)
+	}
+
+	return nil
+}
+
+// parseRange parses an inclusive range of the form "<start>[:<end>]" using
+// the given function to convert each end of the range. When the end is
+// omitted, the range spans a single value.
+func parseRange(in string, parse func(string) (int, error)) (int, int, error) {
+	parts := strings.Split(in, ":")
+	if len(parts) > 2 {
+		return 0, 0, errors.New("expected at most one ':' separator")
+	}
+
+	start, err := parse(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(parts) == 1 {
+		return start, start, nil
+	}
+
+	end, err := parse(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return start, end, nil
+}
+
 // DeepCopyObject implements the runtime.Object interface.
 func (l *AlertmanagerConfig) DeepCopyObject() runtime.Object {
 	return l.DeepCopy()
@@ -721,3 +1599,13 @@ func (l *AlertmanagerConfig) DeepCopyObject() runtime.Object {
 func (l *AlertmanagerConfigList) DeepCopyObject() runtime.Object {
 	return l.DeepCopy()
 }
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ClusterAlertmanagerConfig) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ClusterAlertmanagerConfigList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
@@ -0,0 +1,432 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	PrometheusAgentsKind   = "PrometheusAgent"
+	PrometheusAgentName    = "prometheusagents"
+	PrometheusAgentKindKey = "prometheusagent"
+)
+
+// PrometheusAgentMode defines how the Prometheus agent pods are deployed.
+type PrometheusAgentMode string
+
+const (
+	// StatefulSetMode deploys the Prometheus agent pods with a StatefulSet.
+	StatefulSetMode PrometheusAgentMode = "StatefulSet"
+	// DaemonSetMode deploys one Prometheus agent pod per node with a
+	// DaemonSet. Each pod only scrapes the pods running on its node.
+	DaemonSetMode PrometheusAgentMode = "DaemonSet"
+)
+
+// PrometheusAgent defines a Prometheus deployment running in agent mode. The
+// agent scrapes the selected targets and forwards the samples to remote write
+// endpoints without storing them locally, nor evaluating rules or serving
+// queries.
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Prometheus agent"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The desired replicas number of Prometheus agents"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type PrometheusAgent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the Prometheus agent. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec PrometheusAgentSpec `json:"spec"`
+	// Most recent observed status of the Prometheus agent. Read-only. Not
+	// included when requesting from the apiserver, only from the Prometheus
+	// Operator API itself. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status *monitoringv1.PrometheusStatus `json:"status,omitempty"`
+}
+
+// PrometheusAgentList is a list of PrometheusAgent.
+// +k8s:openapi-gen=true
+type PrometheusAgentList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of PrometheusAgent
+	Items []*PrometheusAgent `json:"items"`
+}
+
+// PrometheusAgentSpec is a specification of the desired behavior of the
+// Prometheus agent. It is a subset of the Prometheus specification: the
+// fields related to rules, alerting, querying and local storage retention
+// don't apply to the agent mode.
+// +k8s:openapi-gen=true
+type PrometheusAgentSpec struct {
+	// Mode defines how the Prometheus agent pods are deployed. Defaults to
+	// `StatefulSet`.
+	// In `DaemonSet` mode, one pod runs on every node and discovers only the
+	// pods scheduled on the same node. This mode only supports PodMonitors:
+	// `serviceMonitorSelector`, `probeSelector`, `replicas`, `shards` and
+	// persistent volume claim storage can't be set.
+	// +kubebuilder:validation:Enum="";StatefulSet;DaemonSet
+	Mode PrometheusAgentMode `json:"mode,omitempty"`
+	// PodMetadata configures Labels and Annotations which are propagated to the prometheus pods.
+	PodMetadata *monitoringv1.EmbeddedObjectMetadata `json:"podMetadata,omitempty"`
+	// ServiceMonitors to be selected for target discovery. *Deprecated:* if
+	// neither this nor podMonitorSelector are specified, configuration is
+	// unmanaged.
+	ServiceMonitorSelector *metav1.LabelSelector `json:"serviceMonitorSelector,omitempty"`
+	// Namespace's labels to match for ServiceMonitor discovery. If nil, only
+	// check own namespace.
+	ServiceMonitorNamespaceSelector *metav1.LabelSelector `json:"serviceMonitorNamespaceSelector,omitempty"`
+	// *Experimental* PodMonitors to be selected for target discovery.
+	// *Deprecated:* if neither this nor serviceMonitorSelector are specified,
+	// configuration is unmanaged.
+	PodMonitorSelector *metav1.LabelSelector `json:"podMonitorSelector,omitempty"`
+	// Namespace's labels to match for PodMonitor discovery. If nil, only
+	// check own namespace.
+	PodMonitorNamespaceSelector *metav1.LabelSelector `json:"podMonitorNamespaceSelector,omitempty"`
+	// *Experimental* Probes to be selected for target discovery.
+	ProbeSelector *metav1.LabelSelector `json:"probeSelector,omitempty"`
+	// *Experimental* Namespaces to be selected for Probe discovery. If nil, only check own namespace.
+	ProbeNamespaceSelector *metav1.LabelSelector `json:"probeNamespaceSelector,omitempty"`
+	// The Kubernetes service discovery role used to discover the targets of
+	// the ServiceMonitors. The default is `Endpoints`.
+	// `EndpointSlice` scales better on large clusters. It requires Prometheus
+	// >= 2.21.0 and the service account of Prometheus must be allowed to
+	// `list` and `watch` the `endpointslices` of the `discovery.k8s.io` API
+	// group.
+	// +optional
+	ServiceDiscoveryRole *monitoringv1.ServiceDiscoveryRole `json:"serviceDiscoveryRole,omitempty"`
+	// Version of Prometheus to be deployed. The agent mode requires Prometheus
+	// >= 2.32.0.
+	Version string `json:"version,omitempty"`
+	// When a PrometheusAgent deployment is paused, no actions except for deletion
+	// will be performed on the underlying objects.
+	Paused bool `json:"paused,omitempty"`
+	// Image if specified has precedence over the default Prometheus image.
+	// Specifying the version is still necessary to ensure the Prometheus
+	// Operator knows what version of Prometheus is being configured.
+	Image *string `json:"image,omitempty"`
+	// An optional list of references to secrets in the same namespace
+	// to use for pulling prometheus images from registries
+	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Image pull policy of the containers generated by the operator. When
+	// not defined, the Kubernetes defaults apply.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Number of replicas of each shard to deploy for a PrometheusAgent deployment.
+	// Number of replicas multiplied by shards is the total number of Pods
+	// created.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// EXPERIMENTAL: Number of shards to distribute targets onto. Number of
+	// replicas multiplied by shards is the total number of Pods created.
+	// Sharding is done on the content of the `__address__` target meta-label.
+	Shards *int32 `json:"shards,omitempty"`
+	// Name of Prometheus external label used to denote replica name.
+	// Defaults to the value of `prometheus_replica`. External label will
+	// _not_ be added when value is set to empty string (`""`).
+	ReplicaExternalLabelName *string `json:"replicaExternalLabelName,omitempty"`
+	// Name of Prometheus external label used to denote Prometheus instance
+	// name. Defaults to the value of `prometheus`. External label will
+	// _not_ be added when value is set to empty string (`""`).
+	PrometheusExternalLabelName *string `json:"prometheusExternalLabelName,omitempty"`
+	// Enable compression of the write-ahead log using Snappy.
+	WALCompression *bool `json:"walCompression,omitempty"`
+	// Size of the write-ahead log segment files read by the remote write
+	// queues. Supported units: B, KB, MB. Ex: `64MB`.
+	// +kubebuilder:validation:Pattern="^[0-9]+(B|KB|MB)$"
+	WALSegmentSize string `json:"walSegmentSize,omitempty"`
+	// How long to wait flushing the remote write queues on shutdown or
+	// configuration reload. Ex: `1m`.
+	RemoteWriteFlushDeadline string `json:"remoteWriteFlushDeadline,omitempty"`
+	// Log level for Prometheus to be configured with.
+	LogLevel string `json:"logLevel,omitempty"`
+	// Log format for Prometheus to be configured with.
+	LogFormat string `json:"logFormat,omitempty"`
+	// Interval between consecutive scrapes.
+	ScrapeInterval string `json:"scrapeInterval,omitempty"`
+	// Number of seconds to wait for target to respond before erroring.
+	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+	// The protocols to negotiate during a scrape. It tells clients the
+	// protocols supported by Prometheus in order of preference (from most to
+	// least preferred).
+	// If unset, Prometheus uses its default value.
+	// It requires Prometheus >= v2.49.0.
+	// +listType=set
+	ScrapeProtocols []monitoringv1.ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+	// Enable access to Prometheus feature flags. By default, no features
+	// are enabled. For instance, `native-histograms` enables the ingestion
+	// of native histograms. The `agent` feature is always enabled.
+	// Enabling features which are disabled by default is entirely outside
+	// the scope of what the maintainers will support and by doing so, you
+	// accept that this behaviour may break at any time without notice.
+	// For more information see https://prometheus.io/docs/prometheus/latest/feature_flags/
+	EnableFeatures []string `json:"enableFeatures,omitempty"`
+	// ScrapeFailureLogFile specifies the file to which scrape failures are
+	// logged. If the filename has an empty path, e.g. 'scrape.log', the
+	// operator mounts an emptyDir volume at `/var/log/prometheus` to store
+	// the file. If a full path is provided, the location must be writable.
+	// It can be set to `/dev/stdout` to log the failures to the default
+	// Prometheus log stream.
+	// This is only available in versions of Prometheus >= 2.55.0.
+	ScrapeFailureLogFile string `json:"scrapeFailureLogFile,omitempty"`
+	// The labels to add to any time series when communicating with remote
+	// storage.
+	ExternalLabels map[string]string `json:"externalLabels,omitempty"`
+	// The external URL the Prometheus instances will be available under. This is
+	// necessary to generate correct URLs. This is necessary if Prometheus is not
+	// served from root of a DNS name.
+	ExternalURL string `json:"externalUrl,omitempty"`
+	// The route prefix Prometheus registers HTTP handlers for. This is useful,
+	// if using ExternalURL and a proxy is rewriting HTTP routes of a request,
+	// and the actual ExternalURL is still true, but the server serves requests
+	// under a different route prefix. For example for use with `kubectl proxy`.
+	RoutePrefix string `json:"routePrefix,omitempty"`
+	// Storage spec to specify how the write-ahead log shall be stored.
+	Storage *monitoringv1.StorageSpec `json:"storage,omitempty"`
+	// Volumes allows configuration of additional volumes on the output StatefulSet definition. Volumes specified will
+	// be appended to other volumes that are generated as a result of StorageSpec objects.
+	Volumes []v1.Volume `json:"volumes,omitempty"`
+	// VolumeMounts allows configuration of additional VolumeMounts on the output StatefulSet definition.
+	// VolumeMounts specified will be appended to other VolumeMounts in the prometheus container,
+	// that are generated as a result of StorageSpec objects.
+	VolumeMounts []v1.VolumeMount `json:"volumeMounts,omitempty"`
+	// WebSpec defines the web command line flags when starting Prometheus.
+	Web *monitoringv1.WebSpec `json:"web,omitempty"`
+	// Define resources requests and limits for single Pods.
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// Defines the resources requests and limits of the config-reloader
+	// sidecar. When set, they replace the values defined by the operator's
+	// `--config-reloader-cpu` and `--config-reloader-memory` flags.
+	// +optional
+	ConfigReloaderResources *v1.ResourceRequirements `json:"configReloaderResources,omitempty"`
+	// Defines how long the config-reloader sidecar waits without detecting
+	// new changes to the configuration, rules and secrets before reloading.
+	// Successive changes within the interval are coalesced into a single
+	// reload. When set, it replaces the value defined by the operator's
+	// `--config-reloader-delay-interval` flag.
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	ReloadDelayInterval *string `json:"reloadDelayInterval,omitempty"`
+	// Defines the maximum number of replicas reloading their configuration at
+	// the same time. The replicas are staggered by the reload delay interval
+	// based on their ordinal number. 0 means no limit. When set, it replaces
+	// the value defined by the operator's
+	// `--config-reloader-max-concurrent-reloads` flag.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentReloads *int32 `json:"maxConcurrentReloads,omitempty"`
+	// Defines how the config-reloader sidecar triggers a reload of the
+	// configuration. With `HTTP` (default), it sends a request to the
+	// `/-/reload` endpoint. With `ProcessSignal`, it sends a SIGHUP signal to
+	// the Prometheus process, in which case the pod shares its process
+	// namespace and the lifecycle API endpoints of Prometheus are disabled.
+	// +optional
+	ReloadStrategy *monitoringv1.ReloadStrategyType `json:"reloadStrategy,omitempty"`
+	// Define which Nodes the Pods are scheduled on.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// ServiceAccountName is the name of the ServiceAccount to use to run the
+	// Prometheus Pods.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// AutomountServiceAccountToken indicates whether a service account token
+	// should be automatically mounted in the pods.
+	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
+	// Secrets is a list of Secrets in the same namespace as the PrometheusAgent
+	// object, which shall be mounted into the Prometheus Pods.
+	// The Secrets are mounted into /etc/prometheus/secrets/<secret-name>.
+	Secrets []string `json:"secrets,omitempty"`
+	// ConfigMaps is a list of ConfigMaps in the same namespace as the PrometheusAgent
+	// object, which shall be mounted into the Prometheus Pods.
+	// The ConfigMaps are mounted into /etc/prometheus/configmaps/<configmap-name>.
+	ConfigMaps []string `json:"configMaps,omitempty"`
+	// If specified, the pod's scheduling constraints.
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// If specified, the pod's tolerations.
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// If specified, the pod's topology spread constraints.
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Pods' hostAliases configuration, the entries are added to the hosts
+	// file of the pods.
+	HostAliases []v1.HostAlias `json:"hostAliases,omitempty"`
+	// Defines the DNS policy of the pods. Defaults to `ClusterFirst` or to
+	// `ClusterFirstWithHostNet` when hostNetwork is true.
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	// +optional
+	DNSPolicy *v1.DNSPolicy `json:"dnsPolicy,omitempty"`
+	// Defines the DNS parameters of the pods, in addition to the ones
+	// generated from the DNS policy.
+	// +optional
+	DNSConfig *v1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// Use the host's network namespace. When true, the pods listen on the
+	// network interfaces of the nodes so the replicas should be scheduled on
+	// different nodes and the ports shouldn't conflict with other workloads
+	// running on the host network.
+	// +optional
+	HostNetwork bool `json:"hostNetwork,omitempty"`
+	// The list of remote write endpoints which the scraped samples are sent
+	// to. At least one endpoint is required since the agent doesn't store
+	// data locally.
+	// +kubebuilder:validation:MinItems=1
+	RemoteWrite []monitoringv1.RemoteWriteSpec `json:"remoteWrite"`
+	// SecurityContext holds pod-level security attributes and common container settings.
+	// This defaults to the default PodSecurityContext.
+	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
+	// ListenLocal makes the Prometheus server listen on loopback, so that it
+	// does not bind against the Pod IP.
+	ListenLocal bool `json:"listenLocal,omitempty"`
+	// Overrides the parameters of the liveness probe of the Prometheus container.
+	LivenessProbe *monitoringv1.ProbeParameters `json:"livenessProbe,omitempty"`
+	// Overrides the parameters of the readiness probe of the Prometheus container.
+	ReadinessProbe *monitoringv1.ProbeParameters `json:"readinessProbe,omitempty"`
+	// Configures a startup probe for the Prometheus container. It can be used to
+	// allow a longer startup window without slowing down the detection of
+	// unhealthy containers afterwards.
+	StartupProbe *monitoringv1.ProbeParameters `json:"startupProbe,omitempty"`
+	// Containers allows injecting additional containers or modifying operator
+	// generated containers. This can be used to allow adding an authentication
+	// proxy to a Prometheus pod or to change the behavior of an operator
+	// generated container. Containers described here modify an operator
+	// generated container if they share the same name and modifications are
+	// done via a strategic merge patch. The current container names are:
+	// `prometheus` and `config-reloader`. Overriding
+	// containers is entirely outside the scope of what the maintainers will
+	// support and by doing so, you accept that this behaviour may break at any
+	// time without notice.
+	Containers []v1.Container `json:"containers,omitempty"`
+	// InitContainers allows adding initContainers to the pod definition. Those can be used to e.g.
+	// fetch secrets for injection into the Prometheus configuration from external sources. Any errors
+	// during the execution of an initContainer will lead to a restart of the Pod. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/
+	// Using initContainers for any use case other then secret fetching is entirely outside the scope
+	// of what the maintainers will support and by doing so, you accept that this behaviour may break
+	// at any time without notice.
+	InitContainers []v1.Container `json:"initContainers,omitempty"`
+	// AdditionalArgs allows setting additional arguments for the Prometheus container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Prometheus container which may cause issues if they are invalid or not supported
+	// by the given Prometheus version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	AdditionalArgs []monitoringv1.Argument `json:"additionalArgs,omitempty"`
+	// AdditionalScrapeConfigs allows specifying a key of a Secret containing
+	// additional Prometheus scrape configurations. Scrape configurations
+	// specified are appended to the configurations generated by the Prometheus
+	// Operator. Job configurations specified must have the form as specified
+	// in the official Prometheus documentation:
+	// https://prometheus.io/docs/prometheus/latest/configuration/configuration/#scrape_config.
+	// As scrape configs are appended, the user is responsible to make sure it
+	// is valid. Note that using this feature may expose the possibility to
+	// break upgrades of Prometheus. It is advised to review Prometheus release
+	// notes to ensure that no incompatible scrape configs are going to break
+	// Prometheus after the upgrade.
+	AdditionalScrapeConfigs *v1.SecretKeySelector `json:"additionalScrapeConfigs,omitempty"`
+	// APIServerConfig allows specifying a host and auth methods to access apiserver.
+	// If left empty, Prometheus is assumed to run inside of the cluster
+	// and will discover API servers automatically and use the pod's CA certificate
+	// and bearer token file at /var/run/secrets/kubernetes.io/serviceaccount/.
+	APIServerConfig *monitoringv1.APIServerConfig `json:"apiserverConfig,omitempty"`
+	// Priority class assigned to the Pods
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Port name used for the pods and governing service.
+	// This defaults to web
+	PortName string `json:"portName,omitempty"`
+	// ArbitraryFSAccessThroughSMs configures whether configuration
+	// based on a service monitor can access arbitrary files on the file system
+	// of the Prometheus container e.g. bearer token files.
+	ArbitraryFSAccessThroughSMs monitoringv1.ArbitraryFSAccessThroughSMsConfig `json:"arbitraryFSAccessThroughSMs,omitempty"`
+	// OverrideHonorLabels if set to true overrides all user configured honor_labels.
+	// If HonorLabels is set in ServiceMonitor or PodMonitor to true, this overrides honor_labels to false.
+	OverrideHonorLabels bool `json:"overrideHonorLabels,omitempty"`
+	// OverrideHonorTimestamps allows to globally enforce honoring timestamps in all scrape configs.
+	OverrideHonorTimestamps bool `json:"overrideHonorTimestamps,omitempty"`
+	// IgnoreNamespaceSelectors if set to true will ignore NamespaceSelector settings from
+	// the podmonitor and servicemonitor configs, and they will only discover endpoints
+	// within their current namespace.  Defaults to false.
+	IgnoreNamespaceSelectors bool `json:"ignoreNamespaceSelectors,omitempty"`
+	// EnforcedNamespaceLabel enforces adding a namespace label of origin for each
+	// metric that is user created. The label value will always be the namespace of the object that is
+	// being created.
+	EnforcedNamespaceLabel string `json:"enforcedNamespaceLabel,omitempty"`
+	// List of references to PodMonitor, ServiceMonitor and Probe objects to
+	// be excluded from enforcing a namespace label of origin. Applies only
+	// if enforcedNamespaceLabel is set.
+	ExcludedFromEnforcement []monitoringv1.ObjectReference `json:"excludedFromEnforcement,omitempty"`
+	// EnforcedSampleLimit defines global limit on number of scraped samples
+	// that will be accepted. This overrides any SampleLimit set per
+	// ServiceMonitor or/and PodMonitor. It is meant to be used by admins to
+	// enforce the SampleLimit to keep overall number of samples/series under
+	// the desired limit.
+	// Note that if SampleLimit is lower that value will be taken instead.
+	EnforcedSampleLimit *uint64 `json:"enforcedSampleLimit,omitempty"`
+	// EnforcedTargetLimit defines a global limit on the number of scraped targets.
+	// This overrides any TargetLimit set per ServiceMonitor or/and PodMonitor.
+	// It is meant to be used by admins to
+	// enforce the TargetLimit to keep overall number of targets under
+	// the desired limit.
+	// Note that if TargetLimit is higher that value will be taken instead.
+	EnforcedTargetLimit *uint64 `json:"enforcedTargetLimit,omitempty"`
+	// EnforcedBodySizeLimit defines the maximum size of the uncompressed
+	// response body accepted by every scrape config generated by the
+	// operator. Example: `100MB`.
+	// Only valid in Prometheus versions 2.28.0 and newer.
+	// +kubebuilder:validation:Pattern="(^0|([0-9]*[.])?[0-9]+((K|M|G|T|E|P)i?)?B)$"
+	EnforcedBodySizeLimit string `json:"enforcedBodySizeLimit,omitempty"`
+	// EnforcedLabelLimit defines the maximum number of labels per sample
+	// accepted by every scrape config generated by the operator.
+	// It overrides the LabelLimit of the ServiceMonitors, PodMonitors and
+	// Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	EnforcedLabelLimit *uint64 `json:"enforcedLabelLimit,omitempty"`
+	// EnforcedLabelNameLengthLimit defines the maximum length of the label
+	// names per sample accepted by every scrape config generated by the
+	// operator.
+	// It overrides the LabelNameLengthLimit of the ServiceMonitors,
+	// PodMonitors and Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	EnforcedLabelNameLengthLimit *uint64 `json:"enforcedLabelNameLengthLimit,omitempty"`
+	// EnforcedLabelValueLengthLimit defines the maximum length of the label
+	// values per sample accepted by every scrape config generated by the
+	// operator.
+	// It overrides the LabelValueLengthLimit of the ServiceMonitors,
+	// PodMonitors and Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	EnforcedLabelValueLengthLimit *uint64 `json:"enforcedLabelValueLengthLimit,omitempty"`
+	// EnforcedKeepDroppedTargets defines the maximum number of targets
+	// dropped by relabeling which are kept in memory for every scrape config
+	// generated by the operator. 0 means no limit.
+	// It overrides the KeepDroppedTargets of the ServiceMonitors,
+	// PodMonitors and Probes unless their value is lower.
+	// Only valid in Prometheus versions 2.47.0 and newer.
+	EnforcedKeepDroppedTargets *uint64 `json:"enforcedKeepDroppedTargets,omitempty"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *PrometheusAgent) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *PrometheusAgentList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
@@ -49,6 +49,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&AlertmanagerConfig{},
 		&AlertmanagerConfigList{},
+		&ClusterAlertmanagerConfig{},
+		&ClusterAlertmanagerConfigList{},
+		&PrometheusAgent{},
+		&PrometheusAgentList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,78 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// ObjectGetter returns cached objects by key (<namespace>/<name>).
+type ObjectGetter interface {
+	Get(key string) (runtime.Object, error)
+}
+
+// NewCachedSecretsGetter returns a SecretsGetter which serves Get requests
+// from the given cache (typically the Secret informers of the operator).
+// Secrets which aren't found in the cache (for instance because they live in
+// a namespace that isn't watched or they are filtered out by a field
+// selector) are requested from the API.
+func NewCachedSecretsGetter(cache ObjectGetter, client corev1client.SecretsGetter) corev1client.SecretsGetter {
+	return &cachedSecretsGetter{
+		cache:  cache,
+		client: client,
+	}
+}
+
+type cachedSecretsGetter struct {
+	cache  ObjectGetter
+	client corev1client.SecretsGetter
+}
+
+func (c *cachedSecretsGetter) Secrets(namespace string) corev1client.SecretInterface {
+	return &cachedSecrets{
+		SecretInterface: c.client.Secrets(namespace),
+		cache:           c.cache,
+		namespace:       namespace,
+	}
+}
+
+// cachedSecrets overrides the Get method of SecretInterface, all other
+// methods go directly to the API.
+type cachedSecrets struct {
+	corev1client.SecretInterface
+
+	cache     ObjectGetter
+	namespace string
+}
+
+func (s *cachedSecrets) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Secret, error) {
+	obj, err := s.cache.Get(s.namespace + "/" + name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if secret, ok := obj.(*v1.Secret); ok && err == nil {
+		// Objects from the cache must not be modified.
+		return secret.DeepCopy(), nil
+	}
+
+	return s.SecretInterface.Get(ctx, name, opts)
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type mapGetter map[string]runtime.Object
+
+func (m mapGetter) Get(key string) (runtime.Object, error) {
+	obj, found := m[key]
+	if !found {
+		return nil, apierrors.NewNotFound(v1.Resource("secrets"), key)
+	}
+	return obj, nil
+}
+
+func TestCachedSecretsGetter(t *testing.T) {
+	cached := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cached",
+			Namespace: "ns1",
+		},
+		Data: map[string][]byte{
+			"key1": []byte("val1"),
+		},
+	}
+	uncached := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "uncached",
+			Namespace: "ns2",
+		},
+		Data: map[string][]byte{
+			"key1": []byte("val2"),
+		},
+	}
+
+	c := fake.NewSimpleClientset(cached, uncached)
+	getter := NewCachedSecretsGetter(mapGetter{"ns1/cached": cached}, c.CoreV1())
+
+	for _, tc := range []struct {
+		ns              string
+		name            string
+		expected        string
+		expectedActions int
+		err             bool
+	}{
+		{
+			ns:              "ns1",
+			name:            "cached",
+			expected:        "val1",
+			expectedActions: 0,
+		},
+		{
+			ns:              "ns2",
+			name:            "uncached",
+			expected:        "val2",
+			expectedActions: 1,
+		},
+		{
+			ns:              "ns1",
+			name:            "missing",
+			expectedActions: 1,
+			err:             true,
+		},
+	} {
+		t.Run(tc.ns+"/"+tc.name, func(t *testing.T) {
+			c.ClearActions()
+
+			s, err := getter.Secrets(tc.ns).Get(context.Background(), tc.name, metav1.GetOptions{})
+			if len(c.Actions()) != tc.expectedActions {
+				t.Fatalf("expected %d API requests, got %d", tc.expectedActions, len(c.Actions()))
+			}
+
+			if tc.err {
+				if err == nil {
+					t.Fatal("expecting error, got no error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error, got %v", err)
+			}
+
+			if string(s.Data["key1"]) != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, string(s.Data["key1"]))
+			}
+		})
+	}
+}
@@ -45,6 +45,9 @@ type Store struct {
 	TLSAssets         map[TLSAssetKey]TLSAsset
 	BearerTokenAssets map[string]BearerToken
 	BasicAuthAssets   map[string]BasicAuthCredentials
+	OAuth2Assets      map[string]OAuth2Credentials
+	SigV4Assets       map[string]SigV4Credentials
+	AzureOAuthAssets  map[string]AzureOAuthCredentials
 }
 
 // NewStore returns an empty assetStore.
@@ -55,6 +58,9 @@ func NewStore(cmClient corev1client.ConfigMapsGetter, sClient corev1client.Secre
 		TLSAssets:         make(map[TLSAssetKey]TLSAsset),
 		BearerTokenAssets: make(map[string]BearerToken),
 		BasicAuthAssets:   make(map[string]BasicAuthCredentials),
+		OAuth2Assets:      make(map[string]OAuth2Credentials),
+		SigV4Assets:       make(map[string]SigV4Credentials),
+		AzureOAuthAssets:  make(map[string]AzureOAuthCredentials),
 		objStore:          cache.NewStore(assetKeyFunc),
 	}
 }
@@ -171,6 +177,104 @@ func (s *Store) AddBasicAuth(ctx context.Context, ns string, ba *monitoringv1.Ba
 	return nil
 }
 
+// AddOAuth2 processes the given *OAuth2 and adds the referenced credentials to the store.
+func (s *Store) AddOAuth2(ctx context.Context, ns string, oauth2 *monitoringv1.OAuth2, key string) error {
+	if oauth2 == nil {
+		return nil
+	}
+
+	if err := oauth2.Validate(); err != nil {
+		return err
+	}
+
+	clientID, err := s.GetKey(ctx, ns, oauth2.ClientID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get oauth2 client id")
+	}
+
+	clientSecret, err := s.GetSecretKey(ctx, ns, oauth2.ClientSecret)
+	if err != nil {
+		return errors.Wrap(err, "failed to get oauth2 client secret")
+	}
+
+	s.OAuth2Assets[key] = OAuth2Credentials{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}
+
+	return nil
+}
+
+// AddOAuth2WithTLSConfig processes the given *OAuth2WithTLSConfig and adds the
+// referenced credentials and TLS assets to the store.
+func (s *Store) AddOAuth2WithTLSConfig(ctx context.Context, ns string, oauth2 *monitoringv1.OAuth2WithTLSConfig, key string) error {
+	if oauth2 == nil {
+		return nil
+	}
+
+	if err := s.AddOAuth2(ctx, ns, &oauth2.OAuth2, key); err != nil {
+		return err
+	}
+
+	if oauth2.TLSConfig != nil {
+		if err := s.AddSafeTLSConfig(ctx, ns, oauth2.TLSConfig); err != nil {
+			return errors.Wrap(err, "failed to get oauth2 TLS assets")
+		}
+	}
+
+	return nil
+}
+
+// AddSigV4 processes the given *Sigv4 and adds the referenced credentials to the store.
+// When no access key nor secret key is referenced, nothing is added and the
+// credentials are read from the environment.
+func (s *Store) AddSigV4(ctx context.Context, ns string, sigv4 *monitoringv1.Sigv4, key string) error {
+	if sigv4 == nil || (sigv4.AccessKey == nil && sigv4.SecretKey == nil) {
+		return nil
+	}
+
+	if sigv4.AccessKey == nil || sigv4.SecretKey == nil {
+		return errors.New("both accessKey and secretKey must be set")
+	}
+
+	accessKeyID, err := s.GetSecretKey(ctx, ns, *sigv4.AccessKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to get sigv4 access key")
+	}
+
+	secretKeyID, err := s.GetSecretKey(ctx, ns, *sigv4.SecretKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to get sigv4 secret key")
+	}
+
+	s.SigV4Assets[key] = SigV4Credentials{
+		AccessKeyID: accessKeyID,
+		SecretKeyID: secretKeyID,
+	}
+
+	return nil
+}
+
+// AddAzureOAuth processes the given *AzureAD and adds the referenced client
+// secret to the store. Nothing is added when the OAuth client credentials
+// aren't used.
+func (s *Store) AddAzureOAuth(ctx context.Context, ns string, azureAD *monitoringv1.AzureAD, key string) error {
+	if azureAD == nil || azureAD.OAuth == nil {
+		return nil
+	}
+
+	clientSecret, err := s.GetSecretKey(ctx, ns, azureAD.OAuth.ClientSecret)
+	if err != nil {
+		return errors.Wrap(err, "failed to get azure oauth client secret")
+	}
+
+	s.AzureOAuthAssets[key] = AzureOAuthCredentials{
+		ClientSecret: clientSecret,
+	}
+
+	return nil
+}
+
 // AddBearerToken processes the given SecretKeySelector and adds the referenced data to the store.
 func (s *Store) AddBearerToken(ctx context.Context, ns string, sel v1.SecretKeySelector, key string) error {
 	if sel.Name == "" {
@@ -187,6 +291,79 @@ func (s *Store) AddBearerToken(ctx context.Context, ns string, sel v1.SecretKeyS
 	return nil
 }
 
+// ProxyConnectHeaderKey returns the key under which the value of the proxy
+// CONNECT header is stored for the given resource key.
+func ProxyConnectHeaderKey(key, header string) string {
+	return fmt.Sprintf("%s/proxyConnectHeader/%s", key, header)
+}
+
+// AddProxyConfig processes the given *ProxyConfig and adds the values of the
+// proxy CONNECT headers to the bearer tokens of the store (see
+// ProxyConnectHeaderKey).
+func (s *Store) AddProxyConfig(ctx context.Context, ns string, pc *monitoringv1.ProxyConfig, key string) error {
+	if pc == nil {
+		return nil
+	}
+
+	for header, sel := range pc.ProxyConnectHeader {
+		value, err := s.GetSecretKey(ctx, ns, sel)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get proxy connect header %q", header)
+		}
+
+		s.BearerTokenAssets[ProxyConnectHeaderKey(key, header)] = BearerToken(value)
+	}
+
+	return nil
+}
+
+// AddSafeAuthorization processes the given *SafeAuthorization and adds the
+// referenced credentials to the store.
+func (s *Store) AddSafeAuthorization(ctx context.Context, ns string, auth *monitoringv1.SafeAuthorization, key string) error {
+	if auth == nil {
+		return nil
+	}
+
+	if err := auth.Validate(); err != nil {
+		return err
+	}
+
+	credentials, err := s.GetSecretKey(ctx, ns, *auth.Credentials)
+	if err != nil {
+		return errors.Wrap(err, "failed to get authorization credentials")
+	}
+
+	s.BearerTokenAssets[key] = BearerToken(credentials)
+
+	return nil
+}
+
+// AddAuthorization processes the given *Authorization and adds the referenced
+// credentials to the store. Nothing is added when the credentials are read
+// from a file.
+func (s *Store) AddAuthorization(ctx context.Context, ns string, auth *monitoringv1.Authorization, key string) error {
+	if auth == nil {
+		return nil
+	}
+
+	if err := auth.Validate(); err != nil {
+		return err
+	}
+
+	if auth.Credentials == nil {
+		return nil
+	}
+
+	credentials, err := s.GetSecretKey(ctx, ns, *auth.Credentials)
+	if err != nil {
+		return errors.Wrap(err, "failed to get authorization credentials")
+	}
+
+	s.BearerTokenAssets[key] = BearerToken(credentials)
+
+	return nil
+}
+
 // GetKey processes the given SecretOrConfigMap selector and returns the referenced data.
 func (s *Store) GetKey(ctx context.Context, namespace string, sel monitoringv1.SecretOrConfigMap) (string, error) {
 	switch {
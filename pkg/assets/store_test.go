@@ -288,6 +288,646 @@ func TestAddBasicAuth(t *testing.T) {
 	}
 }
 
+func TestAddOAuth2(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"client-id":     []byte("secret-client-id"),
+				"client-secret": []byte("client-secret"),
+			},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cm",
+				Namespace: "ns1",
+			},
+			Data: map[string]string{
+				"client-id": "cm-client-id",
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name     string
+		ns       string
+		clientID monitoringv1.SecretOrConfigMap
+		secret   v1.SecretKeySelector
+		tokenURL string
+
+		err              bool
+		expectedClientID string
+	}{
+		{
+			name: "client id from secret",
+			ns:   "ns1",
+			clientID: monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "client-id",
+				},
+			},
+			secret: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  "client-secret",
+			},
+			tokenURL: "http://example.com/token",
+
+			expectedClientID: "secret-client-id",
+		},
+		{
+			name: "client id from configmap",
+			ns:   "ns1",
+			clientID: monitoringv1.SecretOrConfigMap{
+				ConfigMap: &v1.ConfigMapKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "cm"},
+					Key:                  "client-id",
+				},
+			},
+			secret: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  "client-secret",
+			},
+			tokenURL: "http://example.com/token",
+
+			expectedClientID: "cm-client-id",
+		},
+		{
+			name: "missing token url",
+			ns:   "ns1",
+			clientID: monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "client-id",
+				},
+			},
+			secret: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  "client-secret",
+			},
+
+			err: true,
+		},
+		{
+			name: "wrong namespace",
+			ns:   "ns2",
+			clientID: monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "client-id",
+				},
+			},
+			secret: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  "client-secret",
+			},
+			tokenURL: "http://example.com/token",
+
+			err: true,
+		},
+		{
+			name: "wrong key for client secret",
+			ns:   "ns1",
+			clientID: monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "client-id",
+				},
+			},
+			secret: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  "invalid",
+			},
+			tokenURL: "http://example.com/token",
+
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStore(c.CoreV1(), c.CoreV1())
+
+			oauth2 := &monitoringv1.OAuth2{
+				ClientID:     tc.clientID,
+				ClientSecret: tc.secret,
+				TokenURL:     tc.tokenURL,
+			}
+
+			err := store.AddOAuth2(context.Background(), tc.ns, oauth2, "oauth2")
+
+			if tc.err {
+				if err == nil {
+					t.Fatal("expecting error, got no error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error, got %q", err)
+			}
+
+			s, found := store.OAuth2Assets["oauth2"]
+			if !found {
+				t.Fatalf("expecting to find key %q but got nothing", "oauth2")
+			}
+
+			if s.ClientID != tc.expectedClientID {
+				t.Fatalf("expecting client id %q, got %q", tc.expectedClientID, s.ClientID)
+			}
+			if s.ClientSecret != "client-secret" {
+				t.Fatalf("expecting client secret %q, got %q", "client-secret", s.ClientSecret)
+			}
+		})
+	}
+}
+
+func TestAddOAuth2WithTLSConfig(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"client-id":     []byte("client-id"),
+				"client-secret": []byte("client-secret"),
+				"ca.crt":        []byte(caPEM),
+			},
+		},
+	)
+
+	ca := monitoringv1.SecretOrConfigMap{
+		Secret: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+			Key:                  "ca.crt",
+		},
+	}
+	oauth2 := &monitoringv1.OAuth2WithTLSConfig{
+		OAuth2: monitoringv1.OAuth2{
+			ClientID: monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "client-id",
+				},
+			},
+			ClientSecret: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  "client-secret",
+			},
+			TokenURL: "http://example.com/token",
+		},
+		TLSConfig: &monitoringv1.SafeTLSConfig{CA: ca},
+	}
+
+	store := NewStore(c.CoreV1(), c.CoreV1())
+	if err := store.AddOAuth2WithTLSConfig(context.Background(), "ns1", oauth2, "oauth2"); err != nil {
+		t.Fatalf("expecting no error, got %q", err)
+	}
+
+	if _, found := store.OAuth2Assets["oauth2"]; !found {
+		t.Fatalf("expecting to find key %q but got nothing", "oauth2")
+	}
+
+	key := TLSAssetKeyFromSelector("ns1", ca)
+	if string(store.TLSAssets[key]) != caPEM {
+		t.Fatalf("expecting CA %q, got %q", caPEM, store.TLSAssets[key])
+	}
+}
+
+func TestAddSigV4(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"access-key": []byte("val1"),
+				"secret-key": []byte("val2"),
+			},
+		},
+	)
+
+	accessKey := &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+		Key:                  "access-key",
+	}
+	secretKey := &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+		Key:                  "secret-key",
+	}
+
+	for _, tc := range []struct {
+		name  string
+		ns    string
+		sigv4 *monitoringv1.Sigv4
+
+		err      bool
+		expected *SigV4Credentials
+	}{
+		{
+			name: "access and secret keys",
+			ns:   "ns1",
+			sigv4: &monitoringv1.Sigv4{
+				AccessKey: accessKey,
+				SecretKey: secretKey,
+			},
+
+			expected: &SigV4Credentials{
+				AccessKeyID: "val1",
+				SecretKeyID: "val2",
+			},
+		},
+		{
+			name:  "no keys",
+			ns:    "ns1",
+			sigv4: &monitoringv1.Sigv4{Region: "us-east-1"},
+		},
+		{
+			name: "missing secret key",
+			ns:   "ns1",
+			sigv4: &monitoringv1.Sigv4{
+				AccessKey: accessKey,
+			},
+
+			err: true,
+		},
+		{
+			name: "wrong namespace",
+			ns:   "ns2",
+			sigv4: &monitoringv1.Sigv4{
+				AccessKey: accessKey,
+				SecretKey: secretKey,
+			},
+
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStore(c.CoreV1(), c.CoreV1())
+
+			err := store.AddSigV4(context.Background(), tc.ns, tc.sigv4, "sigv4")
+
+			if tc.err {
+				if err == nil {
+					t.Fatal("expecting error, got no error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error, got %q", err)
+			}
+
+			s, found := store.SigV4Assets["sigv4"]
+			if tc.expected == nil {
+				if found {
+					t.Fatalf("expecting no credentials, got %v", s)
+				}
+				return
+			}
+
+			if !found {
+				t.Fatalf("expecting to find key %q but got nothing", "sigv4")
+			}
+
+			if s != *tc.expected {
+				t.Fatalf("expecting credentials %v, got %v", *tc.expected, s)
+			}
+		})
+	}
+}
+
+func TestAddAzureOAuth(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"client-secret": []byte("val1"),
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name    string
+		ns      string
+		azureAD *monitoringv1.AzureAD
+
+		err      bool
+		expected *AzureOAuthCredentials
+	}{
+		{
+			name: "oauth",
+			ns:   "ns1",
+			azureAD: &monitoringv1.AzureAD{
+				OAuth: &monitoringv1.AzureOAuth{
+					ClientID: "client-id",
+					ClientSecret: v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+						Key:                  "client-secret",
+					},
+					TenantID: "tenant-id",
+				},
+			},
+
+			expected: &AzureOAuthCredentials{ClientSecret: "val1"},
+		},
+		{
+			name: "managed identity",
+			ns:   "ns1",
+			azureAD: &monitoringv1.AzureAD{
+				ManagedIdentity: &monitoringv1.ManagedIdentity{ClientID: "client-id"},
+			},
+		},
+		{
+			name: "wrong key",
+			ns:   "ns1",
+			azureAD: &monitoringv1.AzureAD{
+				OAuth: &monitoringv1.AzureOAuth{
+					ClientID: "client-id",
+					ClientSecret: v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+						Key:                  "invalid",
+					},
+					TenantID: "tenant-id",
+				},
+			},
+
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStore(c.CoreV1(), c.CoreV1())
+
+			err := store.AddAzureOAuth(context.Background(), tc.ns, tc.azureAD, "azuread")
+
+			if tc.err {
+				if err == nil {
+					t.Fatal("expecting error, got no error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error, got %q", err)
+			}
+
+			s, found := store.AzureOAuthAssets["azuread"]
+			if tc.expected == nil {
+				if found {
+					t.Fatalf("expecting no credentials, got %v", s)
+				}
+				return
+			}
+
+			if !found {
+				t.Fatalf("expecting to find key %q but got nothing", "azuread")
+			}
+
+			if s != *tc.expected {
+				t.Fatalf("expecting credentials %v, got %v", *tc.expected, s)
+			}
+		})
+	}
+}
+
+func TestAddSafeAuthorization(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"credentials": []byte("token"),
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name string
+		auth *monitoringv1.SafeAuthorization
+
+		err bool
+	}{
+		{
+			name: "valid credentials",
+			auth: &monitoringv1.SafeAuthorization{
+				Credentials: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "credentials",
+				},
+			},
+		},
+		{
+			name: "basic type",
+			auth: &monitoringv1.SafeAuthorization{
+				Type: "Basic",
+				Credentials: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "credentials",
+				},
+			},
+
+			err: true,
+		},
+		{
+			name: "wrong key",
+			auth: &monitoringv1.SafeAuthorization{
+				Credentials: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+					Key:                  "invalid",
+				},
+			},
+
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStore(c.CoreV1(), c.CoreV1())
+
+			err := store.AddSafeAuthorization(context.Background(), "ns1", tc.auth, "auth")
+
+			if tc.err {
+				if err == nil {
+					t.Fatal("expecting error, got no error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error, got %q", err)
+			}
+
+			if s := store.BearerTokenAssets["auth"]; s != "token" {
+				t.Fatalf("expecting credentials %q, got %q", "token", s)
+			}
+		})
+	}
+}
+
+func TestAddProxyConfig(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "proxy",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"auth": []byte("Basic dXNlcjpwYXNz"),
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name string
+		pc   *monitoringv1.ProxyConfig
+
+		err bool
+	}{
+		{
+			name: "valid header",
+			pc: &monitoringv1.ProxyConfig{
+				ProxyConnectHeader: map[string]v1.SecretKeySelector{
+					"Proxy-Authorization": {
+						LocalObjectReference: v1.LocalObjectReference{Name: "proxy"},
+						Key:                  "auth",
+					},
+				},
+			},
+		},
+		{
+			name: "wrong key",
+			pc: &monitoringv1.ProxyConfig{
+				ProxyConnectHeader: map[string]v1.SecretKeySelector{
+					"Proxy-Authorization": {
+						LocalObjectReference: v1.LocalObjectReference{Name: "proxy"},
+						Key:                  "invalid",
+					},
+				},
+			},
+
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStore(c.CoreV1(), c.CoreV1())
+
+			err := store.AddProxyConfig(context.Background(), "ns1", tc.pc, "proxy")
+
+			if tc.err {
+				if err == nil {
+					t.Fatal("expecting error, got no error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error, got %q", err)
+			}
+
+			key := ProxyConnectHeaderKey("proxy", "Proxy-Authorization")
+			if s := store.BearerTokenAssets[key]; s != "Basic dXNlcjpwYXNz" {
+				t.Fatalf("expecting header value %q, got %q", "Basic dXNlcjpwYXNz", s)
+			}
+		})
+	}
+}
+
+func TestAddAuthorization(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"credentials": []byte("token"),
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name string
+		auth *monitoringv1.Authorization
+
+		err      bool
+		expected string
+	}{
+		{
+			name: "valid credentials",
+			auth: &monitoringv1.Authorization{
+				SafeAuthorization: monitoringv1.SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+						Key:                  "credentials",
+					},
+				},
+			},
+			expected: "token",
+		},
+		{
+			name: "credentials file",
+			auth: &monitoringv1.Authorization{
+				CredentialsFile: "/etc/token",
+			},
+		},
+		{
+			name: "credentials and credentials file",
+			auth: &monitoringv1.Authorization{
+				SafeAuthorization: monitoringv1.SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+						Key:                  "credentials",
+					},
+				},
+				CredentialsFile: "/etc/token",
+			},
+
+			err: true,
+		},
+		{
+			name: "wrong key",
+			auth: &monitoringv1.Authorization{
+				SafeAuthorization: monitoringv1.SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+						Key:                  "invalid",
+					},
+				},
+			},
+
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStore(c.CoreV1(), c.CoreV1())
+
+			err := store.AddAuthorization(context.Background(), "ns1", tc.auth, "auth")
+
+			if tc.err {
+				if err == nil {
+					t.Fatal("expecting error, got no error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expecting no error, got %q", err)
+			}
+
+			if s := store.BearerTokenAssets["auth"]; string(s) != tc.expected {
+				t.Fatalf("expecting credentials %q, got %q", tc.expected, s)
+			}
+		})
+	}
+}
+
 func TestAddTLSConfig(t *testing.T) {
 	c := fake.NewSimpleClientset(
 		&v1.ConfigMap{
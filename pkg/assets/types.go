@@ -21,6 +21,26 @@ type BasicAuthCredentials struct {
 	Password string
 }
 
+// OAuth2Credentials represents the client credentials of an OAuth2 client,
+// see https://tools.ietf.org/html/rfc6749#section-4.4.
+type OAuth2Credentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// SigV4Credentials represents the AWS credentials used to sign requests with
+// the Signature Version 4 process.
+type SigV4Credentials struct {
+	AccessKeyID string
+	SecretKeyID string
+}
+
+// AzureOAuthCredentials represents the client secret of an Azure Active
+// Directory application.
+type AzureOAuthCredentials struct {
+	ClientSecret string
+}
+
 // BearerToken represents a bearer token, see
 // https://tools.ietf.org/html/rfc6750.
 type BearerToken string
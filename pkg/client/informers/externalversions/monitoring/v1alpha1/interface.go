@@ -24,6 +24,10 @@ import (
 type Interface interface {
 	// AlertmanagerConfigs returns a AlertmanagerConfigInformer.
 	AlertmanagerConfigs() AlertmanagerConfigInformer
+	// ClusterAlertmanagerConfigs returns a ClusterAlertmanagerConfigInformer.
+	ClusterAlertmanagerConfigs() ClusterAlertmanagerConfigInformer
+	// PrometheusAgents returns a PrometheusAgentInformer.
+	PrometheusAgents() PrometheusAgentInformer
 }
 
 type version struct {
@@ -41,3 +45,13 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) AlertmanagerConfigs() AlertmanagerConfigInformer {
 	return &alertmanagerConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ClusterAlertmanagerConfigs returns a ClusterAlertmanagerConfigInformer.
+func (v *version) ClusterAlertmanagerConfigs() ClusterAlertmanagerConfigInformer {
+	return &clusterAlertmanagerConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// PrometheusAgents returns a PrometheusAgentInformer.
+func (v *version) PrometheusAgents() PrometheusAgentInformer {
+	return &prometheusAgentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -23,3 +23,15 @@ type AlertmanagerConfigListerExpansion interface{}
 // AlertmanagerConfigNamespaceListerExpansion allows custom methods to be added to
 // AlertmanagerConfigNamespaceLister.
 type AlertmanagerConfigNamespaceListerExpansion interface{}
+
+// ClusterAlertmanagerConfigListerExpansion allows custom methods to be added to
+// ClusterAlertmanagerConfigLister.
+type ClusterAlertmanagerConfigListerExpansion interface{}
+
+// PrometheusAgentListerExpansion allows custom methods to be added to
+// PrometheusAgentLister.
+type PrometheusAgentListerExpansion interface{}
+
+// PrometheusAgentNamespaceListerExpansion allows custom methods to be added to
+// PrometheusAgentNamespaceLister.
+type PrometheusAgentNamespaceListerExpansion interface{}
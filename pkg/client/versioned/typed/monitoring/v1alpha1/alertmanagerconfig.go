@@ -38,6 +38,7 @@ type AlertmanagerConfigsGetter interface {
 type AlertmanagerConfigInterface interface {
 	Create(ctx context.Context, alertmanagerConfig *v1alpha1.AlertmanagerConfig, opts v1.CreateOptions) (*v1alpha1.AlertmanagerConfig, error)
 	Update(ctx context.Context, alertmanagerConfig *v1alpha1.AlertmanagerConfig, opts v1.UpdateOptions) (*v1alpha1.AlertmanagerConfig, error)
+	UpdateStatus(ctx context.Context, alertmanagerConfig *v1alpha1.AlertmanagerConfig, opts v1.UpdateOptions) (*v1alpha1.AlertmanagerConfig, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.AlertmanagerConfig, error)
@@ -133,6 +134,22 @@ func (c *alertmanagerConfigs) Update(ctx context.Context, alertmanagerConfig *v1
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *alertmanagerConfigs) UpdateStatus(ctx context.Context, alertmanagerConfig *v1alpha1.AlertmanagerConfig, opts v1.UpdateOptions) (result *v1alpha1.AlertmanagerConfig, err error) {
+	result = &v1alpha1.AlertmanagerConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("alertmanagerconfigs").
+		Name(alertmanagerConfig.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(alertmanagerConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the alertmanagerConfig and deletes it. Returns an error if one occurs.
 func (c *alertmanagerConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
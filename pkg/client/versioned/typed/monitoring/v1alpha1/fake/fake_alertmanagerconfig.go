@@ -100,6 +100,18 @@ func (c *FakeAlertmanagerConfigs) Update(ctx context.Context, alertmanagerConfig
 	return obj.(*v1alpha1.AlertmanagerConfig), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAlertmanagerConfigs) UpdateStatus(ctx context.Context, alertmanagerConfig *v1alpha1.AlertmanagerConfig, opts v1.UpdateOptions) (*v1alpha1.AlertmanagerConfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(alertmanagerconfigsResource, "status", c.ns, alertmanagerConfig), &v1alpha1.AlertmanagerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AlertmanagerConfig), err
+}
+
 // Delete takes name of the alertmanagerConfig and deletes it. Returns an error if one occurs.
 func (c *FakeAlertmanagerConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
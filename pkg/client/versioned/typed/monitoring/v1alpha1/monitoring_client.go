@@ -25,6 +25,8 @@ import (
 type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
+	ClusterAlertmanagerConfigsGetter
+	PrometheusAgentsGetter
 }
 
 // MonitoringV1alpha1Client is used to interact with features provided by the monitoring.coreos.com group.
@@ -36,6 +38,14 @@ func (c *MonitoringV1alpha1Client) AlertmanagerConfigs(namespace string) Alertma
 	return newAlertmanagerConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) ClusterAlertmanagerConfigs() ClusterAlertmanagerConfigInterface {
+	return newClusterAlertmanagerConfigs(c)
+}
+
+func (c *MonitoringV1alpha1Client) PrometheusAgents(namespace string) PrometheusAgentInterface {
+	return newPrometheusAgents(c, namespace)
+}
+
 // NewForConfig creates a new MonitoringV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*MonitoringV1alpha1Client, error) {
 	config := *c
@@ -16,21 +16,29 @@ package k8sutil
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-version"
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/discovery"
+	clientappsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
 	clientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	clientpolicyv1beta1 "k8s.io/client-go/kubernetes/typed/policy/v1beta1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -38,6 +46,10 @@ import (
 // KubeConfigEnv (optionally) specify the location of kubeconfig file
 const KubeConfigEnv = "KUBECONFIG"
 
+// ContentHashAnnotation is the annotation holding the hash of the data of the
+// Secrets generated by the operator.
+const ContentHashAnnotation = "operator.prometheus.io/content-hash"
+
 var invalidDNS1123Characters = regexp.MustCompile("[^-a-z0-9]+")
 
 // PodRunningAndReady returns whether a pod is running and each container has
@@ -129,6 +141,70 @@ func CreateOrUpdateService(ctx context.Context, sclient clientv1.ServiceInterfac
 	return nil
 }
 
+// ContentHash returns the SHA-256 hash of the given data. The result doesn't
+// depend on the iteration order of the map.
+func ContentHash(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%d:%s%d:", len(k), k, len(data[k]))
+		h.Write(data[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// CreateOrUpdateSecret creates the Secret if it doesn't exist yet. Otherwise
+// the Secret is only updated when its data, labels or owner references have
+// changed which avoids needless API requests and configuration reloads. The
+// data is compared by hashing the live data of the Secret so that out-of-band
+// modifications are reverted. The annotations set by others on the live Secret
+// are preserved on update. It returns true if the Secret has been created or
+// updated.
+func CreateOrUpdateSecret(ctx context.Context, secretClient clientv1.SecretInterface, desired *v1.Secret) (bool, error) {
+	if desired.Annotations == nil {
+		desired.Annotations = map[string]string{}
+	}
+	desired.Annotations[ContentHashAnnotation] = ContentHash(desired.Data)
+
+	current, err := secretClient.Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, errors.Wrap(err, "retrieving secret object failed")
+	}
+
+	if apierrors.IsNotFound(err) {
+		if _, err = secretClient.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return false, errors.Wrap(err, "creating secret object failed")
+		}
+		return true, nil
+	}
+
+	ownerReferences := mergeOwnerReferences(current.GetOwnerReferences(), desired.GetOwnerReferences())
+	if ContentHash(current.Data) == desired.Annotations[ContentHashAnnotation] &&
+		reflect.DeepEqual(current.Labels, desired.Labels) &&
+		len(ownerReferences) == len(current.GetOwnerReferences()) {
+		return false, nil
+	}
+
+	for k, v := range current.Annotations {
+		if _, ok := desired.Annotations[k]; !ok {
+			desired.Annotations[k] = v
+		}
+	}
+	desired.ResourceVersion = current.ResourceVersion
+	desired.SetOwnerReferences(ownerReferences)
+	if _, err = secretClient.Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+		return false, errors.Wrap(err, "updating secret object failed")
+	}
+
+	return true, nil
+}
+
 func CreateOrUpdateEndpoints(ctx context.Context, eclient clientv1.EndpointsInterface, eps *v1.Endpoints) error {
 	endpoints, err := eclient.Get(ctx, eps.Name, metav1.GetOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -151,6 +227,52 @@ func CreateOrUpdateEndpoints(ctx context.Context, eclient clientv1.EndpointsInte
 	return nil
 }
 
+func CreateOrUpdatePodDisruptionBudget(ctx context.Context, pdbClient clientpolicyv1beta1.PodDisruptionBudgetInterface, pdb *policyv1beta1.PodDisruptionBudget) error {
+	current, err := pdbClient.Get(ctx, pdb.Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "retrieving pod disruption budget object failed")
+	}
+
+	if apierrors.IsNotFound(err) {
+		_, err = pdbClient.Create(ctx, pdb, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "creating pod disruption budget object failed")
+		}
+	} else {
+		pdb.ResourceVersion = current.ResourceVersion
+		pdb.SetOwnerReferences(mergeOwnerReferences(current.GetOwnerReferences(), pdb.GetOwnerReferences()))
+		_, err = pdbClient.Update(ctx, pdb, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "updating pod disruption budget object failed")
+		}
+	}
+
+	return nil
+}
+
+func CreateOrUpdateDaemonSet(ctx context.Context, dsClient clientappsv1.DaemonSetInterface, ds *appsv1.DaemonSet) error {
+	current, err := dsClient.Get(ctx, ds.Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "retrieving daemonset object failed")
+	}
+
+	if apierrors.IsNotFound(err) {
+		_, err = dsClient.Create(ctx, ds, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "creating daemonset object failed")
+		}
+	} else {
+		ds.ResourceVersion = current.ResourceVersion
+		ds.SetOwnerReferences(mergeOwnerReferences(current.GetOwnerReferences(), ds.GetOwnerReferences()))
+		_, err = dsClient.Update(ctx, ds, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "updating daemonset object failed")
+		}
+	}
+
+	return nil
+}
+
 // GetMinorVersion returns the minor version as an integer
 func GetMinorVersion(dclient discovery.DiscoveryInterface) (int, error) {
 	v, err := dclient.ServerVersion()
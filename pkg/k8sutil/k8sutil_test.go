@@ -15,10 +15,14 @@
 package k8sutil
 
 import (
+	"context"
 	"strings"
 	"testing"
 
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func Test_SanitizeVolumeName(t *testing.T) {
@@ -59,3 +63,124 @@ func Test_SanitizeVolumeName(t *testing.T) {
 		}
 	}
 }
+
+func TestContentHash(t *testing.T) {
+	h := ContentHash(map[string][]byte{"a": []byte("bc"), "d": []byte("e")})
+
+	if h2 := ContentHash(map[string][]byte{"d": []byte("e"), "a": []byte("bc")}); h != h2 {
+		t.Fatalf("expected the hash to be independent of the keys order, got %q and %q", h, h2)
+	}
+
+	if h2 := ContentHash(map[string][]byte{"a": []byte("b"), "cd": []byte("e")}); h == h2 {
+		t.Fatal("expected different data to produce different hashes")
+	}
+}
+
+func TestCreateOrUpdateSecret(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	sClient := client.CoreV1().Secrets("default")
+
+	makeSecret := func(data string, labels map[string]string) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+				Labels:    labels,
+			},
+			Data: map[string][]byte{"key": []byte(data)},
+		}
+	}
+
+	for _, step := range []struct {
+		name     string
+		modify   func(*v1.Secret)
+		secret   *v1.Secret
+		expected bool
+	}{
+		{
+			name:     "create",
+			secret:   makeSecret("foo", nil),
+			expected: true,
+		},
+		{
+			name:     "no change",
+			secret:   makeSecret("foo", nil),
+			expected: false,
+		},
+		{
+			name:     "data change",
+			secret:   makeSecret("bar", nil),
+			expected: true,
+		},
+		{
+			name:     "labels change",
+			secret:   makeSecret("bar", map[string]string{"foo": "bar"}),
+			expected: true,
+		},
+		{
+			name:     "no change after update",
+			secret:   makeSecret("bar", map[string]string{"foo": "bar"}),
+			expected: false,
+		},
+		{
+			name: "data modified out-of-band",
+			modify: func(s *v1.Secret) {
+				s.Data["key"] = []byte("baz")
+			},
+			secret:   makeSecret("bar", map[string]string{"foo": "bar"}),
+			expected: true,
+		},
+		{
+			name: "annotation added out-of-band",
+			modify: func(s *v1.Secret) {
+				s.Annotations["example.com/owner"] = "someone"
+			},
+			secret:   makeSecret("bar", map[string]string{"foo": "bar"}),
+			expected: false,
+		},
+		{
+			name:     "data change preserves annotations",
+			secret:   makeSecret("qux", map[string]string{"foo": "bar"}),
+			expected: true,
+		},
+	} {
+		if step.modify != nil {
+			current, err := sClient.Get(ctx, "test", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", step.name, err)
+			}
+			step.modify(current)
+			if _, err := sClient.Update(ctx, current, metav1.UpdateOptions{}); err != nil {
+				t.Fatalf("%s: unexpected error: %v", step.name, err)
+			}
+		}
+
+		updated, err := CreateOrUpdateSecret(ctx, sClient, step.secret)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+
+		if updated != step.expected {
+			t.Fatalf("%s: expected updated to be %v, got %v", step.name, step.expected, updated)
+		}
+
+		current, err := sClient.Get(ctx, "test", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+
+		if string(current.Data["key"]) != string(step.secret.Data["key"]) {
+			t.Fatalf("%s: expected data %q, got %q", step.name, step.secret.Data["key"], current.Data["key"])
+		}
+	}
+
+	current, err := sClient.Get(ctx, "test", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if current.Annotations["example.com/owner"] != "someone" {
+		t.Fatalf("expected the annotation set out-of-band to be preserved, got %v", current.Annotations)
+	}
+}
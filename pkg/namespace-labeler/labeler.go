@@ -15,6 +15,7 @@
 package namespacelabeler
 
 import (
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -64,6 +65,27 @@ func New(enforcedNsLabel string, excludeConfig []monitoringv1.PrometheusRuleExcl
 	}
 }
 
+// IsExcludedFromEnforcement returns true if the object identified by the
+// resource, namespace and name is part of the excluded objects. A reference
+// without name matches all the objects of the namespace.
+func IsExcludedFromEnforcement(excluded []monitoringv1.ObjectReference, resource, namespace, name string) bool {
+	for _, ref := range excluded {
+		if ref.Group != "" && ref.Group != monitoring.GroupName {
+			continue
+		}
+
+		if ref.Resource != resource || ref.Namespace != namespace {
+			continue
+		}
+
+		if ref.Name == "" || ref.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (l *Labeler) isExcludedRule(namespace, name string) bool {
 	if l.excludeList == nil {
 		return false
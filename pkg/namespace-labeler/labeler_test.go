@@ -221,3 +221,28 @@ func expand(r *promRuleFlat) monitoringv1.PrometheusRule {
 		},
 	}
 }
+
+func TestIsExcludedFromEnforcement(t *testing.T) {
+	excluded := []monitoringv1.ObjectReference{
+		{Resource: monitoringv1.PrometheusRuleName, Namespace: "monitoring", Name: "kubernetes"},
+		{Group: "monitoring.coreos.com", Resource: monitoringv1.PrometheusRuleName, Namespace: "kube-system"},
+		{Group: "example.com", Resource: monitoringv1.PrometheusRuleName, Namespace: "default"},
+	}
+
+	for _, tc := range []struct {
+		resource  string
+		namespace string
+		name      string
+		expected  bool
+	}{
+		{monitoringv1.PrometheusRuleName, "monitoring", "kubernetes", true},
+		{monitoringv1.PrometheusRuleName, "monitoring", "node-exporter", false},
+		{monitoringv1.ServiceMonitorName, "monitoring", "kubernetes", false},
+		{monitoringv1.PrometheusRuleName, "kube-system", "coredns", true},
+		{monitoringv1.PrometheusRuleName, "default", "app", false},
+	} {
+		if got := IsExcludedFromEnforcement(excluded, tc.resource, tc.namespace, tc.name); got != tc.expected {
+			t.Errorf("%s %s/%s: expected %v, got %v", tc.resource, tc.namespace, tc.name, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"fmt"
+	"strings"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// BuildArgs appends the additional arguments to the arguments generated by
+// the operator. The additional arguments use the same dash prefix as the
+// generated flags. It returns an error if an additional argument is already
+// managed by the operator, including its negated form (e.g. "no-foo" for
+// "foo").
+func BuildArgs(args []string, additionalArgs []monitoringv1.Argument) ([]string, error) {
+	if len(additionalArgs) == 0 {
+		return args, nil
+	}
+
+	var (
+		prefix    string
+		generated = map[string]struct{}{}
+	)
+	for _, arg := range args {
+		// Skip the positional arguments (e.g. sub-commands).
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		if prefix == "" {
+			prefix = "--"
+			if !strings.HasPrefix(arg, "--") {
+				prefix = "-"
+			}
+		}
+
+		generated[argName(arg)] = struct{}{}
+	}
+
+	if prefix == "" {
+		prefix = "--"
+	}
+
+	for _, a := range additionalArgs {
+		if _, found := generated[argName(a.Name)]; found {
+			return nil, fmt.Errorf("can't set arguments which are already managed by the operator: %s", a.Name)
+		}
+
+		arg := prefix + strings.TrimLeft(a.Name, "-")
+		if a.Value != "" {
+			arg += "=" + a.Value
+		}
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
+
+// argName returns the name of the flag without dashes, value and negation
+// prefix.
+func argName(arg string) string {
+	name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+	return strings.TrimPrefix(name, "no-")
+}
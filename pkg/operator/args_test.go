@@ -0,0 +1,107 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"reflect"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestBuildArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		args           []string
+		additionalArgs []monitoringv1.Argument
+		expected       []string
+		wantErr        bool
+	}{
+		{
+			name:     "no additional arguments",
+			args:     []string{"--web.listen-address=:9090"},
+			expected: []string{"--web.listen-address=:9090"},
+		},
+		{
+			name: "additional arguments",
+			args: []string{"--web.listen-address=:9090"},
+			additionalArgs: []monitoringv1.Argument{
+				{Name: "scrape.discovery-reload-interval", Value: "30s"},
+				{Name: "storage.tsdb.no-lockfile"},
+			},
+			expected: []string{
+				"--web.listen-address=:9090",
+				"--scrape.discovery-reload-interval=30s",
+				"--storage.tsdb.no-lockfile",
+			},
+		},
+		{
+			name: "single dash prefix",
+			args: []string{"-web.listen-address=:9093"},
+			additionalArgs: []monitoringv1.Argument{
+				{Name: "--log.level", Value: "debug"},
+			},
+			expected: []string{
+				"-web.listen-address=:9093",
+				"-log.level=debug",
+			},
+		},
+		{
+			name: "positional arguments",
+			args: []string{"rule", "--data-dir=/thanos/data"},
+			additionalArgs: []monitoringv1.Argument{
+				{Name: "rule-file", Value: "/etc/rules/*.yaml"},
+			},
+			expected: []string{
+				"rule",
+				"--data-dir=/thanos/data",
+				"--rule-file=/etc/rules/*.yaml",
+			},
+		},
+		{
+			name: "conflicting argument",
+			args: []string{"--web.listen-address=:9090"},
+			additionalArgs: []monitoringv1.Argument{
+				{Name: "web.listen-address", Value: ":8080"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "conflicting negated argument",
+			args: []string{"--web.enable-lifecycle"},
+			additionalArgs: []monitoringv1.Argument{
+				{Name: "no-web.enable-lifecycle"},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := BuildArgs(tc.args, tc.additionalArgs)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(args, tc.expected) {
+				t.Fatalf("expected arguments %v, got %v", tc.expected, args)
+			}
+		})
+	}
+}
@@ -15,8 +15,11 @@
 package operator
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
+	"github.com/prometheus/common/model"
 	"k8s.io/client-go/rest"
 )
 
@@ -42,12 +45,47 @@ type Config struct {
 	AlertManagerSelector         string
 	ThanosRulerSelector          string
 	SecretListWatchSelector      string
+	// AlertmanagerConfigAllowCrossNamespaceSecrets allows the receivers of
+	// AlertmanagerConfig resources to reference Secrets from any namespace.
+	AlertmanagerConfigAllowCrossNamespaceSecrets bool
+	// AlertmanagerConfigDefaultSendResolved is the default value of
+	// send_resolved for the receivers generated from AlertmanagerConfig
+	// resources. If nil, the Alertmanager defaults apply.
+	AlertmanagerConfigDefaultSendResolved *bool
+	// EnableAlertmanagerConfigRendering exposes the configuration generated
+	// for Alertmanager resources on the web server.
+	EnableAlertmanagerConfigRendering bool
 }
 
 type ReloaderConfig struct {
 	CPU    string
 	Memory string
 	Image  string
+	// DelayInterval is how long the reloader waits without detecting new
+	// changes before reloading. Zero means the reloader's default.
+	DelayInterval time.Duration
+	// MaxConcurrentReloads is the maximum number of replicas reloading at
+	// the same time. Zero means no limit.
+	MaxConcurrentReloads int
+}
+
+// WithThrottling returns a copy of the reloader configuration with the
+// delay interval and the maximum number of concurrent reloads overridden by
+// the given values when they aren't nil.
+func (c ReloaderConfig) WithThrottling(delayInterval *string, maxConcurrentReloads *int32) (ReloaderConfig, error) {
+	if delayInterval != nil {
+		d, err := model.ParseDuration(*delayInterval)
+		if err != nil {
+			return c, fmt.Errorf("invalid reload delay interval: %w", err)
+		}
+		c.DelayInterval = time.Duration(d)
+	}
+
+	if maxConcurrentReloads != nil {
+		c.MaxConcurrentReloads = int(*maxConcurrentReloads)
+	}
+
+	return c, nil
 }
 
 type Labels struct {
@@ -0,0 +1,74 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReloaderConfigWithThrottling(t *testing.T) {
+	defaultConfig := ReloaderConfig{
+		DelayInterval:        time.Second,
+		MaxConcurrentReloads: 2,
+	}
+	delay := "1m"
+	invalidDelay := "1 minute"
+	maxConcurrentReloads := int32(0)
+
+	for _, tc := range []struct {
+		name                 string
+		delayInterval        *string
+		maxConcurrentReloads *int32
+		expected             ReloaderConfig
+		wantErr              bool
+	}{
+		{
+			name:     "operator defaults",
+			expected: defaultConfig,
+		},
+		{
+			name:                 "overrides",
+			delayInterval:        &delay,
+			maxConcurrentReloads: &maxConcurrentReloads,
+			expected: ReloaderConfig{
+				DelayInterval:        time.Minute,
+				MaxConcurrentReloads: 0,
+			},
+		},
+		{
+			name:          "invalid delay interval",
+			delayInterval: &invalidDelay,
+			wantErr:       true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := defaultConfig.WithThrottling(tc.delayInterval, tc.maxConcurrentReloads)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if c != tc.expected {
+				t.Fatalf("expected %+v, got %+v", tc.expected, c)
+			}
+		})
+	}
+}
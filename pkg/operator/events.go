@@ -0,0 +1,140 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/reference"
+
+	monitoringscheme "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/scheme"
+)
+
+// EventRecorder emits Kubernetes events for the custom resources managed by
+// the operator.
+type EventRecorder interface {
+	Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...interface{})
+}
+
+const (
+	// eventCacheSize is the maximum number of events remembered by the
+	// recorder.
+	eventCacheSize = 4096
+	// eventCacheTTL matches the default time-to-live of events in the API
+	// server: an identical event is emitted again once the previous one has
+	// expired.
+	eventCacheTTL = time.Hour
+)
+
+// eventRecorder creates the events directly with the API client because the
+// client-go record package doesn't build against the klog implementation used
+// by the operator. It doesn't aggregate events like the record package does
+// but it drops the events identical to the last one emitted for the same
+// object, which is what happens when an invalid object is synced again and
+// again.
+type eventRecorder struct {
+	client    kubernetes.Interface
+	component string
+	logger    log.Logger
+
+	// cache maps an event key to the message of the last event emitted.
+	cache *cache.LRUExpireCache
+}
+
+type eventKey struct {
+	kind      string
+	namespace string
+	name      string
+	uid       types.UID
+	eventType string
+	reason    string
+}
+
+// NewEventRecorder returns a recorder emitting Kubernetes events for the
+// custom resources managed by the given controller.
+func NewEventRecorder(client kubernetes.Interface, name string, logger log.Logger) EventRecorder {
+	return &eventRecorder{
+		client:    client,
+		component: "prometheus-operator-" + name,
+		logger:    logger,
+		cache:     cache.NewLRUExpireCache(eventCacheSize),
+	}
+}
+
+// Eventf creates an event for the given object unless the last event emitted
+// for the object with the same type and reason had the same message. Failures
+// are logged and otherwise ignored since events are informational only.
+func (r *eventRecorder) Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	ref, err := reference.GetReference(monitoringscheme.Scheme, object)
+	if err != nil {
+		level.Warn(r.logger).Log("msg", "failed to get the object reference for the event", "reason", reason, "err", err)
+		return
+	}
+
+	message := fmt.Sprintf(messageFmt, args...)
+	key := eventKey{
+		kind:      ref.Kind,
+		namespace: ref.Namespace,
+		name:      ref.Name,
+		uid:       ref.UID,
+		eventType: eventType,
+		reason:    reason,
+	}
+	if last, ok := r.cache.Get(key); ok && last.(string) == message {
+		return
+	}
+
+	namespace := ref.Namespace
+	if namespace == "" {
+		// Events of cluster-scoped objects are stored in the default namespace.
+		namespace = metav1.NamespaceDefault
+	}
+
+	now := metav1.NewTime(time.Now())
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", ref.Name, now.UnixNano()),
+			Namespace: namespace,
+		},
+		InvolvedObject: *ref,
+		Reason:         reason,
+		Message:        message,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Type:           eventType,
+		Source:         v1.EventSource{Component: r.component},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := r.client.CoreV1().Events(namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		level.Warn(r.logger).Log("msg", "failed to create event", "reason", reason, "object", ref.Name, "err", err)
+		return
+	}
+
+	r.cache.Add(key, message, eventCacheTTL)
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestEventRecorderSkipsDuplicates(t *testing.T) {
+	kclient := fake.NewSimpleClientset()
+	recorder := NewEventRecorder(kclient, "test", log.NewNopLogger())
+
+	amc := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "ns",
+			UID:       "1",
+		},
+	}
+
+	recorder.Eventf(amc, v1.EventTypeWarning, "InvalidConfiguration", "rejected: %s", "first")
+	recorder.Eventf(amc, v1.EventTypeWarning, "InvalidConfiguration", "rejected: %s", "first")
+	recorder.Eventf(amc, v1.EventTypeWarning, "InvalidConfiguration", "rejected: %s", "second")
+
+	events, err := kclient.CoreV1().Events("ns").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(events.Items) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events.Items))
+	}
+
+	messages := map[string]struct{}{}
+	for _, e := range events.Items {
+		messages[e.Message] = struct{}{}
+	}
+	for _, msg := range []string{"rejected: first", "rejected: second"} {
+		if _, ok := messages[msg]; !ok {
+			t.Fatalf("expected an event with message %q", msg)
+		}
+	}
+}
@@ -43,6 +43,12 @@ var (
 		[]string{"resource", "state"},
 		nil,
 	)
+	configSizeDesc = prometheus.NewDesc(
+		"prometheus_operator_generated_config_size_bytes",
+		"Size in bytes of the configuration Secret generated by the operator's controller per object",
+		[]string{"namespace", "name"},
+		nil,
+	)
 )
 
 // Metrics represents metrics associated to an operator.
@@ -61,12 +67,17 @@ type Metrics struct {
 	// objects. It is split in the dimensions of Kubernetes objects and
 	// corresponding actions (add, delete, update).
 	triggerByCounter *prometheus.CounterVec
-	ready            prometheus.Gauge
+	// rejectedResourcesCounter keeps track of the number of times that
+	// resources have been rejected by the controller. It is split by resource
+	// kind, namespace and name.
+	rejectedResourcesCounter *prometheus.CounterVec
+	ready                    prometheus.Gauge
 
 	// mtx protects all fields below.
-	mtx       sync.RWMutex
-	syncs     map[string]bool
-	resources map[resourceKey]map[string]int
+	mtx         sync.RWMutex
+	syncs       map[string]bool
+	resources   map[resourceKey]map[string]int
+	configSizes map[string]int
 }
 
 type resourceKey struct {
@@ -93,6 +104,10 @@ func NewMetrics(name string, r prometheus.Registerer) *Metrics {
 			Help: "Number of times a Kubernetes object add, delete or update event" +
 				" triggered the Prometheus Operator to reconcile an object",
 		}, []string{"triggered_by", "action"}),
+		rejectedResourcesCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "prometheus_operator_rejected_resources_total",
+			Help: "Number of times that a resource has been rejected by the controller because of an invalid configuration",
+		}, []string{"resource", "namespace", "name"}),
 		stsDeleteCreateCounter: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "prometheus_operator_reconcile_sts_delete_create_total",
 			Help: "Number of times that reconciling a statefulset required deleting and re-creating it",
@@ -118,14 +133,16 @@ func NewMetrics(name string, r prometheus.Registerer) *Metrics {
 			Help: "1 when the controller is ready to reconcile resources, 0 otherwise",
 		}),
 
-		syncs:     make(map[string]bool),
-		resources: make(map[resourceKey]map[string]int),
+		syncs:       make(map[string]bool),
+		resources:   make(map[resourceKey]map[string]int),
+		configSizes: make(map[string]int),
 	}
 
 	m.reg.MustRegister(
 		m.reconcileCounter,
 		m.reconcileErrorsCounter,
 		m.triggerByCounter,
+		m.rejectedResourcesCounter,
 		m.stsDeleteCreateCounter,
 		m.listCounter,
 		m.listFailedCounter,
@@ -158,6 +175,16 @@ func (m *Metrics) TriggerByCounter(triggeredBy, action string) prometheus.Counte
 	return m.triggerByCounter.WithLabelValues(triggeredBy, action)
 }
 
+// RejectedResourceCounter returns a counter to track the rejections of a given resource.
+func (m *Metrics) RejectedResourceCounter(resource, namespace, name string) prometheus.Counter {
+	return m.rejectedResourcesCounter.WithLabelValues(resource, namespace, name)
+}
+
+// ForgetRejectedResource removes the rejection counter of a resource which doesn't exist anymore.
+func (m *Metrics) ForgetRejectedResource(resource, namespace, name string) {
+	m.rejectedResourcesCounter.DeleteLabelValues(resource, namespace, name)
+}
+
 const (
 	selected int = iota
 	rejected
@@ -205,6 +232,15 @@ func (m *Metrics) SetSyncStatus(objKey string, success bool) {
 	m.syncs[objKey] = success
 }
 
+// SetGeneratedConfigSize tracks the size in bytes of the configuration
+// Secret generated for the given object.
+func (m *Metrics) SetGeneratedConfigSize(objKey string, size int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.configSizes[objKey] = size
+}
+
 // ForgetObject removes the metrics tracked for the given object's key.
 // It should be called when the controller detects that the object has been deleted.
 func (m *Metrics) ForgetObject(objKey string) {
@@ -212,6 +248,7 @@ func (m *Metrics) ForgetObject(objKey string) {
 	defer m.mtx.Unlock()
 
 	delete(m.syncs, objKey)
+	delete(m.configSizes, objKey)
 
 	for k := range m.resources {
 		delete(m.resources[k], objKey)
@@ -232,6 +269,7 @@ func (m *Metrics) MustRegister(metrics ...prometheus.Collector) {
 func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	ch <- resourcesDesc
 	ch <- syncsDesc
+	ch <- configSizeDesc
 }
 
 // Collect implements the prometheus.Collector interface.
@@ -274,6 +312,20 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 			rKey.state.String(),
 		)
 	}
+
+	for objKey, size := range m.configSizes {
+		namespace, name, err := cache.SplitMetaNamespaceKey(objKey)
+		if err != nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(
+			configSizeDesc,
+			prometheus.GaugeValue,
+			float64(size),
+			namespace,
+			name,
+		)
+	}
 }
 
 type instrumentedListerWatcher struct {
@@ -26,9 +26,13 @@ const configReloaderPort = 8080
 
 // CreateConfigReloader returns the definition of the config-reloader
 // container. No shard environment variable will be passed to the reloader
-// container if `-1` is passed to the shards parameter.
+// container if `-1` is passed to the shards parameter. The reload URL isn't
+// passed when empty, e.g. when the reloader signals the process. The resources
+// override the CPU and memory settings of the reloader configuration when
+// not nil.
 func CreateConfigReloader(
 	config ReloaderConfig,
+	resources *v1.ResourceRequirements,
 	reloadURL url.URL,
 	listenLocal bool,
 	localHost string,
@@ -57,7 +61,17 @@ func CreateConfigReloader(
 	}
 	args = append(args, fmt.Sprintf("--listen-address=%s:%d", listenAddress, configReloaderPort))
 
-	args = append(args, fmt.Sprintf("--reload-url=%s", reloadURL.String()))
+	if reloadURL != (url.URL{}) {
+		args = append(args, fmt.Sprintf("--reload-url=%s", reloadURL.String()))
+	}
+
+	if config.DelayInterval > 0 {
+		args = append(args, fmt.Sprintf("--delay-interval=%s", config.DelayInterval))
+	}
+
+	if config.MaxConcurrentReloads > 0 {
+		args = append(args, fmt.Sprintf("--max-concurrent-reloads=%d", config.MaxConcurrentReloads))
+	}
 
 	if logLevel != "" && logLevel != "info" {
 		args = append(args, fmt.Sprintf("--log-level=%s", logLevel))
@@ -71,19 +85,6 @@ func CreateConfigReloader(
 		args = append(args, additionalArgs[i])
 	}
 
-	resources := v1.ResourceRequirements{
-		Limits:   v1.ResourceList{},
-		Requests: v1.ResourceList{},
-	}
-	if config.CPU != "0" {
-		resources.Limits[v1.ResourceCPU] = resource.MustParse(config.CPU)
-		resources.Requests[v1.ResourceCPU] = resource.MustParse(config.CPU)
-	}
-	if config.Memory != "0" {
-		resources.Limits[v1.ResourceMemory] = resource.MustParse(config.Memory)
-		resources.Requests[v1.ResourceMemory] = resource.MustParse(config.Memory)
-	}
-
 	return v1.Container{
 		Name:                     "config-reloader",
 		Image:                    config.Image,
@@ -104,6 +105,71 @@ func CreateConfigReloader(
 		Args:         args,
 		Ports:        ports,
 		VolumeMounts: volumeMounts,
-		Resources:    resources,
+		Resources:    configReloaderResources(config, resources),
 	}
 }
+
+// CreateInitConfigReloader returns the definition of the init-config-reloader
+// container. It generates the configuration file once and exits so that the
+// main container finds its configuration on startup instead of waiting for
+// the config-reloader sidecar.
+func CreateInitConfigReloader(
+	config ReloaderConfig,
+	resources *v1.ResourceRequirements,
+	logFormat, logLevel string,
+	additionalArgs []string,
+	volumeMounts []v1.VolumeMount,
+) v1.Container {
+	// A zero watch interval tells the reloader to write the configuration
+	// file without watching it.
+	args := []string{"--watch-interval=0"}
+
+	if logLevel != "" && logLevel != "info" {
+		args = append(args, fmt.Sprintf("--log-level=%s", logLevel))
+	}
+
+	if logFormat != "" && logFormat != "logfmt" {
+		args = append(args, fmt.Sprintf("--log-format=%s", logFormat))
+	}
+
+	args = append(args, additionalArgs...)
+
+	return v1.Container{
+		Name:                     "init-config-reloader",
+		Image:                    config.Image,
+		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+		Env: []v1.EnvVar{
+			{
+				Name: "POD_NAME",
+				ValueFrom: &v1.EnvVarSource{
+					FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"},
+				},
+			},
+		},
+		Command:      []string{"/bin/prometheus-config-reloader"},
+		Args:         args,
+		VolumeMounts: volumeMounts,
+		Resources:    configReloaderResources(config, resources),
+	}
+}
+
+func configReloaderResources(config ReloaderConfig, resources *v1.ResourceRequirements) v1.ResourceRequirements {
+	if resources != nil {
+		return *resources
+	}
+
+	r := v1.ResourceRequirements{
+		Limits:   v1.ResourceList{},
+		Requests: v1.ResourceList{},
+	}
+	if config.CPU != "0" {
+		r.Limits[v1.ResourceCPU] = resource.MustParse(config.CPU)
+		r.Requests[v1.ResourceCPU] = resource.MustParse(config.CPU)
+	}
+	if config.Memory != "0" {
+		r.Limits[v1.ResourceMemory] = resource.MustParse(config.Memory)
+		r.Requests[v1.ResourceMemory] = resource.MustParse(config.Memory)
+	}
+
+	return r
+}
@@ -36,3 +36,50 @@ func MakeVolumeClaimTemplate(e monitoringv1.EmbeddedPersistentVolumeClaim) *v1.P
 	}
 	return &pvc
 }
+
+// MakeProbe returns the probe given its default definition and the
+// parameters overridden by the user. It returns nil when the probe is
+// disabled or when there is no default definition.
+func MakeProbe(probe *v1.Probe, params *monitoringv1.ProbeParameters) *v1.Probe {
+	if params == nil {
+		return probe
+	}
+
+	if params.Disabled || probe == nil {
+		return nil
+	}
+
+	probe = probe.DeepCopy()
+	if params.InitialDelaySeconds != nil {
+		probe.InitialDelaySeconds = *params.InitialDelaySeconds
+	}
+	if params.TimeoutSeconds != nil {
+		probe.TimeoutSeconds = *params.TimeoutSeconds
+	}
+	if params.PeriodSeconds != nil {
+		probe.PeriodSeconds = *params.PeriodSeconds
+	}
+	if params.SuccessThreshold != nil {
+		probe.SuccessThreshold = *params.SuccessThreshold
+	}
+	if params.FailureThreshold != nil {
+		probe.FailureThreshold = *params.FailureThreshold
+	}
+
+	return probe
+}
+
+// MakeDNSPolicy returns the DNS policy of the pods. When the pods use the
+// host network and no policy is defined, ClusterFirstWithHostNet is returned
+// so that the cluster services can still be resolved.
+func MakeDNSPolicy(hostNetwork bool, policy *v1.DNSPolicy) v1.DNSPolicy {
+	if policy != nil {
+		return *policy
+	}
+
+	if hostNetwork {
+		return v1.DNSClusterFirstWithHostNet
+	}
+
+	return ""
+}
@@ -0,0 +1,408 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// prometheusFromAgent returns a Prometheus object equivalent to the given
+// PrometheusAgent. The configuration and statefulset generation switch to the
+// agent mode when they receive such an object (see isAgent()) which lets the
+// PrometheusAgent controller reuse the code of the Prometheus controller.
+func prometheusFromAgent(a *monitoringv1alpha1.PrometheusAgent) *monitoringv1.Prometheus {
+	a = a.DeepCopy()
+
+	return &monitoringv1.Prometheus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.PrometheusAgentsKind,
+		},
+		ObjectMeta: a.ObjectMeta,
+		Spec: monitoringv1.PrometheusSpec{
+			PodMetadata:                     a.Spec.PodMetadata,
+			ServiceMonitorSelector:          a.Spec.ServiceMonitorSelector,
+			ServiceMonitorNamespaceSelector: a.Spec.ServiceMonitorNamespaceSelector,
+			PodMonitorSelector:              a.Spec.PodMonitorSelector,
+			PodMonitorNamespaceSelector:     a.Spec.PodMonitorNamespaceSelector,
+			ProbeSelector:                   a.Spec.ProbeSelector,
+			ProbeNamespaceSelector:          a.Spec.ProbeNamespaceSelector,
+			ServiceDiscoveryRole:            a.Spec.ServiceDiscoveryRole,
+			Version:                         a.Spec.Version,
+			Paused:                          a.Spec.Paused,
+			Image:                           a.Spec.Image,
+			ImagePullSecrets:                a.Spec.ImagePullSecrets,
+			ImagePullPolicy:                 a.Spec.ImagePullPolicy,
+			Replicas:                        a.Spec.Replicas,
+			Shards:                          a.Spec.Shards,
+			ReplicaExternalLabelName:        a.Spec.ReplicaExternalLabelName,
+			PrometheusExternalLabelName:     a.Spec.PrometheusExternalLabelName,
+			WALCompression:                  a.Spec.WALCompression,
+			WALSegmentSize:                  a.Spec.WALSegmentSize,
+			RemoteWriteFlushDeadline:        a.Spec.RemoteWriteFlushDeadline,
+			LogLevel:                        a.Spec.LogLevel,
+			LogFormat:                       a.Spec.LogFormat,
+			ScrapeInterval:                  a.Spec.ScrapeInterval,
+			ScrapeTimeout:                   a.Spec.ScrapeTimeout,
+			ScrapeProtocols:                 a.Spec.ScrapeProtocols,
+			EnableFeatures:                  a.Spec.EnableFeatures,
+			ScrapeFailureLogFile:            a.Spec.ScrapeFailureLogFile,
+			ExternalLabels:                  a.Spec.ExternalLabels,
+			ExternalURL:                     a.Spec.ExternalURL,
+			RoutePrefix:                     a.Spec.RoutePrefix,
+			Storage:                         a.Spec.Storage,
+			Volumes:                         a.Spec.Volumes,
+			VolumeMounts:                    a.Spec.VolumeMounts,
+			Web:                             a.Spec.Web,
+			Resources:                       a.Spec.Resources,
+			ConfigReloaderResources:         a.Spec.ConfigReloaderResources,
+			ReloadStrategy:                  a.Spec.ReloadStrategy,
+			ReloadDelayInterval:             a.Spec.ReloadDelayInterval,
+			MaxConcurrentReloads:            a.Spec.MaxConcurrentReloads,
+			NodeSelector:                    a.Spec.NodeSelector,
+			ServiceAccountName:              a.Spec.ServiceAccountName,
+			AutomountServiceAccountToken:    a.Spec.AutomountServiceAccountToken,
+			Secrets:                         a.Spec.Secrets,
+			ConfigMaps:                      a.Spec.ConfigMaps,
+			Affinity:                        a.Spec.Affinity,
+			Tolerations:                     a.Spec.Tolerations,
+			TopologySpreadConstraints:       a.Spec.TopologySpreadConstraints,
+			HostAliases:                     a.Spec.HostAliases,
+			DNSPolicy:                       a.Spec.DNSPolicy,
+			DNSConfig:                       a.Spec.DNSConfig,
+			HostNetwork:                     a.Spec.HostNetwork,
+			RemoteWrite:                     a.Spec.RemoteWrite,
+			SecurityContext:                 a.Spec.SecurityContext,
+			ListenLocal:                     a.Spec.ListenLocal,
+			LivenessProbe:                   a.Spec.LivenessProbe,
+			ReadinessProbe:                  a.Spec.ReadinessProbe,
+			StartupProbe:                    a.Spec.StartupProbe,
+			Containers:                      a.Spec.Containers,
+			InitContainers:                  a.Spec.InitContainers,
+			AdditionalArgs:                  a.Spec.AdditionalArgs,
+			AdditionalScrapeConfigs:         a.Spec.AdditionalScrapeConfigs,
+			APIServerConfig:                 a.Spec.APIServerConfig,
+			PriorityClassName:               a.Spec.PriorityClassName,
+			PortName:                        a.Spec.PortName,
+			ArbitraryFSAccessThroughSMs:     a.Spec.ArbitraryFSAccessThroughSMs,
+			OverrideHonorLabels:             a.Spec.OverrideHonorLabels,
+			OverrideHonorTimestamps:         a.Spec.OverrideHonorTimestamps,
+			IgnoreNamespaceSelectors:        a.Spec.IgnoreNamespaceSelectors,
+			EnforcedNamespaceLabel:          a.Spec.EnforcedNamespaceLabel,
+			ExcludedFromEnforcement:         a.Spec.ExcludedFromEnforcement,
+			EnforcedSampleLimit:             a.Spec.EnforcedSampleLimit,
+			EnforcedTargetLimit:             a.Spec.EnforcedTargetLimit,
+			EnforcedBodySizeLimit:           a.Spec.EnforcedBodySizeLimit,
+			EnforcedLabelLimit:              a.Spec.EnforcedLabelLimit,
+			EnforcedLabelNameLengthLimit:    a.Spec.EnforcedLabelNameLengthLimit,
+			EnforcedLabelValueLengthLimit:   a.Spec.EnforcedLabelValueLengthLimit,
+			EnforcedKeepDroppedTargets:      a.Spec.EnforcedKeepDroppedTargets,
+		},
+	}
+}
+
+func (c *Operator) handleAgentAdd(obj interface{}) {
+	key, ok := c.keyFunc(obj)
+	if !ok {
+		return
+	}
+
+	level.Debug(c.logger).Log("msg", "PrometheusAgent added", "key", key)
+	c.metrics.TriggerByCounter(monitoringv1alpha1.PrometheusAgentsKind, "add").Inc()
+	c.agentQueue.Add(key)
+}
+
+func (c *Operator) handleAgentDelete(obj interface{}) {
+	key, ok := c.keyFunc(obj)
+	if !ok {
+		return
+	}
+
+	level.Debug(c.logger).Log("msg", "PrometheusAgent deleted", "key", key)
+	c.metrics.TriggerByCounter(monitoringv1alpha1.PrometheusAgentsKind, "delete").Inc()
+	c.agentQueue.Add(key)
+}
+
+func (c *Operator) handleAgentUpdate(old, cur interface{}) {
+	if old.(*monitoringv1alpha1.PrometheusAgent).ResourceVersion == cur.(*monitoringv1alpha1.PrometheusAgent).ResourceVersion {
+		return
+	}
+
+	key, ok := c.keyFunc(cur)
+	if !ok {
+		return
+	}
+
+	level.Debug(c.logger).Log("msg", "PrometheusAgent updated", "key", key)
+	c.metrics.TriggerByCounter(monitoringv1alpha1.PrometheusAgentsKind, "update").Inc()
+	c.agentQueue.Add(key)
+}
+
+// enqueueAgentForStatefulSet enqueues the PrometheusAgent controlling the
+// given statefulset if any.
+func (c *Operator) enqueueAgentForStatefulSet(sset metav1.Object) {
+	ref := metav1.GetControllerOf(sset)
+	if ref == nil || ref.Kind != monitoringv1alpha1.PrometheusAgentsKind {
+		return
+	}
+
+	c.agentQueue.Add(fmt.Sprintf("%s/%s", sset.GetNamespace(), ref.Name))
+}
+
+// enqueueAgentsForNamespace enqueues all PrometheusAgent object keys that
+// belong to the given namespace or select objects in the given namespace.
+func (c *Operator) enqueueAgentsForNamespace(ns *v1.Namespace) {
+	err := c.agentInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		a := obj.(*monitoringv1alpha1.PrometheusAgent)
+		if a.Namespace == ns.Name {
+			c.enqueueAgent(a)
+			return
+		}
+
+		for _, selector := range []*metav1.LabelSelector{
+			a.Spec.ServiceMonitorNamespaceSelector,
+			a.Spec.PodMonitorNamespaceSelector,
+			a.Spec.ProbeNamespaceSelector,
+		} {
+			nsSelector, err := metav1.LabelSelectorAsSelector(selector)
+			if err != nil {
+				level.Error(c.logger).Log(
+					"msg", fmt.Sprintf("failed to convert namespace selector of %q to selector", a.Name),
+					"err", err,
+				)
+				return
+			}
+
+			if nsSelector.Matches(labels.Set(ns.Labels)) {
+				c.enqueueAgent(a)
+				return
+			}
+		}
+	})
+	if err != nil {
+		level.Error(c.logger).Log(
+			"msg", "listing all PrometheusAgent instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+func (c *Operator) enqueueAgent(a *monitoringv1alpha1.PrometheusAgent) {
+	key, ok := c.keyFunc(a)
+	if !ok {
+		return
+	}
+
+	c.agentQueue.Add(key)
+}
+
+// agentWorker runs a worker thread processing the PrometheusAgent queue.
+func (c *Operator) agentWorker(ctx context.Context) {
+	for c.processNextAgentWorkItem(ctx) {
+	}
+}
+
+func (c *Operator) processNextAgentWorkItem(ctx context.Context) bool {
+	key, quit := c.agentQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.agentQueue.Done(key)
+
+	c.metrics.ReconcileCounter().Inc()
+	err := c.syncAgent(ctx, key.(string))
+	if err == nil {
+		c.agentQueue.Forget(key)
+		return true
+	}
+
+	c.metrics.ReconcileErrorsCounter().Inc()
+	utilruntime.HandleError(errors.Wrap(err, fmt.Sprintf("Sync PrometheusAgent %q failed", key)))
+	c.agentQueue.AddRateLimited(key)
+
+	return true
+}
+
+func (c *Operator) syncAgent(ctx context.Context, key string) error {
+	aobj, err := c.agentInfs.Get(key)
+
+	if apierrors.IsNotFound(err) {
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	a := aobj.(*monitoringv1alpha1.PrometheusAgent)
+	if a.Spec.Paused {
+		return nil
+	}
+
+	if len(a.Spec.RemoteWrite) == 0 {
+		return errors.New("at least one remote write endpoint is required")
+	}
+
+	level.Info(c.logger).Log("msg", "sync prometheus agent", "key", key)
+
+	p := prometheusFromAgent(a)
+	if a.Spec.Mode == monitoringv1alpha1.DaemonSetMode {
+		if err := validateDaemonSetMode(a.Spec); err != nil {
+			return errors.Wrap(err, "invalid DaemonSet mode")
+		}
+
+		return c.reconcileDaemonSet(ctx, p)
+	}
+
+	// Remove the DaemonSet left over when switching from the DaemonSet mode.
+	if err := c.deleteAgentDaemonSet(ctx, p); err != nil {
+		return err
+	}
+
+	return c.reconcile(ctx, key, p, nil)
+}
+
+// validateDaemonSetMode checks that the PrometheusAgent spec doesn't use
+// fields which are incompatible with the DaemonSet mode.
+func validateDaemonSetMode(spec monitoringv1alpha1.PrometheusAgentSpec) error {
+	switch {
+	case spec.Replicas != nil:
+		return errors.New("replicas can't be set")
+	case spec.Shards != nil:
+		return errors.New("shards can't be set")
+	case spec.ServiceMonitorSelector != nil:
+		return errors.New("serviceMonitorSelector can't be set, only PodMonitors are supported")
+	case spec.ProbeSelector != nil:
+		return errors.New("probeSelector can't be set, only PodMonitors are supported")
+	case spec.Storage != nil && spec.Storage.EmptyDir == nil:
+		return errors.New("only emptyDir storage is supported")
+	}
+
+	return nil
+}
+
+// reconcileDaemonSet creates or updates the configuration and the DaemonSet
+// of a PrometheusAgent running in DaemonSet mode.
+func (c *Operator) reconcileDaemonSet(ctx context.Context, p *monitoringv1.Prometheus) error {
+	assetStore := assets.NewStore(c.kclient.CoreV1(), c.kclient.CoreV1())
+
+	if err := c.createOrUpdateConfigurationSecret(ctx, p, nil, assetStore, true); err != nil {
+		return errors.Wrap(err, "creating config failed")
+	}
+
+	if err := c.createOrUpdateTLSAssetSecret(ctx, p, assetStore); err != nil {
+		return errors.Wrap(err, "creating tls asset secret failed")
+	}
+
+	ds, err := makeDaemonSet(*p, &c.config)
+	if err != nil {
+		return errors.Wrap(err, "making daemonset failed")
+	}
+
+	if err := k8sutil.CreateOrUpdateDaemonSet(ctx, c.kclient.AppsV1().DaemonSets(p.Namespace), ds); err != nil {
+		return errors.Wrap(err, "synchronizing daemonset failed")
+	}
+
+	// Remove the StatefulSets left over when switching from the StatefulSet
+	// mode.
+	ssetClient := c.kclient.AppsV1().StatefulSets(p.Namespace)
+	return c.ssetInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prometheusNameLabelName: p.Name}), func(obj interface{}) {
+		s := obj.(*appsv1.StatefulSet)
+		if !metav1.IsControlledBy(s, p) {
+			return
+		}
+
+		propagationPolicy := metav1.DeletePropagationForeground
+		if err := ssetClient.Delete(ctx, s.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil && !apierrors.IsNotFound(err) {
+			level.Error(c.logger).Log("msg", "failed to delete StatefulSet to cleanup", "err", err)
+		}
+	})
+}
+
+// deleteAgentDaemonSet deletes the DaemonSet of the given PrometheusAgent if
+// it exists.
+func (c *Operator) deleteAgentDaemonSet(ctx context.Context, p *monitoringv1.Prometheus) error {
+	dsClient := c.kclient.AppsV1().DaemonSets(p.Namespace)
+
+	ds, err := dsClient.Get(ctx, prefixedName(p), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "retrieving daemonset failed")
+	}
+
+	if !metav1.IsControlledBy(ds, p) {
+		return nil
+	}
+
+	propagationPolicy := metav1.DeletePropagationForeground
+	if err := dsClient.Delete(ctx, ds.Name, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "deleting daemonset failed")
+	}
+
+	return nil
+}
+
+// makeDaemonSet returns the DaemonSet of a PrometheusAgent running in
+// DaemonSet mode. The pod template is the same as in StatefulSet mode except
+// that the config reloader gets the node name to expand the pod discovery
+// selectors of the configuration.
+func makeDaemonSet(p monitoringv1.Prometheus, config *operator.Config) (*appsv1.DaemonSet, error) {
+	sset, err := makeStatefulSet(prefixedName(&p), p, config, nil, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(sset.Annotations, sSetInputHashName)
+
+	template := sset.Spec.Template
+	for i, container := range template.Spec.Containers {
+		if container.Name != "config-reloader" {
+			continue
+		}
+
+		template.Spec.Containers[i].Env = append(container.Env, v1.EnvVar{
+			Name: "NODE_NAME",
+			ValueFrom: &v1.EnvVarSource{
+				FieldRef: &v1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+			},
+		})
+	}
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: sset.ObjectMeta,
+		Spec: appsv1.DaemonSetSpec{
+			Selector: sset.Spec.Selector,
+			Template: template,
+		},
+	}, nil
+}
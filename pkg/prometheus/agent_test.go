@@ -0,0 +1,346 @@
+// Copyright 2021 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/kylelemons/godebug/pretty"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func makeTestAgent(version string) *monitoringv1alpha1.PrometheusAgent {
+	return &monitoringv1alpha1.PrometheusAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			UID:       "agent-uid",
+		},
+		Spec: monitoringv1alpha1.PrometheusAgentSpec{
+			Version: version,
+			RemoteWrite: []monitoringv1.RemoteWriteSpec{{
+				URL: "https://example.com/remote_write",
+			}},
+		},
+	}
+}
+
+func TestAgentConfig(t *testing.T) {
+	cg := newConfigGenerator(log.NewNopLogger())
+	cfg, err := cg.generateConfig(
+		prometheusFromAgent(makeTestAgent("v2.32.0")),
+		nil,
+		nil,
+		nil,
+		map[string]assets.BasicAuthCredentials{},
+		map[string]assets.BearerToken{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `global:
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+scrape_configs: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+`
+
+	result := string(cfg)
+	if expected != result {
+		fmt.Println(pretty.Compare(expected, result))
+		t.Fatal("expected Prometheus agent configuration and actual configuration do not match")
+	}
+}
+
+func TestAgentEnforcedLimits(t *testing.T) {
+	limit := func(v uint64) *uint64 { return &v }
+
+	a := makeTestAgent("v2.47.0")
+	a.Spec.EnforcedSampleLimit = limit(1000)
+	a.Spec.EnforcedTargetLimit = limit(100)
+	a.Spec.EnforcedBodySizeLimit = "10MB"
+	a.Spec.EnforcedLabelLimit = limit(50)
+	a.Spec.EnforcedLabelNameLengthLimit = limit(60)
+	a.Spec.EnforcedLabelValueLengthLimit = limit(70)
+	a.Spec.EnforcedKeepDroppedTargets = limit(10)
+
+	expected := monitoringv1.PrometheusSpec{
+		EnforcedSampleLimit:           limit(1000),
+		EnforcedTargetLimit:           limit(100),
+		EnforcedBodySizeLimit:         "10MB",
+		EnforcedLabelLimit:            limit(50),
+		EnforcedLabelNameLengthLimit:  limit(60),
+		EnforcedLabelValueLengthLimit: limit(70),
+		EnforcedKeepDroppedTargets:    limit(10),
+	}
+
+	spec := prometheusFromAgent(a).Spec
+	actual := monitoringv1.PrometheusSpec{
+		EnforcedSampleLimit:           spec.EnforcedSampleLimit,
+		EnforcedTargetLimit:           spec.EnforcedTargetLimit,
+		EnforcedBodySizeLimit:         spec.EnforcedBodySizeLimit,
+		EnforcedLabelLimit:            spec.EnforcedLabelLimit,
+		EnforcedLabelNameLengthLimit:  spec.EnforcedLabelNameLengthLimit,
+		EnforcedLabelValueLengthLimit: spec.EnforcedLabelValueLengthLimit,
+		EnforcedKeepDroppedTargets:    spec.EnforcedKeepDroppedTargets,
+	}
+
+	if diff := pretty.Compare(expected, actual); diff != "" {
+		t.Fatalf("unexpected enforced limits:\n%s", diff)
+	}
+}
+
+func TestAgentStatefulSet(t *testing.T) {
+	p := prometheusFromAgent(makeTestAgent("v2.32.0"))
+	names := expectedStatefulSetShardNames(p)
+	if len(names) != 1 || names[0] != "prom-agent-test" {
+		t.Fatalf("expected statefulset names to be [prom-agent-test], got %v", names)
+	}
+
+	sset, err := makeStatefulSet(names[0], *p, defaultTestConfig, nil, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !metav1.IsControlledBy(sset, p) {
+		t.Fatalf("expected statefulset to be controlled by the PrometheusAgent, got %v", sset.OwnerReferences)
+	}
+	if ref := sset.OwnerReferences[0]; ref.Kind != monitoringv1alpha1.PrometheusAgentsKind {
+		t.Fatalf("expected owner kind %q, got %q", monitoringv1alpha1.PrometheusAgentsKind, ref.Kind)
+	}
+
+	if sset.Spec.ServiceName != agentGoverningServiceName {
+		t.Fatalf("expected service name %q, got %q", agentGoverningServiceName, sset.Spec.ServiceName)
+	}
+	if app := sset.Spec.Selector.MatchLabels["app"]; app != "prometheus-agent" {
+		t.Fatalf("expected app selector label to be prometheus-agent, got %q", app)
+	}
+	if secret := sset.Spec.Template.Spec.Volumes[0].Secret.SecretName; secret != "prom-agent-test" {
+		t.Fatalf("expected config secret prom-agent-test, got %q", secret)
+	}
+
+	promArgs := sset.Spec.Template.Spec.Containers[0].Args
+	contains := func(arg string) bool {
+		for _, flag := range promArgs {
+			if flag == arg {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, arg := range []string{"--enable-feature=agent", "--storage.agent.path=/prometheus"} {
+		if !contains(arg) {
+			t.Fatalf("expected Prometheus args to contain %v, but got %v", arg, promArgs)
+		}
+	}
+	for _, arg := range []string{"--storage.tsdb.path=/prometheus", "--storage.tsdb.retention.time=24h", "--web.console.templates=/etc/prometheus/consoles"} {
+		if contains(arg) {
+			t.Fatalf("expected Prometheus args to NOT contain %v, but got %v", arg, promArgs)
+		}
+	}
+
+	svc := makeStatefulSetService(p, *defaultTestConfig)
+	if svc.Name != agentGoverningServiceName || svc.Spec.Selector["app"] != "prometheus-agent" {
+		t.Fatalf("unexpected governing service %s with selector %v", svc.Name, svc.Spec.Selector)
+	}
+}
+
+func TestAgentUnsupportedVersion(t *testing.T) {
+	p := prometheusFromAgent(makeTestAgent("v2.31.0"))
+	if _, err := makeStatefulSet("prom-agent-test", *p, defaultTestConfig, nil, "", 0); err == nil {
+		t.Fatal("expected an error for Prometheus versions without agent mode")
+	}
+}
+
+func TestAgentDaemonSetConfig(t *testing.T) {
+	cg := newConfigGenerator(log.NewNopLogger())
+	a := makeTestAgent("v2.32.0")
+	a.Spec.Mode = monitoringv1alpha1.DaemonSetMode
+
+	cfg, err := cg.generateConfig(
+		prometheusFromAgent(a),
+		nil,
+		map[string]*monitoringv1.PodMonitor{
+			"podmonitor": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testpodmonitor1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PodMonitorSpec{
+					PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+						{
+							Port: "web",
+						},
+					},
+				},
+			},
+		},
+		nil,
+		map[string]assets.BasicAuthCredentials{},
+		map[string]assets.BearerToken{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		true,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `  kubernetes_sd_configs:
+  - role: pod
+    namespaces:
+      names:
+      - default
+    selectors:
+    - role: pod
+      field: spec.nodeName=$(NODE_NAME)
+`
+
+	result := string(cfg)
+	if !strings.Contains(result, expected) {
+		fmt.Println(pretty.Compare(expected, result))
+		t.Fatal("expected the pod discovery to be restricted to the local node")
+	}
+}
+
+func TestAgentDaemonSet(t *testing.T) {
+	a := makeTestAgent("v2.32.0")
+	a.Spec.Mode = monitoringv1alpha1.DaemonSetMode
+	p := prometheusFromAgent(a)
+
+	ds, err := makeDaemonSet(*p, defaultTestConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ds.Name != "prom-agent-test" {
+		t.Fatalf("expected daemonset name prom-agent-test, got %q", ds.Name)
+	}
+	if !metav1.IsControlledBy(ds, p) {
+		t.Fatalf("expected daemonset to be controlled by the PrometheusAgent, got %v", ds.OwnerReferences)
+	}
+	if _, found := ds.Annotations[sSetInputHashName]; found {
+		t.Fatalf("expected daemonset to have no %s annotation", sSetInputHashName)
+	}
+
+	var found bool
+	for _, c := range ds.Spec.Template.Spec.Containers {
+		if c.Name != "config-reloader" {
+			continue
+		}
+
+		for _, env := range c.Env {
+			if env.Name == "NODE_NAME" && env.ValueFrom != nil && env.ValueFrom.FieldRef != nil && env.ValueFrom.FieldRef.FieldPath == "spec.nodeName" {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Fatal("expected the config-reloader container to have the NODE_NAME environment variable")
+	}
+}
+
+func TestValidateDaemonSetMode(t *testing.T) {
+	replicas := int32(2)
+
+	for _, tc := range []struct {
+		name    string
+		spec    func(*monitoringv1alpha1.PrometheusAgentSpec)
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: func(s *monitoringv1alpha1.PrometheusAgentSpec) {
+				s.PodMonitorSelector = &metav1.LabelSelector{}
+				s.Storage = &monitoringv1.StorageSpec{EmptyDir: &v1.EmptyDirVolumeSource{}}
+			},
+		},
+		{
+			name: "replicas",
+			spec: func(s *monitoringv1alpha1.PrometheusAgentSpec) {
+				s.Replicas = &replicas
+			},
+			wantErr: true,
+		},
+		{
+			name: "shards",
+			spec: func(s *monitoringv1alpha1.PrometheusAgentSpec) {
+				s.Shards = &replicas
+			},
+			wantErr: true,
+		},
+		{
+			name: "service monitors",
+			spec: func(s *monitoringv1alpha1.PrometheusAgentSpec) {
+				s.ServiceMonitorSelector = &metav1.LabelSelector{}
+			},
+			wantErr: true,
+		},
+		{
+			name: "probes",
+			spec: func(s *monitoringv1alpha1.PrometheusAgentSpec) {
+				s.ProbeSelector = &metav1.LabelSelector{}
+			},
+			wantErr: true,
+		},
+		{
+			name: "persistent storage",
+			spec: func(s *monitoringv1alpha1.PrometheusAgentSpec) {
+				s.Storage = &monitoringv1.StorageSpec{VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{}}
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := makeTestAgent("v2.32.0")
+			a.Spec.Mode = monitoringv1alpha1.DaemonSetMode
+			tc.spec(&a.Spec)
+
+			err := validateDaemonSetMode(a.Spec)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
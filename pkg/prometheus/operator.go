@@ -16,7 +16,6 @@ package prometheus
 
 import (
 	"bytes"
-	"compress/gzip"
 	"context"
 	"fmt"
 	"reflect"
@@ -25,6 +24,7 @@ import (
 	"time"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
 	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
@@ -37,13 +37,16 @@ import (
 	"github.com/mitchellh/hashstructure"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -65,6 +68,7 @@ type Operator struct {
 	nsMonInf  cache.SharedIndexInformer
 
 	promInfs  *informers.ForResource
+	agentInfs *informers.ForResource
 	smonInfs  *informers.ForResource
 	pmonInfs  *informers.ForResource
 	probeInfs *informers.ForResource
@@ -73,7 +77,8 @@ type Operator struct {
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
 
-	queue workqueue.RateLimitingInterface
+	queue      workqueue.RateLimitingInterface
+	agentQueue workqueue.RateLimitingInterface
 
 	metrics *operator.Metrics
 
@@ -135,6 +140,7 @@ func New(ctx context.Context, conf operator.Config, logger log.Logger, r prometh
 		mclient:                mclient,
 		logger:                 logger,
 		queue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "prometheus"),
+		agentQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "prometheusagent"),
 		host:                   cfg.Host,
 		kubeletObjectName:      kubeletObjectName,
 		kubeletObjectNamespace: kubeletObjectNamespace,
@@ -179,6 +185,22 @@ func New(ctx context.Context, conf operator.Config, logger log.Logger, r prometh
 	}
 	c.metrics.MustRegister(NewPrometheusCollectorForStores(promStores...))
 
+	c.agentInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.config.Namespaces.PrometheusAllowList,
+			c.config.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.config.PromSelector
+			},
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.PrometheusAgentName),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating prometheusagent informers")
+	}
+
 	c.smonInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.config.Namespaces.AllowList,
@@ -321,6 +343,7 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		informersForResource *informers.ForResource
 	}{
 		{"Prometheus", c.promInfs},
+		{"PrometheusAgent", c.agentInfs},
 		{"ServiceMonitor", c.smonInfs},
 		{"PodMonitor", c.pmonInfs},
 		{"PrometheusRule", c.ruleInfs},
@@ -364,6 +387,12 @@ func (c *Operator) addHandlers() {
 		UpdateFunc: c.handlePrometheusUpdate,
 	})
 
+	c.agentInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleAgentAdd,
+		DeleteFunc: c.handleAgentDelete,
+		UpdateFunc: c.handleAgentUpdate,
+	})
+
 	c.smonInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handleSmonAdd,
 		DeleteFunc: c.handleSmonDelete,
@@ -405,6 +434,7 @@ func (c *Operator) addHandlers() {
 // Run the controller.
 func (c *Operator) Run(ctx context.Context) error {
 	defer c.queue.ShutDown()
+	defer c.agentQueue.ShutDown()
 
 	errChan := make(chan error)
 	go func() {
@@ -428,8 +458,10 @@ func (c *Operator) Run(ctx context.Context) error {
 	}
 
 	go c.worker(ctx)
+	go c.agentWorker(ctx)
 
 	go c.promInfs.Start(ctx.Done())
+	go c.agentInfs.Start(ctx.Done())
 	go c.smonInfs.Start(ctx.Done())
 	go c.pmonInfs.Start(ctx.Done())
 	go c.probeInfs.Start(ctx.Done())
@@ -1010,6 +1042,7 @@ func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
 		)
 	}
 
+	c.enqueueAgentsForNamespace(ns)
 }
 
 // worker runs a worker thread that just dequeues items, processes them, and
@@ -1105,6 +1138,10 @@ func (c *Operator) handleStatefulSetDelete(obj interface{}) {
 
 		c.enqueue(ps)
 	}
+
+	if o, ok := c.getObject(obj); ok {
+		c.enqueueAgentForStatefulSet(o)
+	}
 }
 
 func (c *Operator) handleStatefulSetAdd(obj interface{}) {
@@ -1114,6 +1151,8 @@ func (c *Operator) handleStatefulSetAdd(obj interface{}) {
 
 		c.enqueue(ps)
 	}
+
+	c.enqueueAgentForStatefulSet(obj.(*appsv1.StatefulSet))
 }
 
 func (c *Operator) handleStatefulSetUpdate(oldo, curo interface{}) {
@@ -1135,6 +1174,8 @@ func (c *Operator) handleStatefulSetUpdate(oldo, curo interface{}) {
 
 		c.enqueue(ps)
 	}
+
+	c.enqueueAgentForStatefulSet(cur)
 }
 
 func (c *Operator) sync(ctx context.Context, key string) error {
@@ -1164,12 +1205,64 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return err
 	}
 
+	err = c.reconcile(ctx, key, p, ruleConfigMapNames)
+	c.updatePrometheusStatus(ctx, p)
+
+	return err
+}
+
+// updatePrometheusStatus reports the state of the Prometheus pods and shards
+// in the status of the Prometheus object. The object is updated only when the
+// status changes.
+func (c *Operator) updatePrometheusStatus(ctx context.Context, p *monitoringv1.Prometheus) {
+	status, _, err := PrometheusStatus(ctx, c.kclient, p)
+	if err != nil {
+		if !apierrors.IsNotFound(errors.Cause(err)) {
+			level.Warn(c.logger).Log(
+				"msg", "failed to get prometheus status",
+				"err", err,
+				"prometheus", p.Namespace+"/"+p.Name,
+			)
+			return
+		}
+		// Some statefulsets haven't been created yet.
+		status = &monitoringv1.PrometheusStatus{
+			Paused:   p.Spec.Paused,
+			Shards:   int32(len(expectedStatefulSetShardNames(p))),
+			Selector: ListOptions(p.Name).LabelSelector,
+		}
+	}
+
+	if p.Status != nil && reflect.DeepEqual(p.Status, status) {
+		return
+	}
+
+	p = p.DeepCopy()
+	p.Status = status
+
+	if _, err := c.mclient.MonitoringV1().Prometheuses(p.Namespace).UpdateStatus(ctx, p, metav1.UpdateOptions{}); err != nil {
+		level.Warn(c.logger).Log(
+			"msg", "failed to update prometheus status",
+			"err", err,
+			"prometheus", p.Namespace+"/"+p.Name,
+		)
+	}
+}
+
+// reconcile creates or updates the configuration, the governing service and
+// the statefulsets of the given Prometheus. It is shared by the Prometheus
+// and PrometheusAgent controllers.
+func (c *Operator) reconcile(ctx context.Context, key string, p *monitoringv1.Prometheus, ruleConfigMapNames []string) error {
 	assetStore := assets.NewStore(c.kclient.CoreV1(), c.kclient.CoreV1())
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, p, ruleConfigMapNames, assetStore); err != nil {
+	if err := c.createOrUpdateConfigurationSecret(ctx, p, ruleConfigMapNames, assetStore, false); err != nil {
 		return errors.Wrap(err, "creating config failed")
 	}
 
+	if err := c.createOrUpdateWebConfigSecret(ctx, p, assetStore); err != nil {
+		return errors.Wrap(err, "creating web config secret failed")
+	}
+
 	if err := c.createOrUpdateTLSAssetSecret(ctx, p, assetStore); err != nil {
 		return errors.Wrap(err, "creating tls asset secret failed")
 	}
@@ -1180,6 +1273,15 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return errors.Wrap(err, "synchronizing governing service failed")
 	}
 
+	// PrometheusAgent doesn't support PodDisruptionBudgets. Skipping them
+	// also prevents the agent from deleting the PodDisruptionBudgets of a
+	// Prometheus with the same name.
+	if !isAgent(p) {
+		if err := c.createOrUpdatePodDisruptionBudgets(ctx, p); err != nil {
+			return errors.Wrap(err, "synchronizing pod disruption budgets failed")
+		}
+	}
+
 	ssetClient := c.kclient.AppsV1().StatefulSets(p.Namespace)
 
 	// Ensure we have a StatefulSet running Prometheus deployed and that StatefulSet names are created correctly.
@@ -1187,7 +1289,12 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	for shard, ssetName := range expected {
 		level.Debug(c.logger).Log("msg", "reconciling statefulset", "statefulset", ssetName, "shard", fmt.Sprintf("%d", shard))
 
-		obj, err := c.ssetInfs.Get(prometheusKeyToStatefulSetKey(key, shard))
+		ssetKey := prometheusKeyToStatefulSetKey(key, shard)
+		if isAgent(p) {
+			ssetKey = fmt.Sprintf("%s/%s", p.Namespace, ssetName)
+		}
+
+		obj, err := c.ssetInfs.Get(ssetKey)
 		exists := !apierrors.IsNotFound(err)
 		if err != nil && !apierrors.IsNotFound(err) {
 			return errors.Wrap(err, "retrieving statefulset failed")
@@ -1215,13 +1322,16 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
 				return errors.Wrap(err, "creating statefulset failed")
 			}
-			return nil
+			continue
 		}
 
 		oldSSetInputHash := obj.(*appsv1.StatefulSet).ObjectMeta.Annotations[sSetInputHashName]
-		if newSSetInputHash == oldSSetInputHash {
+		// The statefulset of a shard which was previously removed and
+		// retained needs to be updated to drop the retention annotation.
+		_, retained := obj.(*appsv1.StatefulSet).ObjectMeta.Annotations[shardRetainedUntilAnnotation]
+		if newSSetInputHash == oldSSetInputHash && !retained {
 			level.Debug(c.logger).Log("msg", "new statefulset generation inputs match current, skipping any actions")
-			return nil
+			continue
 		}
 
 		level.Debug(c.logger).Log("msg", "updating current Prometheus statefulset")
@@ -1236,7 +1346,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
 				return errors.Wrap(err, "failed to delete StatefulSet to avoid forbidden action")
 			}
-			return nil
+			continue
 		}
 
 		if err != nil {
@@ -1244,11 +1354,21 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		}
 	}
 
+	if err := c.rollOutPods(ctx, p, expected); err != nil {
+		return errors.Wrap(err, "rolling out pods failed")
+	}
+
 	ssets := map[string]struct{}{}
 	for _, ssetName := range expected {
 		ssets[ssetName] = struct{}{}
 	}
 
+	retention, err := shardRetentionPeriod(p)
+	if err != nil {
+		return errors.Wrap(err, "invalid shard retention policy")
+	}
+	now := time.Now()
+
 	err = c.ssetInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prometheusNameLabelName: p.Name}), func(obj interface{}) {
 		s := obj.(*appsv1.StatefulSet)
 
@@ -1258,11 +1378,526 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			return
 		}
 
+		// Do not delete the statefulsets of a Prometheus and a
+		// PrometheusAgent sharing the same name.
+		if !metav1.IsControlledBy(s, p) {
+			return
+		}
+
+		if retention > 0 {
+			retainedUntil, err := c.retainStatefulSet(ctx, s, now.Add(retention))
+			if err != nil {
+				level.Error(c.logger).Log("msg", "failed to retain StatefulSet of removed shard", "statefulset", s.GetName(), "err", err)
+				return
+			}
+
+			if now.Before(retainedUntil) {
+				// Reconcile again once the retention period expires.
+				c.queue.AddAfter(key, retainedUntil.Sub(now))
+				return
+			}
+		}
+
 		propagationPolicy := metav1.DeletePropagationForeground
 		if err := ssetClient.Delete(context.TODO(), s.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
 			level.Error(c.logger).Log("failed to delete StatefulSet to cleanup")
 		}
 	})
+	if err != nil {
+		return errors.Wrap(err, "listing StatefulSets failed")
+	}
+
+	return nil
+}
+
+// shardRetentionPeriod returns how long the statefulsets of the removed
+// shards should be kept. A zero value means that they are deleted
+// immediately.
+func shardRetentionPeriod(p *monitoringv1.Prometheus) (time.Duration, error) {
+	policy := p.Spec.ShardRetentionPolicy
+	if policy == nil || policy.WhenScaled == nil || *policy.WhenScaled != monitoringv1.RetainWhenScaledRetentionType {
+		return 0, nil
+	}
+
+	retainFor := policy.RetainFor
+	if retainFor == "" {
+		retainFor = p.Spec.Retention
+	}
+	if retainFor == "" {
+		retainFor = defaultRetention
+	}
+
+	d, err := model.ParseDuration(retainFor)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(d), nil
+}
+
+// retainStatefulSet annotates the statefulset of a removed shard with the
+// time until which it should be kept, if not done already. It returns the
+// time recorded in the annotation.
+func (c *Operator) retainStatefulSet(ctx context.Context, s *appsv1.StatefulSet, retainUntil time.Time) (time.Time, error) {
+	if v, found := s.Annotations[shardRetainedUntilAnnotation]; found {
+		t, err := time.Parse(time.RFC3339, v)
+		if err == nil {
+			return t, nil
+		}
+		level.Warn(c.logger).Log("msg", "invalid retention annotation, resetting it", "statefulset", s.GetName(), "err", err)
+	}
+
+	s = s.DeepCopy()
+	if s.Annotations == nil {
+		s.Annotations = map[string]string{}
+	}
+	s.Annotations[shardRetainedUntilAnnotation] = retainUntil.UTC().Format(time.RFC3339)
+
+	if _, err := c.kclient.AppsV1().StatefulSets(s.Namespace).Update(ctx, s, metav1.UpdateOptions{}); err != nil {
+		return time.Time{}, err
+	}
+
+	level.Info(c.logger).Log("msg", "retaining StatefulSet of removed shard", "statefulset", s.GetName(), "until", s.Annotations[shardRetainedUntilAnnotation])
+	return retainUntil, nil
+}
+
+// rollOutPods deletes the outdated pods of the statefulsets when the
+// OnDelete update strategy is used. The pods of all shards are considered
+// together so that no more than maxUnavailable pods are unavailable at the
+// same time. The rollout progresses as the statefulsets' status changes
+// since every update of the statefulsets triggers a new reconciliation.
+func (c *Operator) rollOutPods(ctx context.Context, p *monitoringv1.Prometheus, ssetNames []string) error {
+	strategy := p.Spec.UpdateStrategy
+	if strategy == nil || strategy.Type != monitoringv1.OnDeleteStatefulSetUpdateStrategyType || strategy.Paused {
+		return nil
+	}
+
+	var (
+		pods            []v1.Pod
+		expectedPods    int
+		updateRevisions = map[string]string{}
+	)
+	for _, name := range ssetNames {
+		obj, err := c.ssetInfs.Get(p.Namespace + "/" + name)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "retrieving statefulset failed")
+		}
+
+		sset := obj.(*appsv1.StatefulSet)
+		if sset.Spec.Replicas != nil {
+			expectedPods += int(*sset.Spec.Replicas)
+		}
+
+		// The update revision can only be trusted once the StatefulSet
+		// controller has observed the latest generation.
+		if sset.Status.ObservedGeneration >= sset.Generation && sset.Status.UpdateRevision != "" {
+			updateRevisions[sset.Name] = sset.Status.UpdateRevision
+		}
+
+		podList, err := c.kclient.CoreV1().Pods(p.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: metav1.FormatLabelSelector(sset.Spec.Selector),
+		})
+		if err != nil {
+			return errors.Wrap(err, "listing pods failed")
+		}
+
+		for _, pod := range podList.Items {
+			if metav1.IsControlledBy(&pod, sset) {
+				pods = append(pods, pod)
+			}
+		}
+	}
+
+	maxUnavailable, err := rolloutMaxUnavailable(strategy, expectedPods)
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range podsToRollOut(pods, updateRevisions, expectedPods, maxUnavailable) {
+		level.Info(c.logger).Log("msg", "deleting outdated pod", "pod", pod.Name, "namespace", pod.Namespace)
+		if err := c.kclient.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete pod %s", pod.Name)
+		}
+	}
+
+	return nil
+}
+
+// rolloutMaxUnavailable returns the maximum number of unavailable pods
+// during a rollout. It is at least 1 to guarantee that the rollout
+// progresses.
+func rolloutMaxUnavailable(strategy *monitoringv1.StatefulSetUpdateStrategy, total int) (int, error) {
+	maxUnavailable := intstr.FromInt(1)
+	if strategy.MaxUnavailable != nil {
+		maxUnavailable = *strategy.MaxUnavailable
+	}
+
+	n, err := intstr.GetValueFromIntOrPercent(&maxUnavailable, total, false)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid maxUnavailable value")
+	}
+
+	if n < 1 {
+		return 1, nil
+	}
+	return n, nil
+}
+
+// podsToRollOut returns the outdated pods which can be deleted without
+// having more than maxUnavailable unavailable pods. Pods which don't exist
+// yet count as unavailable. The outdated pods which are already unavailable
+// are always returned since deleting them doesn't reduce the availability.
+func podsToRollOut(pods []v1.Pod, updateRevisions map[string]string, expectedPods, maxUnavailable int) []v1.Pod {
+	var (
+		unavailable         int
+		outdatedAvailable   []v1.Pod
+		outdatedUnavailable []v1.Pod
+	)
+
+	if len(pods) < expectedPods {
+		unavailable = expectedPods - len(pods)
+	}
+
+	for _, pod := range pods {
+		ready, _ := k8sutil.PodRunningAndReady(pod)
+		available := ready && pod.DeletionTimestamp == nil
+		if !available {
+			unavailable++
+		}
+
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		ref := metav1.GetControllerOf(&pod)
+		if ref == nil {
+			continue
+		}
+
+		revision, found := updateRevisions[ref.Name]
+		if !found || pod.Labels[appsv1.StatefulSetRevisionLabel] == revision {
+			continue
+		}
+
+		if available {
+			outdatedAvailable = append(outdatedAvailable, pod)
+		} else {
+			outdatedUnavailable = append(outdatedUnavailable, pod)
+		}
+	}
+
+	toDelete := outdatedUnavailable
+	for _, pod := range outdatedAvailable {
+		if unavailable >= maxUnavailable {
+			break
+		}
+		toDelete = append(toDelete, pod)
+		unavailable++
+	}
+
+	return toDelete
+}
+
+// createOrUpdatePodDisruptionBudgets reconciles the PodDisruptionBudgets of
+// the Prometheus shards. The PodDisruptionBudgets of removed shards are
+// deleted as well as all of them when the PodDisruptionBudget isn't
+// configured anymore.
+func (c *Operator) createOrUpdatePodDisruptionBudgets(ctx context.Context, p *monitoringv1.Prometheus) error {
+	pdbClient := c.kclient.PolicyV1beta1().PodDisruptionBudgets(p.Namespace)
+
+	expected := map[string]struct{}{}
+	if p.Spec.PodDisruptionBudget != nil {
+		for shard, name := range expectedStatefulSetShardNames(p) {
+			pdb, err := makePodDisruptionBudget(name, *p, &c.config, int32(shard))
+			if err != nil {
+				return err
+			}
+
+			if err := k8sutil.CreateOrUpdatePodDisruptionBudget(ctx, pdbClient, pdb); err != nil {
+				return err
+			}
+			expected[name] = struct{}{}
+		}
+	}
+
+	pdbs, err := pdbClient.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{prometheusNameLabelName: p.Name}).String(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing pod disruption budgets failed")
+	}
+
+	for _, pdb := range pdbs.Items {
+		if _, ok := expected[pdb.Name]; ok {
+			continue
+		}
+
+		if err := pdbClient.Delete(ctx, pdb.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrap(err, "deleting pod disruption budget failed")
+		}
+	}
+
+	return nil
+}
+
+// validateRemoteWriteSpec checks that the authentication methods of the
+// remote write endpoint are mutually exclusive.
+func validateTracingConfig(tc *monitoringv1.PrometheusTracingConfig) error {
+	if tc.SamplingFraction != nil {
+		if tc.SamplingFraction.Sign() < 0 || tc.SamplingFraction.Cmp(resource.MustParse("1")) > 0 {
+			return errors.Errorf("samplingFraction must be between 0 and 1, got %s", tc.SamplingFraction.String())
+		}
+	}
+
+	if tc.TLSConfig != nil {
+		if err := tc.TLSConfig.Validate(); err != nil {
+			return errors.Wrap(err, "tlsConfig")
+		}
+	}
+
+	return nil
+}
+
+func validateRemoteWriteSpec(spec monitoringv1.RemoteWriteSpec) error {
+	var authMethods []string
+	if spec.BasicAuth != nil {
+		authMethods = append(authMethods, "basicAuth")
+	}
+	if spec.BearerToken != "" || spec.BearerTokenFile != "" {
+		authMethods = append(authMethods, "bearerToken/bearerTokenFile")
+	}
+	if spec.OAuth2 != nil {
+		authMethods = append(authMethods, "oauth2")
+	}
+	if spec.Sigv4 != nil {
+		authMethods = append(authMethods, "sigv4")
+	}
+	if spec.AzureAD != nil {
+		authMethods = append(authMethods, "azureAd")
+	}
+
+	if len(authMethods) > 1 {
+		return errors.Errorf("%s are mutually exclusive", strings.Join(authMethods, ", "))
+	}
+
+	if spec.AzureAD != nil && (spec.AzureAD.ManagedIdentity == nil) == (spec.AzureAD.OAuth == nil) {
+		return errors.New("azureAd: exactly one of managedIdentity and oauth must be set")
+	}
+
+	if spec.QueueConfig != nil {
+		if err := validateQueueConfig(spec.QueueConfig); err != nil {
+			return errors.Wrap(err, "queueConfig")
+		}
+	}
+
+	return nil
+}
+
+// validateEndpointAuthentication checks that the authentication methods of
+// the ServiceMonitor endpoint are mutually exclusive.
+func validateEndpointAuthentication(endpoint monitoringv1.Endpoint) error {
+	var authMethods []string
+	if endpoint.BasicAuth != nil {
+		authMethods = append(authMethods, "basicAuth")
+	}
+	if endpoint.BearerTokenFile != "" || endpoint.BearerTokenSecret.Name != "" {
+		authMethods = append(authMethods, "bearerTokenFile/bearerTokenSecret")
+	}
+	if endpoint.OAuth2 != nil {
+		authMethods = append(authMethods, "oauth2")
+	}
+	if endpoint.Authorization != nil {
+		authMethods = append(authMethods, "authorization")
+	}
+
+	if len(authMethods) > 1 {
+		return errors.Errorf("%s are mutually exclusive", strings.Join(authMethods, ", "))
+	}
+
+	return nil
+}
+
+// addProbeAssets validates the probe's prober settings and adds the
+// referenced credentials to the store.
+func addProbeAssets(ctx context.Context, probe *monitoringv1.Probe, store *assets.Store) error {
+	if err := validateProxyConfig(probe.Spec.ProberSpec.ProxyConfig); err != nil {
+		return err
+	}
+
+	probeKey := fmt.Sprintf("probe/%s/%s", probe.GetNamespace(), probe.GetName())
+	if err := store.AddProxyConfig(ctx, probe.GetNamespace(), &probe.Spec.ProberSpec.ProxyConfig, probeKey); err != nil {
+		return err
+	}
+
+	probeAuthKey := fmt.Sprintf("probe/auth/%s/%s", probe.GetNamespace(), probe.GetName())
+	return store.AddSafeAuthorization(ctx, probe.GetNamespace(), probe.Spec.Authorization, probeAuthKey)
+}
+
+// validateProxyConfig checks that the proxy settings of a monitor are
+// consistent.
+func validateProxyConfig(pc monitoringv1.ProxyConfig) error {
+	hasProxyURL := pc.ProxyURL != nil && *pc.ProxyURL != ""
+	fromEnvironment := pc.ProxyFromEnvironment != nil && *pc.ProxyFromEnvironment
+
+	if hasProxyURL && fromEnvironment {
+		return errors.New("proxyUrl and proxyFromEnvironment are mutually exclusive")
+	}
+
+	if pc.NoProxy != nil && *pc.NoProxy != "" && !hasProxyURL {
+		return errors.New("noProxy requires proxyUrl to be set")
+	}
+
+	if len(pc.ProxyConnectHeader) > 0 && !hasProxyURL && !fromEnvironment {
+		return errors.New("proxyConnectHeader requires proxyUrl or proxyFromEnvironment to be set")
+	}
+
+	return nil
+}
+
+// validatePodMetricsEndpointAuthentication checks that the authentication
+// methods of the PodMonitor endpoint are mutually exclusive.
+func validatePodMetricsEndpointAuthentication(endpoint monitoringv1.PodMetricsEndpoint) error {
+	var authMethods []string
+	if endpoint.BasicAuth != nil {
+		authMethods = append(authMethods, "basicAuth")
+	}
+	if endpoint.BearerTokenSecret.Name != "" {
+		authMethods = append(authMethods, "bearerTokenSecret")
+	}
+	if endpoint.Authorization != nil {
+		authMethods = append(authMethods, "authorization")
+	}
+
+	if len(authMethods) > 1 {
+		return errors.Errorf("%s are mutually exclusive", strings.Join(authMethods, ", "))
+	}
+
+	return nil
+}
+
+// validatePodMetricsEndpointPort checks that the port number of the
+// PodMonitor endpoint isn't combined with the other port fields.
+func validatePodMetricsEndpointPort(endpoint monitoringv1.PodMetricsEndpoint) error {
+	if endpoint.PortNumber == nil {
+		return nil
+	}
+
+	if endpoint.Port != "" || endpoint.TargetPort != nil {
+		return errors.New("portNumber is mutually exclusive with port and targetPort")
+	}
+
+	return nil
+}
+
+// validateRemoteReadSpec checks that the authentication methods of the
+// remote read endpoint are mutually exclusive.
+func validateRemoteReadSpec(spec monitoringv1.RemoteReadSpec) error {
+	var authMethods []string
+	if spec.BasicAuth != nil {
+		authMethods = append(authMethods, "basicAuth")
+	}
+	if spec.BearerToken != "" || spec.BearerTokenFile != "" {
+		authMethods = append(authMethods, "bearerToken/bearerTokenFile")
+	}
+	if spec.Authorization != nil {
+		authMethods = append(authMethods, "authorization")
+	}
+	if spec.OAuth2 != nil {
+		authMethods = append(authMethods, "oauth2")
+	}
+
+	if len(authMethods) > 1 {
+		return errors.Errorf("%s are mutually exclusive", strings.Join(authMethods, ", "))
+	}
+
+	return nil
+}
+
+// validateAlertmanagerEndpoints checks that the authentication methods of the
+// alertmanager endpoint are mutually exclusive.
+func validateAlertmanagerEndpoints(am monitoringv1.AlertmanagerEndpoints) error {
+	var authMethods []string
+	if am.BasicAuth != nil {
+		authMethods = append(authMethods, "basicAuth")
+	}
+	if am.BearerTokenFile != "" {
+		authMethods = append(authMethods, "bearerTokenFile")
+	}
+	if am.Authorization != nil {
+		authMethods = append(authMethods, "authorization")
+	}
+	if am.Sigv4 != nil {
+		authMethods = append(authMethods, "sigv4")
+	}
+
+	if len(authMethods) > 1 {
+		return errors.Errorf("%s are mutually exclusive", strings.Join(authMethods, ", "))
+	}
+
+	return nil
+}
+
+// validateScrapeClasses checks that at most one scrape class is the default
+// and that the TLS configurations only use the supported fields.
+func validateScrapeClasses(scrapeClasses []monitoringv1.ScrapeClass) error {
+	var defaultClass string
+	for _, sc := range scrapeClasses {
+		if sc.Default != nil && *sc.Default {
+			if defaultClass != "" {
+				return errors.Errorf("scrape classes %q and %q are both the default, only one is allowed", defaultClass, sc.Name)
+			}
+			defaultClass = sc.Name
+		}
+
+		if sc.TLSConfig == nil {
+			continue
+		}
+
+		if sc.TLSConfig.CA != (monitoringv1.SecretOrConfigMap{}) || sc.TLSConfig.Cert != (monitoringv1.SecretOrConfigMap{}) || sc.TLSConfig.KeySecret != nil {
+			return errors.Errorf("scrape class %q: only caFile, certFile and keyFile are supported in the TLS configuration", sc.Name)
+		}
+
+		if err := sc.TLSConfig.Validate(); err != nil {
+			return errors.Wrapf(err, "scrape class %q", sc.Name)
+		}
+	}
+
+	return nil
+}
+
+// validateScrapeClassName checks that the scrape class referenced by a
+// scraping object exists.
+func validateScrapeClassName(p *monitoringv1.Prometheus, name *string) error {
+	if name == nil {
+		return nil
+	}
+
+	for _, sc := range p.Spec.ScrapeClasses {
+		if sc.Name == *name {
+			return nil
+		}
+	}
+
+	return errors.Errorf("scrape class %q not found", *name)
+}
+
+// validateQueueConfig checks that the queue parameters are consistent with
+// each other.
+func validateQueueConfig(qc *monitoringv1.QueueConfig) error {
+	if qc.Capacity < 0 || qc.MinShards < 0 || qc.MaxShards < 0 || qc.MaxSamplesPerSend < 0 || qc.MaxRetries < 0 {
+		return errors.New("capacity, minShards, maxShards, maxSamplesPerSend and maxRetries can't be negative")
+	}
+
+	if qc.MinShards > 0 && qc.MaxShards > 0 && qc.MinShards > qc.MaxShards {
+		return errors.Errorf("minShards (%d) must be less than or equal to maxShards (%d)", qc.MinShards, qc.MaxShards)
+	}
+
+	if qc.Capacity > 0 && qc.MaxSamplesPerSend > 0 && qc.Capacity < qc.MaxSamplesPerSend {
+		return errors.Errorf("capacity (%d) must be greater than or equal to maxSamplesPerSend (%d)", qc.Capacity, qc.MaxSamplesPerSend)
+	}
 
 	return nil
 }
@@ -1316,12 +1951,14 @@ func createSSetInputHash(p monitoringv1.Prometheus, c operator.Config, ruleConfi
 	return fmt.Sprintf("%d", hash), nil
 }
 
+// ListOptions returns the options to list the pods of the given Prometheus.
+// The label selector is sorted so it can be used in the status.
 func ListOptions(name string) metav1.ListOptions {
 	return metav1.ListOptions{
-		LabelSelector: fields.SelectorFromSet(fields.Set(map[string]string{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
 			"app":        "prometheus",
 			"prometheus": name,
-		})).String(),
+		}).String(),
 	}
 }
 
@@ -1329,7 +1966,11 @@ func ListOptions(name string) metav1.ListOptions {
 // respect to its specified resource object. It return the status and a list of
 // pods that are not updated.
 func PrometheusStatus(ctx context.Context, kclient kubernetes.Interface, p *monitoringv1.Prometheus) (*monitoringv1.PrometheusStatus, []v1.Pod, error) {
-	res := &monitoringv1.PrometheusStatus{Paused: p.Spec.Paused}
+	res := &monitoringv1.PrometheusStatus{
+		Paused:   p.Spec.Paused,
+		Shards:   int32(len(expectedStatefulSetShardNames(p))),
+		Selector: ListOptions(p.Name).LabelSelector,
+	}
 
 	pods, err := kclient.CoreV1().Pods(p.Namespace).List(ctx, ListOptions(p.Name))
 	if err != nil {
@@ -1402,16 +2043,7 @@ func (c *Operator) loadAdditionalScrapeConfigsSecret(additionalScrapeConfigs *v1
 	return nil, nil
 }
 
-func gzipConfig(buf *bytes.Buffer, conf []byte) error {
-	w := gzip.NewWriter(buf)
-	defer w.Close()
-	if _, err := w.Write(conf); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *monitoringv1.Prometheus, ruleConfigMapNames []string, store *assets.Store) error {
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *monitoringv1.Prometheus, ruleConfigMapNames []string, store *assets.Store, localNodeOnly bool) error {
 	// If no service or pod monitor selectors are configured, the user wants to
 	// manage configuration themselves. Do create an empty Secret if it doesn't
 	// exist.
@@ -1437,6 +2069,16 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 		return nil
 	}
 
+	if err := validateScrapeClasses(p.Spec.ScrapeClasses); err != nil {
+		return errors.Wrap(err, "invalid scrape classes")
+	}
+
+	for _, sc := range p.Spec.ScrapeClasses {
+		if err := store.AddAuthorization(ctx, p.GetNamespace(), sc.Authorization, scrapeClassAuthKey(sc.Name)); err != nil {
+			return errors.Wrapf(err, "scrape class %q", sc.Name)
+		}
+	}
+
 	smons, err := c.selectServiceMonitors(ctx, p, store)
 	if err != nil {
 		return errors.Wrap(err, "selecting ServiceMonitors failed")
@@ -1447,7 +2089,7 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 		return errors.Wrap(err, "selecting PodMonitors failed")
 	}
 
-	bmons, err := c.selectProbes(p)
+	bmons, err := c.selectProbes(ctx, p, store)
 	if err != nil {
 		return errors.Wrap(err, "selecting Probes failed")
 	}
@@ -1459,23 +2101,79 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 	}
 
 	for i, remote := range p.Spec.RemoteRead {
+		if err := validateRemoteReadSpec(remote); err != nil {
+			return errors.Wrapf(err, "remote read %d", i)
+		}
 		if err := store.AddBasicAuth(ctx, p.GetNamespace(), remote.BasicAuth, fmt.Sprintf("remoteRead/%d", i)); err != nil {
 			return errors.Wrapf(err, "remote read %d", i)
 		}
+		if err := store.AddSafeAuthorization(ctx, p.GetNamespace(), remote.Authorization, fmt.Sprintf("remoteRead/auth/%d", i)); err != nil {
+			return errors.Wrapf(err, "remote read %d", i)
+		}
+		if err := store.AddOAuth2WithTLSConfig(ctx, p.GetNamespace(), remote.OAuth2, fmt.Sprintf("remoteRead/%d", i)); err != nil {
+			return errors.Wrapf(err, "remote read %d", i)
+		}
 		if err := store.AddTLSConfig(ctx, p.GetNamespace(), remote.TLSConfig); err != nil {
 			return errors.Wrapf(err, "remote read %d", i)
 		}
 	}
 
 	for i, remote := range p.Spec.RemoteWrite {
+		if err := validateRemoteWriteSpec(remote); err != nil {
+			return errors.Wrapf(err, "remote write %d", i)
+		}
 		if err := store.AddBasicAuth(ctx, p.GetNamespace(), remote.BasicAuth, fmt.Sprintf("remoteWrite/%d", i)); err != nil {
 			return errors.Wrapf(err, "remote write %d", i)
 		}
+		if err := store.AddOAuth2WithTLSConfig(ctx, p.GetNamespace(), remote.OAuth2, fmt.Sprintf("remoteWrite/%d", i)); err != nil {
+			return errors.Wrapf(err, "remote write %d", i)
+		}
+		if err := store.AddSigV4(ctx, p.GetNamespace(), remote.Sigv4, fmt.Sprintf("remoteWrite/%d", i)); err != nil {
+			return errors.Wrapf(err, "remote write %d", i)
+		}
+		if err := store.AddAzureOAuth(ctx, p.GetNamespace(), remote.AzureAD, fmt.Sprintf("remoteWrite/%d", i)); err != nil {
+			return errors.Wrapf(err, "remote write %d", i)
+		}
 		if err := store.AddTLSConfig(ctx, p.GetNamespace(), remote.TLSConfig); err != nil {
 			return errors.Wrapf(err, "remote write %d", i)
 		}
 	}
 
+	if p.Spec.Alerting != nil {
+		for i, am := range p.Spec.Alerting.Alertmanagers {
+			if err := validateAlertmanagerEndpoints(am); err != nil {
+				return errors.Wrapf(err, "alertmanager %d", i)
+			}
+			if err := store.AddBasicAuth(ctx, p.GetNamespace(), am.BasicAuth, fmt.Sprintf("alertmanager/auth/%d", i)); err != nil {
+				return errors.Wrapf(err, "alertmanager %d", i)
+			}
+			if err := store.AddSafeAuthorization(ctx, p.GetNamespace(), am.Authorization, fmt.Sprintf("alertmanager/auth/%d", i)); err != nil {
+				return errors.Wrapf(err, "alertmanager %d", i)
+			}
+			if err := store.AddSigV4(ctx, p.GetNamespace(), am.Sigv4, fmt.Sprintf("alertmanager/auth/%d", i)); err != nil {
+				return errors.Wrapf(err, "alertmanager %d", i)
+			}
+			if err := store.AddTLSConfig(ctx, p.GetNamespace(), am.TLSConfig); err != nil {
+				return errors.Wrapf(err, "alertmanager %d", i)
+			}
+		}
+	}
+
+	if p.Spec.Thanos != nil {
+		if err := store.AddTLSConfig(ctx, p.GetNamespace(), p.Spec.Thanos.GRPCServerTLSConfig); err != nil {
+			return errors.Wrap(err, "thanos gRPC server TLS config")
+		}
+	}
+
+	if p.Spec.TracingConfig != nil {
+		if err := validateTracingConfig(p.Spec.TracingConfig); err != nil {
+			return errors.Wrap(err, "tracing config")
+		}
+		if err := store.AddTLSConfig(ctx, p.GetNamespace(), p.Spec.TracingConfig.TLSConfig); err != nil {
+			return errors.Wrap(err, "tracing config")
+		}
+	}
+
 	if p.Spec.APIServerConfig != nil {
 		if err := store.AddBasicAuth(ctx, p.GetNamespace(), p.Spec.APIServerConfig.BasicAuth, "apiserver"); err != nil {
 			return errors.Wrap(err, "apiserver config")
@@ -1503,10 +2201,14 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 		bmons,
 		store.BasicAuthAssets,
 		store.BearerTokenAssets,
+		store.OAuth2Assets,
+		store.SigV4Assets,
+		store.AzureOAuthAssets,
 		additionalScrapeConfigs,
 		additionalAlertRelabelConfigs,
 		additionalAlertManagerConfigs,
 		ruleConfigMapNames,
+		localNodeOnly,
 	)
 	if err != nil {
 		return errors.Wrap(err, "generating config failed")
@@ -1519,35 +2221,23 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 
 	// Compress config to avoid 1mb secret limit for a while
 	var buf bytes.Buffer
-	if err = gzipConfig(&buf, conf); err != nil {
+	if err = operator.GzipConfig(&buf, conf); err != nil {
 		return errors.Wrap(err, "couldn't gzip config")
 	}
 	s.Data[configFilename] = buf.Bytes()
 
-	curSecret, err := sClient.Get(ctx, s.Name, metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		level.Debug(c.logger).Log("msg", "creating configuration")
-		_, err = sClient.Create(ctx, s, metav1.CreateOptions{})
-		return err
+	updated, err := k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	if err != nil {
+		return errors.Wrap(err, "failed to update Prometheus configuration secret")
 	}
 
-	var (
-		generatedConf             = s.Data[configFilename]
-		curConfig, curConfigFound = curSecret.Data[configFilename]
-	)
-	if curConfigFound {
-		if bytes.Equal(curConfig, generatedConf) {
-			level.Debug(c.logger).Log("msg", "updating Prometheus configuration secret skipped, no configuration change")
-			return nil
-		}
-		level.Debug(c.logger).Log("msg", "current Prometheus configuration has changed")
-	} else {
-		level.Debug(c.logger).Log("msg", "no current Prometheus configuration secret found", "currentConfigFound", curConfigFound)
+	if !updated {
+		level.Debug(c.logger).Log("msg", "updating Prometheus configuration secret skipped, no configuration change")
+		return nil
 	}
 
-	level.Debug(c.logger).Log("msg", "updating Prometheus configuration secret")
-	_, err = sClient.Update(ctx, s, metav1.UpdateOptions{})
-	return err
+	level.Debug(c.logger).Log("msg", "Prometheus configuration secret updated")
+	return nil
 }
 
 func (c *Operator) createOrUpdateTLSAssetSecret(ctx context.Context, p *monitoringv1.Prometheus, store *assets.Store) error {
@@ -1556,7 +2246,7 @@ func (c *Operator) createOrUpdateTLSAssetSecret(ctx context.Context, p *monitori
 
 	tlsAssetsSecret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   tlsAssetsSecretName(p.Name),
+			Name:   tlsAssetsSecretName(p),
 			Labels: c.config.Labels.Merge(managedByOperatorLabels),
 			OwnerReferences: []metav1.OwnerReference{
 				{
@@ -1576,26 +2266,13 @@ func (c *Operator) createOrUpdateTLSAssetSecret(ctx context.Context, p *monitori
 		tlsAssetsSecret.Data[key.String()] = []byte(asset)
 	}
 
-	_, err := sClient.Get(ctx, tlsAssetsSecret.Name, metav1.GetOptions{})
+	updated, err := k8sutil.CreateOrUpdateSecret(ctx, sClient, tlsAssetsSecret)
 	if err != nil {
-		if !apierrors.IsNotFound(err) {
-			return errors.Wrapf(
-				err,
-				"failed to check whether tls assets secret already exists for Prometheus %v in namespace %v",
-				p.Name,
-				p.Namespace,
-			)
-		}
-		_, err = sClient.Create(ctx, tlsAssetsSecret, metav1.CreateOptions{})
-		level.Debug(c.logger).Log("msg", "created tlsAssetsSecret", "secretname", tlsAssetsSecret.Name)
-
-	} else {
-		_, err = sClient.Update(ctx, tlsAssetsSecret, metav1.UpdateOptions{})
-		level.Debug(c.logger).Log("msg", "updated tlsAssetsSecret", "secretname", tlsAssetsSecret.Name)
+		return errors.Wrapf(err, "failed to create TLS assets secret for Prometheus %v in namespace %v", p.Name, p.Namespace)
 	}
 
-	if err != nil {
-		return errors.Wrapf(err, "failed to create TLS assets secret for Prometheus %v in namespace %v", p.Name, p.Namespace)
+	if updated {
+		level.Debug(c.logger).Log("msg", "updated tlsAssetsSecret", "secretname", tlsAssetsSecret.Name)
 	}
 
 	return nil
@@ -1640,9 +2317,13 @@ func (c *Operator) selectServiceMonitors(ctx context.Context, p *monitoringv1.Pr
 	var rejected int
 	res := make(map[string]*monitoringv1.ServiceMonitor, len(serviceMonitors))
 	for namespaceAndName, sm := range serviceMonitors {
-		var err error
+		err := validateScrapeClassName(p, sm.Spec.ScrapeClassName)
 
 		for i, endpoint := range sm.Spec.Endpoints {
+			if err != nil {
+				break
+			}
+
 			// If denied by Prometheus spec, filter out all service monitors that access
 			// the file system.
 			if p.Spec.ArbitraryFSAccessThroughSMs.Deny {
@@ -1651,6 +2332,14 @@ func (c *Operator) selectServiceMonitors(ctx context.Context, p *monitoringv1.Pr
 				}
 			}
 
+			if err = validateEndpointAuthentication(endpoint); err != nil {
+				break
+			}
+
+			if err = validateProxyConfig(endpoint.ProxyConfig); err != nil {
+				break
+			}
+
 			smKey := fmt.Sprintf("serviceMonitor/%s/%s/%d", sm.GetNamespace(), sm.GetName(), i)
 
 			if err = store.AddBearerToken(ctx, sm.GetNamespace(), endpoint.BearerTokenSecret, smKey); err != nil {
@@ -1661,6 +2350,19 @@ func (c *Operator) selectServiceMonitors(ctx context.Context, p *monitoringv1.Pr
 				break
 			}
 
+			if err = store.AddOAuth2WithTLSConfig(ctx, sm.GetNamespace(), endpoint.OAuth2, smKey); err != nil {
+				break
+			}
+
+			if err = store.AddProxyConfig(ctx, sm.GetNamespace(), &endpoint.ProxyConfig, smKey); err != nil {
+				break
+			}
+
+			smAuthKey := fmt.Sprintf("serviceMonitor/auth/%s/%s/%d", sm.GetNamespace(), sm.GetName(), i)
+			if err = store.AddSafeAuthorization(ctx, sm.GetNamespace(), endpoint.Authorization, smAuthKey); err != nil {
+				break
+			}
+
 			if endpoint.TLSConfig != nil {
 				if err = store.AddTLSConfig(ctx, sm.GetNamespace(), endpoint.TLSConfig); err != nil {
 					break
@@ -1736,9 +2438,25 @@ func (c *Operator) selectPodMonitors(ctx context.Context, p *monitoringv1.Promet
 	var rejected int
 	res := make(map[string]*monitoringv1.PodMonitor, len(podMonitors))
 	for namespaceAndName, pm := range podMonitors {
-		var err error
+		err := validateScrapeClassName(p, pm.Spec.ScrapeClassName)
 
 		for i, endpoint := range pm.Spec.PodMetricsEndpoints {
+			if err != nil {
+				break
+			}
+
+			if err = validatePodMetricsEndpointAuthentication(endpoint); err != nil {
+				break
+			}
+
+			if err = validatePodMetricsEndpointPort(endpoint); err != nil {
+				break
+			}
+
+			if err = validateProxyConfig(endpoint.ProxyConfig); err != nil {
+				break
+			}
+
 			pmKey := fmt.Sprintf("podMonitor/%s/%s/%d", pm.GetNamespace(), pm.GetName(), i)
 
 			if err = store.AddBearerToken(ctx, pm.GetNamespace(), endpoint.BearerTokenSecret, pmKey); err != nil {
@@ -1749,6 +2467,15 @@ func (c *Operator) selectPodMonitors(ctx context.Context, p *monitoringv1.Promet
 				break
 			}
 
+			if err = store.AddProxyConfig(ctx, pm.GetNamespace(), &endpoint.ProxyConfig, pmKey); err != nil {
+				break
+			}
+
+			pmAuthKey := fmt.Sprintf("podMonitor/auth/%s/%s/%d", pm.GetNamespace(), pm.GetName(), i)
+			if err = store.AddSafeAuthorization(ctx, pm.GetNamespace(), endpoint.Authorization, pmAuthKey); err != nil {
+				break
+			}
+
 			if endpoint.TLSConfig != nil {
 				if err = store.AddSafeTLSConfig(ctx, pm.GetNamespace(), &endpoint.TLSConfig.SafeTLSConfig); err != nil {
 					break
@@ -1785,7 +2512,7 @@ func (c *Operator) selectPodMonitors(ctx context.Context, p *monitoringv1.Promet
 	return res, nil
 }
 
-func (c *Operator) selectProbes(p *monitoringv1.Prometheus) (map[string]*monitoringv1.Probe, error) {
+func (c *Operator) selectProbes(ctx context.Context, p *monitoringv1.Prometheus, store *assets.Store) (map[string]*monitoringv1.Probe, error) {
 	namespaces := []string{}
 	// Selectors might overlap. Deduplicate them along the keyFunc.
 	probes := make(map[string]*monitoringv1.Probe)
@@ -1835,6 +2562,30 @@ func (c *Operator) selectProbes(p *monitoringv1.Prometheus) (map[string]*monitor
 			continue
 		}
 
+		if err := validateScrapeClassName(p, probe.Spec.ScrapeClassName); err != nil {
+			rejected++
+			level.Warn(c.logger).Log(
+				"msg", "skipping probe",
+				"error", err.Error(),
+				"probe", probeName,
+				"namespace", p.Namespace,
+				"prometheus", p.Name,
+			)
+			continue
+		}
+
+		if err := addProbeAssets(ctx, probe, store); err != nil {
+			rejected++
+			level.Warn(c.logger).Log(
+				"msg", "skipping probe",
+				"error", err.Error(),
+				"probe", probeName,
+				"namespace", p.Namespace,
+				"prometheus", p.Name,
+			)
+			continue
+		}
+
 		res[probeName] = probe
 	}
 
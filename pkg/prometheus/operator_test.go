@@ -17,11 +17,15 @@ package prometheus
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kylelemons/godebug/pretty"
 )
@@ -29,7 +33,7 @@ import (
 func TestListOptions(t *testing.T) {
 	for i := 0; i < 1000; i++ {
 		o := ListOptions("test")
-		if o.LabelSelector != "app=prometheus,prometheus=test" && o.LabelSelector != "prometheus=test,app=prometheus" {
+		if o.LabelSelector != "app=prometheus,prometheus=test" {
 			t.Fatalf("LabelSelector not computed correctly\n\nExpected: \"app=prometheus,prometheus=test\"\n\nGot:      %#+v", o.LabelSelector)
 		}
 	}
@@ -202,3 +206,795 @@ func TestPrometheusKeyToStatefulSetKey(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateRemoteWriteSpec(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		spec    monitoringv1.RemoteWriteSpec
+		wantErr bool
+	}{
+		{
+			name: "no authentication",
+			spec: monitoringv1.RemoteWriteSpec{},
+		},
+		{
+			name: "basic auth only",
+			spec: monitoringv1.RemoteWriteSpec{
+				BasicAuth: &monitoringv1.BasicAuth{},
+			},
+		},
+		{
+			name: "sigv4 only",
+			spec: monitoringv1.RemoteWriteSpec{
+				Sigv4: &monitoringv1.Sigv4{},
+			},
+		},
+		{
+			name: "oauth2 and bearer token",
+			spec: monitoringv1.RemoteWriteSpec{
+				OAuth2:      &monitoringv1.OAuth2WithTLSConfig{},
+				BearerToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "sigv4 and basic auth",
+			spec: monitoringv1.RemoteWriteSpec{
+				Sigv4:     &monitoringv1.Sigv4{},
+				BasicAuth: &monitoringv1.BasicAuth{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "sigv4 and oauth2",
+			spec: monitoringv1.RemoteWriteSpec{
+				Sigv4:  &monitoringv1.Sigv4{},
+				OAuth2: &monitoringv1.OAuth2WithTLSConfig{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "azure ad with managed identity",
+			spec: monitoringv1.RemoteWriteSpec{
+				AzureAD: &monitoringv1.AzureAD{
+					ManagedIdentity: &monitoringv1.ManagedIdentity{ClientID: "client-id"},
+				},
+			},
+		},
+		{
+			name: "azure ad and sigv4",
+			spec: monitoringv1.RemoteWriteSpec{
+				AzureAD: &monitoringv1.AzureAD{
+					ManagedIdentity: &monitoringv1.ManagedIdentity{ClientID: "client-id"},
+				},
+				Sigv4: &monitoringv1.Sigv4{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "azure ad without managed identity nor oauth",
+			spec: monitoringv1.RemoteWriteSpec{
+				AzureAD: &monitoringv1.AzureAD{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid queue config",
+			spec: monitoringv1.RemoteWriteSpec{
+				QueueConfig: &monitoringv1.QueueConfig{
+					Capacity:          2500,
+					MinShards:         1,
+					MaxShards:         200,
+					MaxSamplesPerSend: 500,
+				},
+			},
+		},
+		{
+			name: "min shards greater than max shards",
+			spec: monitoringv1.RemoteWriteSpec{
+				QueueConfig: &monitoringv1.QueueConfig{
+					MinShards: 10,
+					MaxShards: 5,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity lower than max samples per send",
+			spec: monitoringv1.RemoteWriteSpec{
+				QueueConfig: &monitoringv1.QueueConfig{
+					Capacity:          100,
+					MaxSamplesPerSend: 500,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative capacity",
+			spec: monitoringv1.RemoteWriteSpec{
+				QueueConfig: &monitoringv1.QueueConfig{
+					Capacity: -1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "azure ad with managed identity and oauth",
+			spec: monitoringv1.RemoteWriteSpec{
+				AzureAD: &monitoringv1.AzureAD{
+					ManagedIdentity: &monitoringv1.ManagedIdentity{ClientID: "client-id"},
+					OAuth:           &monitoringv1.AzureOAuth{ClientID: "client-id", TenantID: "tenant-id"},
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRemoteWriteSpec(tc.spec)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestShardRetentionPeriod(t *testing.T) {
+	retain := monitoringv1.RetainWhenScaledRetentionType
+	del := monitoringv1.DeleteWhenScaledRetentionType
+
+	for _, tc := range []struct {
+		name      string
+		retention string
+		policy    *monitoringv1.ShardRetentionPolicy
+		expected  time.Duration
+		wantErr   bool
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name:   "delete",
+			policy: &monitoringv1.ShardRetentionPolicy{WhenScaled: &del, RetainFor: "1h"},
+		},
+		{
+			name:     "retain with default retention",
+			policy:   &monitoringv1.ShardRetentionPolicy{WhenScaled: &retain},
+			expected: 24 * time.Hour,
+		},
+		{
+			name:      "retain with Prometheus retention",
+			retention: "7d",
+			policy:    &monitoringv1.ShardRetentionPolicy{WhenScaled: &retain},
+			expected:  7 * 24 * time.Hour,
+		},
+		{
+			name:      "retain for",
+			retention: "7d",
+			policy:    &monitoringv1.ShardRetentionPolicy{WhenScaled: &retain, RetainFor: "2h"},
+			expected:  2 * time.Hour,
+		},
+		{
+			name:    "invalid retain for",
+			policy:  &monitoringv1.ShardRetentionPolicy{WhenScaled: &retain, RetainFor: "2 hours"},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					Retention:            tc.retention,
+					ShardRetentionPolicy: tc.policy,
+				},
+			}
+
+			d, err := shardRetentionPeriod(p)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if d != tc.expected {
+				t.Fatalf("expected retention period %v, got %v", tc.expected, d)
+			}
+		})
+	}
+}
+
+func TestRolloutMaxUnavailable(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		maxUnavailable *intstr.IntOrString
+		expected       int
+	}{
+		{
+			name:     "default",
+			expected: 1,
+		},
+		{
+			name:           "absolute value",
+			maxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 3},
+			expected:       3,
+		},
+		{
+			name:           "percentage",
+			maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+			expected:       2,
+		},
+		{
+			name:           "percentage rounded down to zero",
+			maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "5%"},
+			expected:       1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			n, err := rolloutMaxUnavailable(&monitoringv1.StatefulSetUpdateStrategy{MaxUnavailable: tc.maxUnavailable}, 10)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if n != tc.expected {
+				t.Fatalf("expected %d, got %d", tc.expected, n)
+			}
+		})
+	}
+}
+
+func TestPodsToRollOut(t *testing.T) {
+	makePod := func(name, sset, revision string, ready bool) v1.Pod {
+		controller := true
+		status := v1.ConditionFalse
+		if ready {
+			status = v1.ConditionTrue
+		}
+
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Labels:          map[string]string{appsv1.StatefulSetRevisionLabel: revision},
+				OwnerReferences: []metav1.OwnerReference{{Name: sset, Controller: &controller}},
+			},
+			Status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+			},
+		}
+	}
+
+	revisions := map[string]string{
+		"prometheus-test":         "new",
+		"prometheus-test-shard-1": "new",
+	}
+
+	for _, tc := range []struct {
+		name           string
+		pods           []v1.Pod
+		expectedPods   int
+		maxUnavailable int
+		expected       []string
+	}{
+		{
+			name: "up-to-date",
+			pods: []v1.Pod{
+				makePod("prometheus-test-0", "prometheus-test", "new", true),
+				makePod("prometheus-test-shard-1-0", "prometheus-test-shard-1", "new", true),
+			},
+			expectedPods:   2,
+			maxUnavailable: 1,
+		},
+		{
+			name: "one pod at a time across shards",
+			pods: []v1.Pod{
+				makePod("prometheus-test-0", "prometheus-test", "old", true),
+				makePod("prometheus-test-shard-1-0", "prometheus-test-shard-1", "old", true),
+			},
+			expectedPods:   2,
+			maxUnavailable: 1,
+			expected:       []string{"prometheus-test-0"},
+		},
+		{
+			name: "max unavailable reached",
+			pods: []v1.Pod{
+				makePod("prometheus-test-0", "prometheus-test", "new", false),
+				makePod("prometheus-test-shard-1-0", "prometheus-test-shard-1", "old", true),
+			},
+			expectedPods:   2,
+			maxUnavailable: 1,
+		},
+		{
+			name: "missing pods count as unavailable",
+			pods: []v1.Pod{
+				makePod("prometheus-test-shard-1-0", "prometheus-test-shard-1", "old", true),
+			},
+			expectedPods:   2,
+			maxUnavailable: 1,
+		},
+		{
+			name: "outdated unavailable pods are always deleted",
+			pods: []v1.Pod{
+				makePod("prometheus-test-0", "prometheus-test", "old", false),
+				makePod("prometheus-test-shard-1-0", "prometheus-test-shard-1", "old", true),
+			},
+			expectedPods:   2,
+			maxUnavailable: 1,
+			expected:       []string{"prometheus-test-0"},
+		},
+		{
+			name: "several pods at a time",
+			pods: []v1.Pod{
+				makePod("prometheus-test-0", "prometheus-test", "old", true),
+				makePod("prometheus-test-1", "prometheus-test", "old", true),
+				makePod("prometheus-test-shard-1-0", "prometheus-test-shard-1", "old", true),
+			},
+			expectedPods:   3,
+			maxUnavailable: 2,
+			expected:       []string{"prometheus-test-0", "prometheus-test-1"},
+		},
+		{
+			name: "unknown update revision",
+			pods: []v1.Pod{
+				makePod("prometheus-test-shard-2-0", "prometheus-test-shard-2", "old", true),
+			},
+			expectedPods:   1,
+			maxUnavailable: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var names []string
+			for _, pod := range podsToRollOut(tc.pods, revisions, tc.expectedPods, tc.maxUnavailable) {
+				names = append(names, pod.Name)
+			}
+
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Fatalf("expected pods %v to be deleted, got %v", tc.expected, names)
+			}
+		})
+	}
+}
+
+func TestValidateTracingConfig(t *testing.T) {
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	for _, tc := range []struct {
+		name    string
+		config  monitoringv1.PrometheusTracingConfig
+		wantErr bool
+	}{
+		{
+			name: "endpoint only",
+			config: monitoringv1.PrometheusTracingConfig{
+				Endpoint: "otel-collector:4317",
+			},
+		},
+		{
+			name: "valid sampling fraction",
+			config: monitoringv1.PrometheusTracingConfig{
+				Endpoint:         "otel-collector:4317",
+				SamplingFraction: quantity("0.5"),
+			},
+		},
+		{
+			name: "sampling fraction greater than 1",
+			config: monitoringv1.PrometheusTracingConfig{
+				Endpoint:         "otel-collector:4317",
+				SamplingFraction: quantity("2"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative sampling fraction",
+			config: monitoringv1.PrometheusTracingConfig{
+				Endpoint:         "otel-collector:4317",
+				SamplingFraction: quantity("-0.1"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid TLS config",
+			config: monitoringv1.PrometheusTracingConfig{
+				Endpoint: "otel-collector:4317",
+				TLSConfig: &monitoringv1.TLSConfig{
+					CAFile: "/etc/ca.crt",
+					SafeTLSConfig: monitoringv1.SafeTLSConfig{
+						CA: monitoringv1.SecretOrConfigMap{
+							Secret: &v1.SecretKeySelector{},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTracingConfig(&tc.config)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateRemoteReadSpec(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		spec    monitoringv1.RemoteReadSpec
+		wantErr bool
+	}{
+		{
+			name: "no authentication",
+			spec: monitoringv1.RemoteReadSpec{},
+		},
+		{
+			name: "authorization only",
+			spec: monitoringv1.RemoteReadSpec{
+				Authorization: &monitoringv1.SafeAuthorization{},
+			},
+		},
+		{
+			name: "authorization and bearer token file",
+			spec: monitoringv1.RemoteReadSpec{
+				Authorization:   &monitoringv1.SafeAuthorization{},
+				BearerTokenFile: "/tmp/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "oauth2 and basic auth",
+			spec: monitoringv1.RemoteReadSpec{
+				OAuth2:    &monitoringv1.OAuth2WithTLSConfig{},
+				BasicAuth: &monitoringv1.BasicAuth{},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRemoteReadSpec(tc.spec)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateEndpointAuthentication(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		endpoint monitoringv1.Endpoint
+		wantErr  bool
+	}{
+		{
+			name:     "no authentication",
+			endpoint: monitoringv1.Endpoint{},
+		},
+		{
+			name: "oauth2 only",
+			endpoint: monitoringv1.Endpoint{
+				OAuth2: &monitoringv1.OAuth2WithTLSConfig{},
+			},
+		},
+		{
+			name: "oauth2 and basic auth",
+			endpoint: monitoringv1.Endpoint{
+				OAuth2:    &monitoringv1.OAuth2WithTLSConfig{},
+				BasicAuth: &monitoringv1.BasicAuth{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "authorization and basic auth",
+			endpoint: monitoringv1.Endpoint{
+				Authorization: &monitoringv1.SafeAuthorization{},
+				BasicAuth:     &monitoringv1.BasicAuth{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "oauth2 and bearer token secret",
+			endpoint: monitoringv1.Endpoint{
+				OAuth2: &monitoringv1.OAuth2WithTLSConfig{},
+				BearerTokenSecret: v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "token"},
+					Key:                  "token",
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateEndpointAuthentication(tc.endpoint)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidatePodMetricsEndpointPort(t *testing.T) {
+	portNumber := int32(8080)
+	targetPort := intstr.FromInt(8080)
+
+	for _, tc := range []struct {
+		name     string
+		endpoint monitoringv1.PodMetricsEndpoint
+		wantErr  bool
+	}{
+		{
+			name: "port only",
+			endpoint: monitoringv1.PodMetricsEndpoint{
+				Port: "web",
+			},
+		},
+		{
+			name: "port number only",
+			endpoint: monitoringv1.PodMetricsEndpoint{
+				PortNumber: &portNumber,
+			},
+		},
+		{
+			name: "port number and port",
+			endpoint: monitoringv1.PodMetricsEndpoint{
+				Port:       "web",
+				PortNumber: &portNumber,
+			},
+			wantErr: true,
+		},
+		{
+			name: "port number and target port",
+			endpoint: monitoringv1.PodMetricsEndpoint{
+				PortNumber: &portNumber,
+				TargetPort: &targetPort,
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePodMetricsEndpointPort(tc.endpoint)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateProxyConfig(t *testing.T) {
+	proxyURL := "http://proxy.example.com:3128"
+	noProxy := "localhost"
+	fromEnvironment := true
+	header := map[string]v1.SecretKeySelector{
+		"Proxy-Authorization": {
+			LocalObjectReference: v1.LocalObjectReference{Name: "proxy"},
+			Key:                  "auth",
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		pc      monitoringv1.ProxyConfig
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "proxy url with no proxy and header",
+			pc: monitoringv1.ProxyConfig{
+				ProxyURL:           &proxyURL,
+				NoProxy:            &noProxy,
+				ProxyConnectHeader: header,
+			},
+		},
+		{
+			name: "proxy from environment with header",
+			pc: monitoringv1.ProxyConfig{
+				ProxyFromEnvironment: &fromEnvironment,
+				ProxyConnectHeader:   header,
+			},
+		},
+		{
+			name: "proxy url and proxy from environment",
+			pc: monitoringv1.ProxyConfig{
+				ProxyURL:             &proxyURL,
+				ProxyFromEnvironment: &fromEnvironment,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no proxy without proxy url",
+			pc: monitoringv1.ProxyConfig{
+				NoProxy: &noProxy,
+			},
+			wantErr: true,
+		},
+		{
+			name: "header without proxy",
+			pc: monitoringv1.ProxyConfig{
+				ProxyConnectHeader: header,
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateProxyConfig(tc.pc)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateAlertmanagerEndpoints(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		am      monitoringv1.AlertmanagerEndpoints
+		wantErr bool
+	}{
+		{
+			name: "no authentication",
+			am:   monitoringv1.AlertmanagerEndpoints{},
+		},
+		{
+			name: "sigv4 only",
+			am: monitoringv1.AlertmanagerEndpoints{
+				Sigv4: &monitoringv1.Sigv4{},
+			},
+		},
+		{
+			name: "authorization and bearer token file",
+			am: monitoringv1.AlertmanagerEndpoints{
+				Authorization:   &monitoringv1.SafeAuthorization{},
+				BearerTokenFile: "/tmp/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "basic auth and sigv4",
+			am: monitoringv1.AlertmanagerEndpoints{
+				BasicAuth: &monitoringv1.BasicAuth{},
+				Sigv4:     &monitoringv1.Sigv4{},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAlertmanagerEndpoints(tc.am)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateScrapeClasses(t *testing.T) {
+	yes := true
+
+	for _, tc := range []struct {
+		name          string
+		scrapeClasses []monitoringv1.ScrapeClass
+		wantErr       bool
+	}{
+		{
+			name: "no scrape class",
+		},
+		{
+			name: "one default scrape class",
+			scrapeClasses: []monitoringv1.ScrapeClass{
+				{
+					Name:    "default",
+					Default: &yes,
+					TLSConfig: &monitoringv1.TLSConfig{
+						CAFile: "/etc/ca.crt",
+					},
+				},
+				{
+					Name: "other",
+				},
+			},
+		},
+		{
+			name: "two default scrape classes",
+			scrapeClasses: []monitoringv1.ScrapeClass{
+				{
+					Name:    "default",
+					Default: &yes,
+				},
+				{
+					Name:    "other",
+					Default: &yes,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tls config with secret",
+			scrapeClasses: []monitoringv1.ScrapeClass{
+				{
+					Name: "default",
+					TLSConfig: &monitoringv1.TLSConfig{
+						SafeTLSConfig: monitoringv1.SafeTLSConfig{
+							KeySecret: &v1.SecretKeySelector{},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tls config with cert file but no key file",
+			scrapeClasses: []monitoringv1.ScrapeClass{
+				{
+					Name: "default",
+					TLSConfig: &monitoringv1.TLSConfig{
+						CertFile: "/etc/tls.crt",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateScrapeClasses(tc.scrapeClasses)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"path"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -30,13 +31,15 @@ import (
 
 	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	namespacelabeler "github.com/prometheus-operator/prometheus-operator/pkg/namespace-labeler"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
 const (
-	kubernetesSDRoleEndpoint = "endpoints"
-	kubernetesSDRolePod      = "pod"
-	kubernetesSDRoleIngress  = "ingress"
+	kubernetesSDRoleEndpoint      = "endpoints"
+	kubernetesSDRoleEndpointSlice = "endpointslice"
+	kubernetesSDRolePod           = "pod"
+	kubernetesSDRoleIngress       = "ingress"
 )
 
 var (
@@ -114,6 +117,88 @@ func addTLStoYaml(cfg yaml.MapSlice, namespace string, tls *v1.TLSConfig) yaml.M
 	return cfg
 }
 
+func (cg *configGenerator) generateOAuth2Config(version semver.Version, namespace string, oauth2 *v1.OAuth2WithTLSConfig, s assets.OAuth2Credentials) yaml.MapSlice {
+	cfg := yaml.MapSlice{
+		{Key: "client_id", Value: s.ClientID},
+		{Key: "client_secret", Value: s.ClientSecret},
+		{Key: "token_url", Value: oauth2.TokenURL},
+	}
+
+	if len(oauth2.Scopes) > 0 {
+		cfg = append(cfg, yaml.MapItem{Key: "scopes", Value: oauth2.Scopes})
+	}
+
+	if len(oauth2.EndpointParams) > 0 {
+		cfg = append(cfg, yaml.MapItem{Key: "endpoint_params", Value: stringMapToMapSlice(oauth2.EndpointParams)})
+	}
+
+	if oauth2.TLSConfig != nil {
+		if version.LT(semver.MustParse("2.43.0")) {
+			level.Warn(cg.logger).Log("msg", "OAuth2 TLS configuration is only supported in Prometheus versions 2.43.0 and newer, ignoring it", "version", version)
+		} else {
+			cfg = addSafeTLStoYaml(cfg, namespace, *oauth2.TLSConfig)
+		}
+	}
+
+	return cfg
+}
+
+func generateSigv4Config(sigv4 *v1.Sigv4, s assets.SigV4Credentials) yaml.MapSlice {
+	cfg := yaml.MapSlice{}
+
+	if sigv4.Region != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "region", Value: sigv4.Region})
+	}
+
+	if s.AccessKeyID != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "access_key", Value: s.AccessKeyID})
+	}
+
+	if s.SecretKeyID != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "secret_key", Value: s.SecretKeyID})
+	}
+
+	if sigv4.Profile != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "profile", Value: sigv4.Profile})
+	}
+
+	if sigv4.RoleArn != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "role_arn", Value: sigv4.RoleArn})
+	}
+
+	return cfg
+}
+
+func generateAzureADConfig(azureAD *v1.AzureAD, s assets.AzureOAuthCredentials) yaml.MapSlice {
+	cfg := yaml.MapSlice{}
+
+	if azureAD.Cloud != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "cloud", Value: *azureAD.Cloud})
+	}
+
+	if azureAD.ManagedIdentity != nil {
+		cfg = append(cfg, yaml.MapItem{
+			Key: "managed_identity",
+			Value: yaml.MapSlice{
+				{Key: "client_id", Value: azureAD.ManagedIdentity.ClientID},
+			},
+		})
+	}
+
+	if azureAD.OAuth != nil {
+		cfg = append(cfg, yaml.MapItem{
+			Key: "oauth",
+			Value: yaml.MapSlice{
+				{Key: "client_id", Value: azureAD.OAuth.ClientID},
+				{Key: "client_secret", Value: s.ClientSecret},
+				{Key: "tenant_id", Value: azureAD.OAuth.TenantID},
+			},
+		})
+	}
+
+	return cfg
+}
+
 func buildExternalLabels(p *v1.Prometheus) yaml.MapSlice {
 	m := map[string]string{}
 
@@ -160,10 +245,14 @@ func (cg *configGenerator) generateConfig(
 	probes map[string]*v1.Probe,
 	basicAuthSecrets map[string]assets.BasicAuthCredentials,
 	bearerTokens map[string]assets.BearerToken,
+	oauth2Secrets map[string]assets.OAuth2Credentials,
+	sigv4Secrets map[string]assets.SigV4Credentials,
+	azureOAuthSecrets map[string]assets.AzureOAuthCredentials,
 	additionalScrapeConfigs []byte,
 	additionalAlertRelabelConfigs []byte,
 	additionalAlertManagerConfigs []byte,
 	ruleConfigMapNames []string,
+	localNodeOnly bool,
 ) ([]byte, error) {
 	versionStr := p.Spec.Version
 	if versionStr == "" {
@@ -187,11 +276,15 @@ func (cg *configGenerator) generateConfig(
 		evaluationInterval = p.Spec.EvaluationInterval
 	}
 
-	globalItems := yaml.MapSlice{
-		{Key: "evaluation_interval", Value: evaluationInterval},
-		{Key: "scrape_interval", Value: scrapeInterval},
-		{Key: "external_labels", Value: buildExternalLabels(p)},
+	// The agent mode doesn't evaluate rules.
+	var globalItems yaml.MapSlice
+	if !isAgent(p) {
+		globalItems = append(globalItems, yaml.MapItem{Key: "evaluation_interval", Value: evaluationInterval})
 	}
+	globalItems = append(globalItems,
+		yaml.MapItem{Key: "scrape_interval", Value: scrapeInterval},
+		yaml.MapItem{Key: "external_labels", Value: buildExternalLabels(p)},
+	)
 
 	if p.Spec.ScrapeTimeout != "" {
 		globalItems = append(globalItems, yaml.MapItem{
@@ -199,22 +292,49 @@ func (cg *configGenerator) generateConfig(
 		})
 	}
 
-	if version.GTE(semver.MustParse("2.16.0")) && p.Spec.QueryLogFile != "" {
+	globalItems = cg.addScrapeProtocols(globalItems, version, p.Spec.ScrapeProtocols)
+
+	// The agent mode doesn't evaluate queries.
+	if version.GTE(semver.MustParse("2.16.0")) && p.Spec.QueryLogFile != "" && !isAgent(p) {
 		globalItems = append(globalItems, yaml.MapItem{
-			Key: "query_log_file", Value: p.Spec.QueryLogFile,
+			Key: "query_log_file", Value: logFilePath(p.Spec.QueryLogFile),
 		})
 	}
 
+	if p.Spec.RuleQueryOffset != nil && !isAgent(p) {
+		if version.GTE(semver.MustParse("2.53.0")) {
+			globalItems = append(globalItems, yaml.MapItem{
+				Key: "rule_query_offset", Value: *p.Spec.RuleQueryOffset,
+			})
+		} else {
+			level.Warn(cg.logger).Log("msg", "ruleQueryOffset is only supported in Prometheus versions 2.53.0 and newer, ignoring it", "version", version)
+		}
+	}
+
+	if p.Spec.ScrapeFailureLogFile != "" {
+		if version.GTE(semver.MustParse("2.55.0")) {
+			globalItems = append(globalItems, yaml.MapItem{
+				Key: "scrape_failure_log_file", Value: logFilePath(p.Spec.ScrapeFailureLogFile),
+			})
+		} else {
+			level.Warn(cg.logger).Log("msg", "scrapeFailureLogFile is only supported in Prometheus versions 2.55.0 and newer, ignoring it", "version", version)
+		}
+	}
+
 	cfg = append(cfg, yaml.MapItem{Key: "global", Value: globalItems})
 
-	ruleFilePaths := []string{}
-	for _, name := range ruleConfigMapNames {
-		ruleFilePaths = append(ruleFilePaths, rulesDir+"/"+name+"/*.yaml")
+	// The agent mode rejects configurations with rule files and alerting
+	// sections.
+	if !isAgent(p) {
+		ruleFilePaths := []string{}
+		for _, name := range ruleConfigMapNames {
+			ruleFilePaths = append(ruleFilePaths, rulesDir+"/"+name+"/*.yaml")
+		}
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "rule_files",
+			Value: ruleFilePaths,
+		})
 	}
-	cfg = append(cfg, yaml.MapItem{
-		Key:   "rule_files",
-		Value: ruleFilePaths,
-	})
 
 	sMonIdentifiers := make([]string, len(sMons))
 	i := 0
@@ -253,67 +373,92 @@ func (cg *configGenerator) generateConfig(
 	}
 
 	var scrapeConfigs []yaml.MapSlice
+	endpointsRole := cg.endpointsRole(version, p.Spec.ServiceDiscoveryRole)
 	for _, identifier := range sMonIdentifiers {
-		for i, ep := range sMons[identifier].Spec.Endpoints {
-			scrapeConfigs = append(scrapeConfigs,
-				cg.generateServiceMonitorConfig(
-					version,
-					sMons[identifier],
-					ep, i,
-					apiserverConfig,
-					basicAuthSecrets,
-					bearerTokens,
-					p.Spec.OverrideHonorLabels,
-					p.Spec.OverrideHonorTimestamps,
-					p.Spec.IgnoreNamespaceSelectors,
-					p.Spec.EnforcedNamespaceLabel,
-					p.Spec.EnforcedSampleLimit,
-					p.Spec.EnforcedTargetLimit,
-					shards,
-				),
+		m := sMons[identifier]
+		for i, ep := range m.Spec.Endpoints {
+			scrapeConfig := cg.generateServiceMonitorConfig(
+				version,
+				m,
+				ep, i,
+				getScrapeClass(p, m.Spec.ScrapeClassName),
+				endpointsRole,
+				apiserverConfig,
+				basicAuthSecrets,
+				bearerTokens,
+				oauth2Secrets,
+				p.Spec.OverrideHonorLabels,
+				p.Spec.OverrideHonorTimestamps,
+				p.Spec.IgnoreNamespaceSelectors,
+				enforcedNamespaceLabel(p, v1.ServiceMonitorName, m),
+				p.Spec.EnforcedSampleLimit,
+				p.Spec.EnforcedTargetLimit,
+				shards,
 			)
+			scrapeConfigs = append(scrapeConfigs, cg.addScrapeLimits(scrapeConfig, version, p, scrapeLimits{
+				labelLimit:            m.Spec.LabelLimit,
+				labelNameLengthLimit:  m.Spec.LabelNameLengthLimit,
+				labelValueLengthLimit: m.Spec.LabelValueLengthLimit,
+				keepDroppedTargets:    m.Spec.KeepDroppedTargets,
+			}))
 		}
 	}
 	for _, identifier := range pMonIdentifiers {
-		for i, ep := range pMons[identifier].Spec.PodMetricsEndpoints {
-			scrapeConfigs = append(scrapeConfigs,
-				cg.generatePodMonitorConfig(
-					version,
-					pMons[identifier], ep, i,
-					apiserverConfig,
-					basicAuthSecrets,
-					bearerTokens,
-					p.Spec.OverrideHonorLabels,
-					p.Spec.OverrideHonorTimestamps,
-					p.Spec.IgnoreNamespaceSelectors,
-					p.Spec.EnforcedNamespaceLabel,
-					p.Spec.EnforcedSampleLimit,
-					p.Spec.EnforcedTargetLimit,
-					shards,
-				),
-			)
-		}
-	}
-
-	for _, identifier := range probeIdentifiers {
-		scrapeConfigs = append(scrapeConfigs,
-			cg.generateProbeConfig(
+		m := pMons[identifier]
+		for i, ep := range m.Spec.PodMetricsEndpoints {
+			scrapeConfig := cg.generatePodMonitorConfig(
 				version,
-				probes[identifier],
+				m, ep, i,
+				getScrapeClass(p, m.Spec.ScrapeClassName),
 				apiserverConfig,
 				basicAuthSecrets,
+				bearerTokens,
 				p.Spec.OverrideHonorLabels,
 				p.Spec.OverrideHonorTimestamps,
 				p.Spec.IgnoreNamespaceSelectors,
-				p.Spec.EnforcedNamespaceLabel,
-			),
+				enforcedNamespaceLabel(p, v1.PodMonitorName, m),
+				p.Spec.EnforcedSampleLimit,
+				p.Spec.EnforcedTargetLimit,
+				shards,
+				localNodeOnly,
+			)
+			scrapeConfigs = append(scrapeConfigs, cg.addScrapeLimits(scrapeConfig, version, p, scrapeLimits{
+				labelLimit:            m.Spec.LabelLimit,
+				labelNameLengthLimit:  m.Spec.LabelNameLengthLimit,
+				labelValueLengthLimit: m.Spec.LabelValueLengthLimit,
+				keepDroppedTargets:    m.Spec.KeepDroppedTargets,
+			}))
+		}
+	}
+
+	for _, identifier := range probeIdentifiers {
+		m := probes[identifier]
+		scrapeConfig := cg.generateProbeConfig(
+			version,
+			m,
+			getScrapeClass(p, m.Spec.ScrapeClassName),
+			apiserverConfig,
+			basicAuthSecrets,
+			bearerTokens,
+			p.Spec.OverrideHonorLabels,
+			p.Spec.OverrideHonorTimestamps,
+			p.Spec.IgnoreNamespaceSelectors,
+			enforcedNamespaceLabel(p, v1.ProbeName, m),
+			p.Spec.EnforcedSampleLimit,
+			p.Spec.EnforcedTargetLimit,
 		)
+		scrapeConfigs = append(scrapeConfigs, cg.addScrapeLimits(scrapeConfig, version, p, scrapeLimits{
+			labelLimit:            m.Spec.LabelLimit,
+			labelNameLengthLimit:  m.Spec.LabelNameLengthLimit,
+			labelValueLengthLimit: m.Spec.LabelValueLengthLimit,
+			keepDroppedTargets:    m.Spec.KeepDroppedTargets,
+		}))
 	}
 
 	var alertmanagerConfigs []yaml.MapSlice
 	if p.Spec.Alerting != nil {
-		for _, am := range p.Spec.Alerting.Alertmanagers {
-			alertmanagerConfigs = append(alertmanagerConfigs, cg.generateAlertmanagerConfig(version, am, apiserverConfig, basicAuthSecrets))
+		for i, am := range p.Spec.Alerting.Alertmanagers {
+			alertmanagerConfigs = append(alertmanagerConfigs, cg.generateAlertmanagerConfig(version, p, i, am, apiserverConfig, basicAuthSecrets, bearerTokens, sigv4Secrets))
 		}
 	}
 
@@ -364,31 +509,153 @@ func (cg *configGenerator) generateConfig(
 		return nil, errors.Wrap(err, "unmarshalling additional alerting relabel configs failed")
 	}
 
-	cfg = append(cfg, yaml.MapItem{
-		Key: "alerting",
-		Value: yaml.MapSlice{
-			{
-				Key:   "alert_relabel_configs",
-				Value: append(alertRelabelConfigs, additionalAlertRelabelConfigsYaml...),
-			},
-			{
-				Key:   "alertmanagers",
-				Value: alertmanagerConfigs,
+	if !isAgent(p) {
+		cfg = append(cfg, yaml.MapItem{
+			Key: "alerting",
+			Value: yaml.MapSlice{
+				{
+					Key:   "alert_relabel_configs",
+					Value: append(alertRelabelConfigs, additionalAlertRelabelConfigsYaml...),
+				},
+				{
+					Key:   "alertmanagers",
+					Value: alertmanagerConfigs,
+				},
 			},
-		},
-	})
+		})
+	}
+
+	// The agent mode has no TSDB nor exemplar storage.
+	var storage yaml.MapSlice
+	if p.Spec.TSDB.OutOfOrderTimeWindow != "" && !isAgent(p) {
+		if version.GTE(semver.MustParse("2.39.0")) {
+			storage = append(storage, yaml.MapItem{
+				Key: "tsdb",
+				Value: yaml.MapSlice{
+					{Key: "out_of_order_time_window", Value: p.Spec.TSDB.OutOfOrderTimeWindow},
+				},
+			})
+		} else {
+			level.Warn(cg.logger).Log("msg", "outOfOrderTimeWindow is only supported in Prometheus versions 2.39.0 and newer, ignoring it", "version", version)
+		}
+	}
+
+	if p.Spec.Exemplars != nil && p.Spec.Exemplars.MaxSize != nil && !isAgent(p) {
+		if version.GTE(semver.MustParse("2.29.0")) {
+			storage = append(storage, yaml.MapItem{
+				Key: "exemplars",
+				Value: yaml.MapSlice{
+					{Key: "max_exemplars", Value: *p.Spec.Exemplars.MaxSize},
+				},
+			})
+		} else {
+			level.Warn(cg.logger).Log("msg", "exemplars maxSize is only supported in Prometheus versions 2.29.0 and newer, ignoring it", "version", version)
+		}
+	}
+
+	if len(storage) > 0 {
+		cfg = append(cfg, yaml.MapItem{Key: "storage", Value: storage})
+	}
+
+	if p.Spec.TracingConfig != nil && !isAgent(p) {
+		if version.GTE(semver.MustParse("2.34.0")) {
+			tracing, err := generateTracingConfig(p)
+			if err != nil {
+				return nil, errors.Wrap(err, "generating tracing configuration failed")
+			}
+			cfg = append(cfg, tracing)
+		} else {
+			level.Warn(cg.logger).Log("msg", "tracingConfig is only supported in Prometheus versions 2.34.0 and newer, ignoring it", "version", version)
+		}
+	}
 
 	if len(p.Spec.RemoteWrite) > 0 {
-		cfg = append(cfg, cg.generateRemoteWriteConfig(version, p, basicAuthSecrets))
+		cfg = append(cfg, cg.generateRemoteWriteConfig(version, p, basicAuthSecrets, oauth2Secrets, sigv4Secrets, azureOAuthSecrets))
 	}
 
 	if len(p.Spec.RemoteRead) > 0 {
-		cfg = append(cfg, cg.generateRemoteReadConfig(version, p, basicAuthSecrets))
+		cfg = append(cfg, cg.generateRemoteReadConfig(version, p, basicAuthSecrets, bearerTokens, oauth2Secrets))
+	}
+
+	// The agent mode has no OTLP receiver.
+	if p.Spec.OTLP != nil && !isAgent(p) {
+		if otlp := cg.generateOTLPConfig(version, p.Spec.OTLP); len(otlp) > 0 {
+			cfg = append(cfg, yaml.MapItem{Key: "otlp", Value: otlp})
+		}
 	}
 
 	return yaml.Marshal(cfg)
 }
 
+func generateTracingConfig(p *v1.Prometheus) (yaml.MapItem, error) {
+	tracingConfig := p.Spec.TracingConfig
+	cfg := yaml.MapSlice{
+		{Key: "endpoint", Value: tracingConfig.Endpoint},
+	}
+
+	if tracingConfig.ClientType != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "client_type", Value: *tracingConfig.ClientType})
+	}
+
+	if tracingConfig.SamplingFraction != nil {
+		samplingFraction, err := strconv.ParseFloat(tracingConfig.SamplingFraction.AsDec().String(), 64)
+		if err != nil {
+			return yaml.MapItem{}, errors.Wrap(err, "invalid sampling fraction")
+		}
+		cfg = append(cfg, yaml.MapItem{Key: "sampling_fraction", Value: samplingFraction})
+	}
+
+	if tracingConfig.Insecure != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "insecure", Value: *tracingConfig.Insecure})
+	}
+
+	if len(tracingConfig.Headers) > 0 {
+		cfg = append(cfg, yaml.MapItem{Key: "headers", Value: stringMapToMapSlice(tracingConfig.Headers)})
+	}
+
+	if tracingConfig.Compression != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "compression", Value: *tracingConfig.Compression})
+	}
+
+	if tracingConfig.Timeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "timeout", Value: tracingConfig.Timeout})
+	}
+
+	cfg = addTLStoYaml(cfg, p.Namespace, tracingConfig.TLSConfig)
+
+	return yaml.MapItem{Key: "tracing", Value: cfg}, nil
+}
+
+func (cg *configGenerator) generateOTLPConfig(version semver.Version, otlpConfig *v1.OTLPConfig) yaml.MapSlice {
+	var otlp yaml.MapSlice
+
+	if len(otlpConfig.PromoteResourceAttributes) > 0 {
+		if version.GTE(semver.MustParse("2.54.0")) {
+			otlp = append(otlp, yaml.MapItem{Key: "promote_resource_attributes", Value: otlpConfig.PromoteResourceAttributes})
+		} else {
+			level.Warn(cg.logger).Log("msg", "promoteResourceAttributes is only supported in Prometheus versions 2.54.0 and newer, ignoring it", "version", version)
+		}
+	}
+
+	if otlpConfig.TranslationStrategy != nil {
+		if version.GTE(semver.MustParse("3.0.0")) {
+			otlp = append(otlp, yaml.MapItem{Key: "translation_strategy", Value: string(*otlpConfig.TranslationStrategy)})
+		} else {
+			level.Warn(cg.logger).Log("msg", "translationStrategy is only supported in Prometheus versions 3.0.0 and newer, ignoring it", "version", version)
+		}
+	}
+
+	if otlpConfig.KeepIdentifyingResourceAttributes != nil {
+		if version.GTE(semver.MustParse("3.1.0")) {
+			otlp = append(otlp, yaml.MapItem{Key: "keep_identifying_resource_attributes", Value: *otlpConfig.KeepIdentifyingResourceAttributes})
+		} else {
+			level.Warn(cg.logger).Log("msg", "keepIdentifyingResourceAttributes is only supported in Prometheus versions 3.1.0 and newer, ignoring it", "version", version)
+		}
+	}
+
+	return otlp
+}
+
 // honorLabels determines the value of honor_labels.
 // if overrideHonorLabels is true and user tries to set the
 // value to true, we want to set honor_labels to false.
@@ -421,7 +688,9 @@ func (cg *configGenerator) generatePodMonitorConfig(
 	version semver.Version,
 	m *v1.PodMonitor,
 	ep v1.PodMetricsEndpoint,
-	i int, apiserverConfig *v1.APIServerConfig,
+	i int,
+	scrapeClass *v1.ScrapeClass,
+	apiserverConfig *v1.APIServerConfig,
 	basicAuthSecrets map[string]assets.BasicAuthCredentials,
 	bearerTokens map[string]assets.BearerToken,
 	ignoreHonorLabels bool,
@@ -431,6 +700,7 @@ func (cg *configGenerator) generatePodMonitorConfig(
 	enforcedSampleLimit *uint64,
 	enforcedTargetLimit *uint64,
 	shards int32,
+	localNodeOnly bool,
 ) yaml.MapSlice {
 	hl := honorLabels(ep.HonorLabels, ignoreHonorLabels)
 	cfg := yaml.MapSlice{
@@ -448,7 +718,16 @@ func (cg *configGenerator) generatePodMonitorConfig(
 	}
 
 	selectedNamespaces := getNamespacesFromNamespaceSelector(&m.Spec.NamespaceSelector, m.Namespace, ignoreNamespaceSelectors)
-	cfg = append(cfg, cg.generateK8SSDConfig(selectedNamespaces, apiserverConfig, basicAuthSecrets, kubernetesSDRolePod))
+	sdConfig := cg.generateK8SSDConfig(selectedNamespaces, apiserverConfig, basicAuthSecrets, kubernetesSDRolePod)
+	sdConfig = cg.addAttachMetadata(sdConfig, version, attachMetadata(m.Spec.AttachMetadata, scrapeClass), kubernetesSDRolePod)
+	labelSelector := cg.roleLabelSelector(version, m.Spec.SelectorMechanism, m.Spec.Selector)
+	if labelSelector != "" {
+		sdConfig = addSDSelector(sdConfig, kubernetesSDRolePod, "label", labelSelector)
+	}
+	if localNodeOnly {
+		sdConfig = restrictPodDiscoveryToNode(sdConfig)
+	}
+	cfg = append(cfg, sdConfig)
 
 	if ep.Interval != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
@@ -456,12 +735,12 @@ func (cg *configGenerator) generatePodMonitorConfig(
 	if ep.ScrapeTimeout != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
 	}
+	cfg = cg.addScrapeProtocols(cfg, version, m.Spec.ScrapeProtocols)
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
 	}
-	if ep.ProxyURL != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "proxy_url", Value: ep.ProxyURL})
-	}
+	cfg = cg.addProxyConfigToYaml(cfg, version, ep.ProxyConfig, bearerTokens, fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i))
+	cfg = cg.addHTTPClientOptions(cfg, version, ep.FollowRedirects, ep.EnableHttp2)
 	if ep.Params != nil {
 		cfg = append(cfg, yaml.MapItem{Key: "params", Value: ep.Params})
 	}
@@ -471,6 +750,8 @@ func (cg *configGenerator) generatePodMonitorConfig(
 
 	if ep.TLSConfig != nil {
 		cfg = addSafeTLStoYaml(cfg, m.Namespace, ep.TLSConfig.SafeTLSConfig)
+	} else {
+		cfg = addTLStoYaml(cfg, m.Namespace, scrapeClass.TLSConfig)
 	}
 
 	if ep.BearerTokenSecret.Name != "" {
@@ -490,53 +771,62 @@ func (cg *configGenerator) generatePodMonitorConfig(
 		}
 	}
 
+	cfg = cg.addSafeAuthorizationToYaml(cfg, version, ep.Authorization, bearerTokens, fmt.Sprintf("podMonitor/auth/%s/%s/%d", m.Namespace, m.Name, i))
+
+	if ep.BearerTokenSecret.Name == "" && ep.BasicAuth == nil && ep.Authorization == nil {
+		cfg = cg.addScrapeClassAuthorization(cfg, version, scrapeClass, bearerTokens)
+	}
+
 	var (
 		relabelings []yaml.MapSlice
 		labelKeys   []string
 	)
-	// Filter targets by pods selected by the monitor.
-	// Exact label matches.
-	for k := range m.Spec.Selector.MatchLabels {
-		labelKeys = append(labelKeys, k)
-	}
-	sort.Strings(labelKeys)
+	// Filter targets by pods selected by the monitor unless the selector is
+	// enforced by the service discovery.
+	if labelSelector == "" {
+		// Exact label matches.
+		for k := range m.Spec.Selector.MatchLabels {
+			labelKeys = append(labelKeys, k)
+		}
+		sort.Strings(labelKeys)
 
-	for _, k := range labelKeys {
-		relabelings = append(relabelings, yaml.MapSlice{
-			{Key: "action", Value: "keep"},
-			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(k)}},
-			{Key: "regex", Value: m.Spec.Selector.MatchLabels[k]},
-		})
-	}
-	// Set based label matching. We have to map the valid relations
-	// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
-	for _, exp := range m.Spec.Selector.MatchExpressions {
-		switch exp.Operator {
-		case metav1.LabelSelectorOpIn:
-			relabelings = append(relabelings, yaml.MapSlice{
-				{Key: "action", Value: "keep"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: strings.Join(exp.Values, "|")},
-			})
-		case metav1.LabelSelectorOpNotIn:
-			relabelings = append(relabelings, yaml.MapSlice{
-				{Key: "action", Value: "drop"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: strings.Join(exp.Values, "|")},
-			})
-		case metav1.LabelSelectorOpExists:
+		for _, k := range labelKeys {
 			relabelings = append(relabelings, yaml.MapSlice{
 				{Key: "action", Value: "keep"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_labelpresent_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: "true"},
-			})
-		case metav1.LabelSelectorOpDoesNotExist:
-			relabelings = append(relabelings, yaml.MapSlice{
-				{Key: "action", Value: "drop"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_labelpresent_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: "true"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(k)}},
+				{Key: "regex", Value: m.Spec.Selector.MatchLabels[k]},
 			})
 		}
+		// Set based label matching. We have to map the valid relations
+		// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
+		for _, exp := range m.Spec.Selector.MatchExpressions {
+			switch exp.Operator {
+			case metav1.LabelSelectorOpIn:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "keep"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: strings.Join(exp.Values, "|")},
+				})
+			case metav1.LabelSelectorOpNotIn:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "drop"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: strings.Join(exp.Values, "|")},
+				})
+			case metav1.LabelSelectorOpExists:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "keep"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_labelpresent_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: "true"},
+				})
+			case metav1.LabelSelectorOpDoesNotExist:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "drop"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_labelpresent_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: "true"},
+				})
+			}
+		}
 	}
 
 	// Filter targets based on correct port for the endpoint.
@@ -546,8 +836,14 @@ func (cg *configGenerator) generatePodMonitorConfig(
 			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_container_port_name"}},
 			{Key: "regex", Value: ep.Port},
 		})
+	} else if ep.PortNumber != nil {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "action", Value: "keep"},
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_container_port_number"}},
+			{Key: "regex", Value: strconv.Itoa(int(*ep.PortNumber))},
+		})
 	} else if ep.TargetPort != nil {
-		level.Warn(cg.logger).Log("msg", "PodMonitor 'targetPort' is deprecated, use 'port' instead.",
+		level.Warn(cg.logger).Log("msg", "PodMonitor 'targetPort' is deprecated, use 'port' or 'portNumber' instead.",
 			"podMonitor", m.Name)
 		if ep.TargetPort.StrVal != "" {
 			relabelings = append(relabelings, yaml.MapSlice{
@@ -564,6 +860,15 @@ func (cg *configGenerator) generatePodMonitorConfig(
 		}
 	}
 
+	// Drop the pods which aren't running anymore.
+	if ep.FilterRunning == nil || *ep.FilterRunning {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "action", Value: "drop"},
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_phase"}},
+			{Key: "regex", Value: "(Failed|Succeeded)"},
+		})
+	}
+
 	// Relabel namespace and pod and service labels into proper labels.
 	relabelings = append(relabelings, []yaml.MapSlice{
 		{
@@ -614,6 +919,11 @@ func (cg *configGenerator) generatePodMonitorConfig(
 			{Key: "target_label", Value: "endpoint"},
 			{Key: "replacement", Value: ep.Port},
 		})
+	} else if ep.PortNumber != nil {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "target_label", Value: "endpoint"},
+			{Key: "replacement", Value: strconv.Itoa(int(*ep.PortNumber))},
+		})
 	} else if ep.TargetPort != nil && ep.TargetPort.String() != "" {
 		relabelings = append(relabelings, yaml.MapSlice{
 			{Key: "target_label", Value: "endpoint"},
@@ -621,6 +931,10 @@ func (cg *configGenerator) generatePodMonitorConfig(
 		})
 	}
 
+	for _, c := range scrapeClass.Relabelings {
+		relabelings = append(relabelings, generateRelabelConfig(c))
+	}
+
 	if ep.RelabelConfigs != nil {
 		for _, c := range ep.RelabelConfigs {
 			relabelings = append(relabelings, generateRelabelConfig(c))
@@ -637,13 +951,16 @@ func (cg *configGenerator) generatePodMonitorConfig(
 		cfg = append(cfg, yaml.MapItem{Key: "sample_limit", Value: getLimit(m.Spec.SampleLimit, enforcedSampleLimit)})
 	}
 
-	if version.Major == 2 && version.Minor >= 21 &&
+	if version.GTE(semver.MustParse("2.21.0")) &&
 		(m.Spec.TargetLimit > 0 || enforcedTargetLimit != nil) {
 		cfg = append(cfg, yaml.MapItem{Key: "target_limit", Value: getLimit(m.Spec.TargetLimit, enforcedTargetLimit)})
 	}
 
-	if ep.MetricRelabelConfigs != nil {
+	if scrapeClass.MetricRelabelings != nil || ep.MetricRelabelConfigs != nil {
 		var metricRelabelings []yaml.MapSlice
+		for _, c := range scrapeClass.MetricRelabelings {
+			metricRelabelings = append(metricRelabelings, generateRelabelConfig(c))
+		}
 		for _, c := range ep.MetricRelabelConfigs {
 			if c.TargetLabel != "" && enforcedNamespaceLabel != "" && c.TargetLabel == enforcedNamespaceLabel {
 				continue
@@ -661,12 +978,17 @@ func (cg *configGenerator) generatePodMonitorConfig(
 func (cg *configGenerator) generateProbeConfig(
 	version semver.Version,
 	m *v1.Probe,
+	scrapeClass *v1.ScrapeClass,
 	apiserverConfig *v1.APIServerConfig,
 	basicAuthSecrets map[string]assets.BasicAuthCredentials,
+	bearerTokens map[string]assets.BearerToken,
 	ignoreHonorLabels bool,
 	overrideHonorTimestamps bool,
 	ignoreNamespaceSelectors bool,
-	enforcedNamespaceLabel string) yaml.MapSlice {
+	enforcedNamespaceLabel string,
+	enforcedSampleLimit *uint64,
+	enforcedTargetLimit *uint64,
+) yaml.MapSlice {
 
 	jobName := fmt.Sprintf("%s/%s", m.Namespace, m.Name)
 	cfg := yaml.MapSlice{
@@ -691,14 +1013,24 @@ func (cg *configGenerator) generateProbeConfig(
 	if m.Spec.ScrapeTimeout != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: m.Spec.ScrapeTimeout})
 	}
+	cfg = cg.addScrapeProtocols(cfg, version, m.Spec.ScrapeProtocols)
 	if m.Spec.ProberSpec.Scheme != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: m.Spec.ProberSpec.Scheme})
 	}
+	cfg = cg.addProxyConfigToYaml(cfg, version, m.Spec.ProberSpec.ProxyConfig, bearerTokens, fmt.Sprintf("probe/%s/%s", m.Namespace, m.Name))
+	cfg = cg.addHTTPClientOptions(cfg, version, m.Spec.FollowRedirects, m.Spec.EnableHttp2)
 
 	cfg = append(cfg, yaml.MapItem{Key: "params", Value: yaml.MapSlice{
 		{Key: "module", Value: []string{m.Spec.Module}},
 	}})
 
+	cfg = addTLStoYaml(cfg, m.Namespace, scrapeClass.TLSConfig)
+	if m.Spec.Authorization != nil {
+		cfg = cg.addSafeAuthorizationToYaml(cfg, version, m.Spec.Authorization, bearerTokens, fmt.Sprintf("probe/auth/%s/%s", m.Namespace, m.Name))
+	} else {
+		cfg = cg.addScrapeClassAuthorization(cfg, version, scrapeClass, bearerTokens)
+	}
+
 	var relabelings []yaml.MapSlice
 	if m.Spec.JobName != "" {
 		relabelings = append(relabelings, []yaml.MapSlice{
@@ -747,6 +1079,10 @@ func (cg *configGenerator) generateProbeConfig(
 			},
 		}...)
 
+		for _, c := range scrapeClass.Relabelings {
+			relabelings = append(relabelings, generateRelabelConfig(c))
+		}
+
 		cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: enforceNamespaceLabel(relabelings, m.Namespace, enforcedNamespaceLabel)})
 	}
 
@@ -835,6 +1171,10 @@ func (cg *configGenerator) generateProbeConfig(
 			},
 		}...)
 
+		for _, c := range scrapeClass.Relabelings {
+			relabelings = append(relabelings, generateRelabelConfig(c))
+		}
+
 		// Add configured relabelings.
 		if m.Spec.Targets.Ingress.RelabelConfigs != nil {
 			for _, r := range m.Spec.Targets.Ingress.RelabelConfigs {
@@ -847,6 +1187,23 @@ func (cg *configGenerator) generateProbeConfig(
 
 	}
 
+	if m.Spec.SampleLimit > 0 || enforcedSampleLimit != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "sample_limit", Value: getLimit(m.Spec.SampleLimit, enforcedSampleLimit)})
+	}
+
+	if version.GTE(semver.MustParse("2.21.0")) &&
+		(m.Spec.TargetLimit > 0 || enforcedTargetLimit != nil) {
+		cfg = append(cfg, yaml.MapItem{Key: "target_limit", Value: getLimit(m.Spec.TargetLimit, enforcedTargetLimit)})
+	}
+
+	if scrapeClass.MetricRelabelings != nil {
+		var metricRelabelings []yaml.MapSlice
+		for _, c := range scrapeClass.MetricRelabelings {
+			metricRelabelings = append(metricRelabelings, generateRelabelConfig(c))
+		}
+		cfg = append(cfg, yaml.MapItem{Key: "metric_relabel_configs", Value: metricRelabelings})
+	}
+
 	return cfg
 }
 
@@ -855,9 +1212,12 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 	m *v1.ServiceMonitor,
 	ep v1.Endpoint,
 	i int,
+	scrapeClass *v1.ScrapeClass,
+	role string,
 	apiserverConfig *v1.APIServerConfig,
 	basicAuthSecrets map[string]assets.BasicAuthCredentials,
 	bearerTokens map[string]assets.BearerToken,
+	oauth2Secrets map[string]assets.OAuth2Credentials,
 	overrideHonorLabels bool,
 	overrideHonorTimestamps bool,
 	ignoreNamespaceSelectors bool,
@@ -882,7 +1242,15 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 	}
 
 	selectedNamespaces := getNamespacesFromNamespaceSelector(&m.Spec.NamespaceSelector, m.Namespace, ignoreNamespaceSelectors)
-	cfg = append(cfg, cg.generateK8SSDConfig(selectedNamespaces, apiserverConfig, basicAuthSecrets, kubernetesSDRoleEndpoint))
+	sdConfig := cg.generateK8SSDConfig(selectedNamespaces, apiserverConfig, basicAuthSecrets, role)
+	sdConfig = cg.addAttachMetadata(sdConfig, version, attachMetadata(m.Spec.AttachMetadata, scrapeClass), role)
+	// The targets are generated from the Endpoints (or EndpointSlice) objects
+	// which inherit the labels of their service.
+	labelSelector := cg.roleLabelSelector(version, m.Spec.SelectorMechanism, m.Spec.Selector)
+	if labelSelector != "" {
+		sdConfig = addSDSelector(sdConfig, role, "label", labelSelector)
+	}
+	cfg = append(cfg, sdConfig)
 
 	if ep.Interval != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
@@ -890,12 +1258,12 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 	if ep.ScrapeTimeout != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
 	}
+	cfg = cg.addScrapeProtocols(cfg, version, m.Spec.ScrapeProtocols)
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
 	}
-	if ep.ProxyURL != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "proxy_url", Value: ep.ProxyURL})
-	}
+	cfg = cg.addProxyConfigToYaml(cfg, version, ep.ProxyConfig, bearerTokens, fmt.Sprintf("serviceMonitor/%s/%s/%d", m.Namespace, m.Name, i))
+	cfg = cg.addHTTPClientOptions(cfg, version, ep.FollowRedirects, ep.EnableHttp2)
 	if ep.Params != nil {
 		cfg = append(cfg, yaml.MapItem{Key: "params", Value: ep.Params})
 	}
@@ -903,7 +1271,11 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: ep.Scheme})
 	}
 
-	cfg = addTLStoYaml(cfg, m.Namespace, ep.TLSConfig)
+	tlsConfig := ep.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = scrapeClass.TLSConfig
+	}
+	cfg = addTLStoYaml(cfg, m.Namespace, tlsConfig)
 
 	if ep.BearerTokenFile != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: ep.BearerTokenFile})
@@ -926,60 +1298,82 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 		}
 	}
 
-	var relabelings []yaml.MapSlice
+	if ep.OAuth2 != nil {
+		if version.LT(semver.MustParse("2.27.0")) {
+			level.Warn(cg.logger).Log("msg", "OAuth2 is only supported in Prometheus versions 2.27.0 and newer, ignoring it", "version", version, "servicemonitor", fmt.Sprintf("%s/%s", m.Namespace, m.Name))
+		} else if s, ok := oauth2Secrets[fmt.Sprintf("serviceMonitor/%s/%s/%d", m.Namespace, m.Name, i)]; ok {
+			cfg = append(cfg, yaml.MapItem{Key: "oauth2", Value: cg.generateOAuth2Config(version, m.Namespace, ep.OAuth2, s)})
+		}
+	}
 
-	// Filter targets by services selected by the monitor.
+	cfg = cg.addSafeAuthorizationToYaml(cfg, version, ep.Authorization, bearerTokens, fmt.Sprintf("serviceMonitor/auth/%s/%s/%d", m.Namespace, m.Name, i))
 
-	// Exact label matches.
-	var labelKeys []string
-	for k := range m.Spec.Selector.MatchLabels {
-		labelKeys = append(labelKeys, k)
+	if ep.BearerTokenFile == "" && ep.BearerTokenSecret.Name == "" && ep.BasicAuth == nil && ep.OAuth2 == nil && ep.Authorization == nil {
+		cfg = cg.addScrapeClassAuthorization(cfg, version, scrapeClass, bearerTokens)
 	}
-	sort.Strings(labelKeys)
 
-	for _, k := range labelKeys {
-		relabelings = append(relabelings, yaml.MapSlice{
-			{Key: "action", Value: "keep"},
-			{Key: "source_labels", Value: []string{"__meta_kubernetes_service_label_" + sanitizeLabelName(k)}},
-			{Key: "regex", Value: m.Spec.Selector.MatchLabels[k]},
-		})
-	}
-	// Set based label matching. We have to map the valid relations
-	// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
-	for _, exp := range m.Spec.Selector.MatchExpressions {
-		switch exp.Operator {
-		case metav1.LabelSelectorOpIn:
-			relabelings = append(relabelings, yaml.MapSlice{
-				{Key: "action", Value: "keep"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_label_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: strings.Join(exp.Values, "|")},
-			})
-		case metav1.LabelSelectorOpNotIn:
-			relabelings = append(relabelings, yaml.MapSlice{
-				{Key: "action", Value: "drop"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_label_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: strings.Join(exp.Values, "|")},
-			})
-		case metav1.LabelSelectorOpExists:
+	var relabelings []yaml.MapSlice
+
+	// Filter targets by services selected by the monitor unless the selector
+	// is enforced by the service discovery.
+	if labelSelector == "" {
+		// Exact label matches.
+		var labelKeys []string
+		for k := range m.Spec.Selector.MatchLabels {
+			labelKeys = append(labelKeys, k)
+		}
+		sort.Strings(labelKeys)
+
+		for _, k := range labelKeys {
 			relabelings = append(relabelings, yaml.MapSlice{
 				{Key: "action", Value: "keep"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_labelpresent_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: "true"},
-			})
-		case metav1.LabelSelectorOpDoesNotExist:
-			relabelings = append(relabelings, yaml.MapSlice{
-				{Key: "action", Value: "drop"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_labelpresent_" + sanitizeLabelName(exp.Key)}},
-				{Key: "regex", Value: "true"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_label_" + sanitizeLabelName(k)}},
+				{Key: "regex", Value: m.Spec.Selector.MatchLabels[k]},
 			})
 		}
+		// Set based label matching. We have to map the valid relations
+		// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
+		for _, exp := range m.Spec.Selector.MatchExpressions {
+			switch exp.Operator {
+			case metav1.LabelSelectorOpIn:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "keep"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_service_label_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: strings.Join(exp.Values, "|")},
+				})
+			case metav1.LabelSelectorOpNotIn:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "drop"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_service_label_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: strings.Join(exp.Values, "|")},
+				})
+			case metav1.LabelSelectorOpExists:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "keep"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_service_labelpresent_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: "true"},
+				})
+			case metav1.LabelSelectorOpDoesNotExist:
+				relabelings = append(relabelings, yaml.MapSlice{
+					{Key: "action", Value: "drop"},
+					{Key: "source_labels", Value: []string{"__meta_kubernetes_service_labelpresent_" + sanitizeLabelName(exp.Key)}},
+					{Key: "regex", Value: "true"},
+				})
+			}
+		}
+	}
+
+	// The meta labels of the endpointslice role have their own prefix.
+	metaPrefix := "__meta_kubernetes_endpoint_"
+	if role == kubernetesSDRoleEndpointSlice {
+		metaPrefix = "__meta_kubernetes_endpointslice_"
 	}
 
 	// Filter targets based on correct port for the endpoint.
 	if ep.Port != "" {
 		relabelings = append(relabelings, yaml.MapSlice{
 			{Key: "action", Value: "keep"},
-			{Key: "source_labels", Value: []string{"__meta_kubernetes_endpoint_port_name"}},
+			{Key: "source_labels", Value: []string{metaPrefix + "port_name"}},
 			{Key: "regex", Value: ep.Port},
 		})
 	} else if ep.TargetPort != nil {
@@ -1001,14 +1395,14 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 	// Relabel namespace and pod and service labels into proper labels.
 	relabelings = append(relabelings, []yaml.MapSlice{
 		{ // Relabel node labels for pre v2.3 meta labels
-			{Key: "source_labels", Value: []string{"__meta_kubernetes_endpoint_address_target_kind", "__meta_kubernetes_endpoint_address_target_name"}},
+			{Key: "source_labels", Value: []string{metaPrefix + "address_target_kind", metaPrefix + "address_target_name"}},
 			{Key: "separator", Value: ";"},
 			{Key: "regex", Value: "Node;(.*)"},
 			{Key: "replacement", Value: "${1}"},
 			{Key: "target_label", Value: "node"},
 		},
 		{ // Relabel pod labels for >=v2.3 meta labels
-			{Key: "source_labels", Value: []string{"__meta_kubernetes_endpoint_address_target_kind", "__meta_kubernetes_endpoint_address_target_name"}},
+			{Key: "source_labels", Value: []string{metaPrefix + "address_target_kind", metaPrefix + "address_target_name"}},
 			{Key: "separator", Value: ";"},
 			{Key: "regex", Value: "Pod;(.*)"},
 			{Key: "replacement", Value: "${1}"},
@@ -1083,6 +1477,10 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 		})
 	}
 
+	for _, c := range scrapeClass.Relabelings {
+		relabelings = append(relabelings, generateRelabelConfig(c))
+	}
+
 	if ep.RelabelConfigs != nil {
 		for _, c := range ep.RelabelConfigs {
 			relabelings = append(relabelings, generateRelabelConfig(c))
@@ -1099,13 +1497,16 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 		cfg = append(cfg, yaml.MapItem{Key: "sample_limit", Value: getLimit(m.Spec.SampleLimit, enforcedSampleLimit)})
 	}
 
-	if version.Major == 2 && version.Minor >= 21 &&
+	if version.GTE(semver.MustParse("2.21.0")) &&
 		(m.Spec.TargetLimit > 0 || enforcedTargetLimit != nil) {
 		cfg = append(cfg, yaml.MapItem{Key: "target_limit", Value: getLimit(m.Spec.TargetLimit, enforcedTargetLimit)})
 	}
 
-	if ep.MetricRelabelConfigs != nil {
+	if scrapeClass.MetricRelabelings != nil || ep.MetricRelabelConfigs != nil {
 		var metricRelabelings []yaml.MapSlice
+		for _, c := range scrapeClass.MetricRelabelings {
+			metricRelabelings = append(metricRelabelings, generateRelabelConfig(c))
+		}
 		for _, c := range ep.MetricRelabelConfigs {
 			if c.TargetLabel != "" && enforcedNamespaceLabel != "" && c.TargetLabel == enforcedNamespaceLabel {
 				continue
@@ -1130,6 +1531,68 @@ func getLimit(user uint64, enforced *uint64) uint64 {
 	return user
 }
 
+// addScrapeProtocols adds the scrape protocols to the given global or scrape
+// config.
+func (cg *configGenerator) addScrapeProtocols(cfg yaml.MapSlice, version semver.Version, scrapeProtocols []v1.ScrapeProtocol) yaml.MapSlice {
+	if len(scrapeProtocols) == 0 {
+		return cfg
+	}
+
+	if version.LT(semver.MustParse("2.49.0")) {
+		level.Warn(cg.logger).Log("msg", "scrapeProtocols is only supported in Prometheus versions 2.49.0 and newer, ignoring it", "version", version)
+		return cfg
+	}
+
+	return append(cfg, yaml.MapItem{Key: "scrape_protocols", Value: scrapeProtocols})
+}
+
+// scrapeLimits holds the limits defined by a scraping object which aren't
+// handled by the scrape config generators.
+type scrapeLimits struct {
+	labelLimit            uint64
+	labelNameLengthLimit  uint64
+	labelValueLengthLimit uint64
+	keepDroppedTargets    uint64
+}
+
+// addScrapeLimits adds the limits of the scraping object, capped by the
+// limits enforced by the Prometheus object, to the given scrape config.
+// Limits which aren't supported by the Prometheus version are ignored.
+func (cg *configGenerator) addScrapeLimits(cfg yaml.MapSlice, version semver.Version, p *v1.Prometheus, limits scrapeLimits) yaml.MapSlice {
+	if p.Spec.EnforcedBodySizeLimit != "" {
+		if version.GTE(semver.MustParse("2.28.0")) {
+			cfg = append(cfg, yaml.MapItem{Key: "body_size_limit", Value: p.Spec.EnforcedBodySizeLimit})
+		} else {
+			level.Warn(cg.logger).Log("msg", "body_size_limit is only supported in Prometheus versions 2.28.0 and newer, ignoring it", "version", version)
+		}
+	}
+
+	for _, l := range []struct {
+		key        string
+		user       uint64
+		enforced   *uint64
+		minVersion string
+	}{
+		{key: "label_limit", user: limits.labelLimit, enforced: p.Spec.EnforcedLabelLimit, minVersion: "2.27.0"},
+		{key: "label_name_length_limit", user: limits.labelNameLengthLimit, enforced: p.Spec.EnforcedLabelNameLengthLimit, minVersion: "2.27.0"},
+		{key: "label_value_length_limit", user: limits.labelValueLengthLimit, enforced: p.Spec.EnforcedLabelValueLengthLimit, minVersion: "2.27.0"},
+		{key: "keep_dropped_targets", user: limits.keepDroppedTargets, enforced: p.Spec.EnforcedKeepDroppedTargets, minVersion: "2.47.0"},
+	} {
+		if l.user == 0 && l.enforced == nil {
+			continue
+		}
+
+		if version.LT(semver.MustParse(l.minVersion)) {
+			level.Warn(cg.logger).Log("msg", fmt.Sprintf("%s is only supported in Prometheus versions %s and newer, ignoring it", l.key, l.minVersion), "version", version)
+			continue
+		}
+
+		cfg = append(cfg, yaml.MapItem{Key: l.key, Value: getLimit(l.user, l.enforced)})
+	}
+
+	return cfg
+}
+
 func generateAddressShardingRelabelingRules(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
 	return append(relabelings, yaml.MapSlice{
 		{Key: "source_labels", Value: []string{"__address__"}},
@@ -1143,6 +1606,16 @@ func generateAddressShardingRelabelingRules(relabelings []yaml.MapSlice, shards
 	})
 }
 
+// enforcedNamespaceLabel returns the namespace label enforced for the given
+// object or an empty string if the object is excluded from the enforcement.
+func enforcedNamespaceLabel(p *v1.Prometheus, resource string, o metav1.Object) string {
+	if namespacelabeler.IsExcludedFromEnforcement(p.Spec.ExcludedFromEnforcement, resource, o.GetNamespace(), o.GetName()) {
+		return ""
+	}
+
+	return p.Spec.EnforcedNamespaceLabel
+}
+
 func enforceNamespaceLabel(relabelings []yaml.MapSlice, namespace, enforcedNamespaceLabel string) []yaml.MapSlice {
 	if enforcedNamespaceLabel == "" {
 		return relabelings
@@ -1256,7 +1729,274 @@ func (cg *configGenerator) generateK8SSDConfig(namespaces []string, apiserverCon
 	}
 }
 
-func (cg *configGenerator) generateAlertmanagerConfig(version semver.Version, am v1.AlertmanagerEndpoints, apiserverConfig *v1.APIServerConfig, basicAuthSecrets map[string]assets.BasicAuthCredentials) yaml.MapSlice {
+// endpointsRole returns the Kubernetes SD role used to discover the targets
+// of the ServiceMonitors.
+func (cg *configGenerator) endpointsRole(version semver.Version, role *v1.ServiceDiscoveryRole) string {
+	if role == nil || *role != v1.EndpointSliceRole {
+		return kubernetesSDRoleEndpoint
+	}
+
+	if version.LT(semver.MustParse("2.21.0")) {
+		level.Warn(cg.logger).Log("msg", "The EndpointSlice service discovery role is only supported in Prometheus versions 2.21.0 and newer, falling back to Endpoints", "version", version)
+		return kubernetesSDRoleEndpoint
+	}
+
+	return kubernetesSDRoleEndpointSlice
+}
+
+// getScrapeClass returns the scrape class of the Prometheus object matching
+// the given name or the default scrape class when the name is nil. It returns
+// an empty scrape class when none matches.
+func getScrapeClass(p *v1.Prometheus, name *string) *v1.ScrapeClass {
+	for i, sc := range p.Spec.ScrapeClasses {
+		if name == nil && sc.Default != nil && *sc.Default {
+			return &p.Spec.ScrapeClasses[i]
+		}
+		if name != nil && sc.Name == *name {
+			return &p.Spec.ScrapeClasses[i]
+		}
+	}
+
+	return &v1.ScrapeClass{}
+}
+
+// scrapeClassAuthKey returns the key of the authorization credentials of the
+// scrape class in the assets store.
+func scrapeClassAuthKey(name string) string {
+	return fmt.Sprintf("scrapeClass/auth/%s", name)
+}
+
+func (cg *configGenerator) addScrapeClassAuthorization(cfg yaml.MapSlice, version semver.Version, scrapeClass *v1.ScrapeClass, bearerTokens map[string]assets.BearerToken) yaml.MapSlice {
+	if scrapeClass.Authorization == nil {
+		return cfg
+	}
+
+	if version.LT(semver.MustParse("2.26.0")) {
+		level.Warn(cg.logger).Log("msg", "Authorization is only supported for scrape configs in Prometheus versions 2.26.0 and newer, ignoring it", "version", version, "scrapeClass", scrapeClass.Name)
+		return cfg
+	}
+
+	authorization := yaml.MapSlice{}
+	if scrapeClass.Authorization.Type != "" {
+		authorization = append(authorization, yaml.MapItem{Key: "type", Value: strings.TrimSpace(scrapeClass.Authorization.Type)})
+	}
+
+	if scrapeClass.Authorization.CredentialsFile != "" {
+		authorization = append(authorization, yaml.MapItem{Key: "credentials_file", Value: scrapeClass.Authorization.CredentialsFile})
+	} else if s, ok := bearerTokens[scrapeClassAuthKey(scrapeClass.Name)]; ok {
+		authorization = append(authorization, yaml.MapItem{Key: "credentials", Value: string(s)})
+	} else {
+		return cfg
+	}
+
+	return append(cfg, yaml.MapItem{Key: "authorization", Value: authorization})
+}
+
+// addSafeAuthorizationToYaml adds the authorization section of a monitor to
+// the scrape configuration. The credentials are read from the bearer tokens
+// under the given key.
+func (cg *configGenerator) addSafeAuthorizationToYaml(cfg yaml.MapSlice, version semver.Version, auth *v1.SafeAuthorization, bearerTokens map[string]assets.BearerToken, key string) yaml.MapSlice {
+	if auth == nil {
+		return cfg
+	}
+
+	if version.LT(semver.MustParse("2.26.0")) {
+		level.Warn(cg.logger).Log("msg", "Authorization is only supported for scrape configs in Prometheus versions 2.26.0 and newer, ignoring it", "version", version, "key", key)
+		return cfg
+	}
+
+	s, ok := bearerTokens[key]
+	if !ok {
+		return cfg
+	}
+
+	authorization := yaml.MapSlice{}
+	if auth.Type != "" {
+		authorization = append(authorization, yaml.MapItem{Key: "type", Value: strings.TrimSpace(auth.Type)})
+	}
+	authorization = append(authorization, yaml.MapItem{Key: "credentials", Value: string(s)})
+
+	return append(cfg, yaml.MapItem{Key: "authorization", Value: authorization})
+}
+
+// addProxyConfigToYaml adds the proxy settings to the scrape configuration.
+// The values of the proxy CONNECT headers are read from the bearer tokens
+// (see assets.ProxyConnectHeaderKey).
+func (cg *configGenerator) addProxyConfigToYaml(cfg yaml.MapSlice, version semver.Version, pc v1.ProxyConfig, bearerTokens map[string]assets.BearerToken, key string) yaml.MapSlice {
+	if pc.ProxyURL != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "proxy_url", Value: pc.ProxyURL})
+	}
+
+	if pc.NoProxy == nil && pc.ProxyFromEnvironment == nil && len(pc.ProxyConnectHeader) == 0 {
+		return cfg
+	}
+
+	if version.LT(semver.MustParse("2.43.0")) {
+		level.Warn(cg.logger).Log("msg", "noProxy, proxyFromEnvironment and proxyConnectHeader are only supported in Prometheus versions 2.43.0 and newer, ignoring them", "version", version, "key", key)
+		return cfg
+	}
+
+	if pc.NoProxy != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "no_proxy", Value: *pc.NoProxy})
+	}
+
+	if pc.ProxyFromEnvironment != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "proxy_from_environment", Value: *pc.ProxyFromEnvironment})
+	}
+
+	if len(pc.ProxyConnectHeader) > 0 {
+		headers := make([]string, 0, len(pc.ProxyConnectHeader))
+		for header := range pc.ProxyConnectHeader {
+			headers = append(headers, header)
+		}
+		sort.Strings(headers)
+
+		proxyConnectHeader := yaml.MapSlice{}
+		for _, header := range headers {
+			if s, ok := bearerTokens[assets.ProxyConnectHeaderKey(key, header)]; ok {
+				proxyConnectHeader = append(proxyConnectHeader, yaml.MapItem{Key: header, Value: []string{string(s)}})
+			}
+		}
+
+		if len(proxyConnectHeader) > 0 {
+			cfg = append(cfg, yaml.MapItem{Key: "proxy_connect_header", Value: proxyConnectHeader})
+		}
+	}
+
+	return cfg
+}
+
+// addHTTPClientOptions adds the follow_redirects and enable_http2 settings
+// to the scrape configuration when they're supported by the Prometheus
+// version.
+func (cg *configGenerator) addHTTPClientOptions(cfg yaml.MapSlice, version semver.Version, followRedirects, enableHTTP2 *bool) yaml.MapSlice {
+	if followRedirects != nil {
+		if version.LT(semver.MustParse("2.26.0")) {
+			level.Warn(cg.logger).Log("msg", "followRedirects is only supported in Prometheus versions 2.26.0 and newer, ignoring it", "version", version)
+		} else {
+			cfg = append(cfg, yaml.MapItem{Key: "follow_redirects", Value: *followRedirects})
+		}
+	}
+
+	if enableHTTP2 != nil {
+		if version.LT(semver.MustParse("2.35.0")) {
+			level.Warn(cg.logger).Log("msg", "enableHttp2 is only supported in Prometheus versions 2.35.0 and newer, ignoring it", "version", version)
+		} else {
+			cfg = append(cfg, yaml.MapItem{Key: "enable_http2", Value: *enableHTTP2})
+		}
+	}
+
+	return cfg
+}
+
+// attachMetadata returns the metadata configuration of the monitor, falling
+// back to the one of the scrape class.
+func attachMetadata(monitor *v1.AttachMetadata, scrapeClass *v1.ScrapeClass) *v1.AttachMetadata {
+	if monitor != nil {
+		return monitor
+	}
+	return scrapeClass.AttachMetadata
+}
+
+// addAttachMetadata configures the Kubernetes SD config to attach the node
+// metadata to the discovered targets.
+func (cg *configGenerator) addAttachMetadata(sdConfig yaml.MapItem, version semver.Version, attachMetadata *v1.AttachMetadata, role string) yaml.MapItem {
+	if attachMetadata == nil || !attachMetadata.Node {
+		return sdConfig
+	}
+
+	minVersion := "2.35.0"
+	if role != kubernetesSDRolePod {
+		minVersion = "2.37.0"
+	}
+
+	if version.LT(semver.MustParse(minVersion)) {
+		level.Warn(cg.logger).Log("msg", fmt.Sprintf("Attaching the node metadata for the %s role is only supported in Prometheus versions %s and newer, ignoring it", role, minVersion), "version", version)
+		return sdConfig
+	}
+
+	configs := sdConfig.Value.([]yaml.MapSlice)
+	for i := range configs {
+		configs[i] = append(configs[i], yaml.MapItem{
+			Key: "attach_metadata",
+			Value: yaml.MapSlice{
+				{Key: "node", Value: true},
+			},
+		})
+	}
+
+	return sdConfig
+}
+
+// restrictPodDiscoveryToNode limits the discovery of the Kubernetes SD config
+// to the pods running on the same node as Prometheus. The $(NODE_NAME)
+// variable is expanded by the config reloader.
+func restrictPodDiscoveryToNode(sdConfig yaml.MapItem) yaml.MapItem {
+	return addSDSelector(sdConfig, kubernetesSDRolePod, "field", "spec.nodeName=$(NODE_NAME)")
+}
+
+// roleLabelSelector returns the label selector which should be enforced by
+// the Kubernetes service discovery. It returns an empty string when the
+// selector is enforced by relabeling rules instead.
+func (cg *configGenerator) roleLabelSelector(version semver.Version, mechanism *v1.SelectorMechanism, selector metav1.LabelSelector) string {
+	if mechanism == nil || *mechanism != v1.SelectorMechanismRole {
+		return ""
+	}
+
+	if version.LT(semver.MustParse("2.17.0")) {
+		level.Warn(cg.logger).Log("msg", "The RoleSelector mechanism is only supported in Prometheus versions 2.17.0 and newer, falling back to relabeling", "version", version)
+		return ""
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(&selector)
+	if err != nil {
+		level.Warn(cg.logger).Log("msg", "Invalid label selector, falling back to relabeling", "err", err)
+		return ""
+	}
+
+	return s.String()
+}
+
+// addSDSelector adds a selector for the given role to the Kubernetes SD
+// config. Prometheus accepts only one selector per role so the key is merged
+// into the existing selector of the role if any.
+func addSDSelector(sdConfig yaml.MapItem, role, key, value string) yaml.MapItem {
+	configs := sdConfig.Value.([]yaml.MapSlice)
+	for i := range configs {
+		idx := -1
+		for j, item := range configs[i] {
+			if item.Key == "selectors" {
+				idx = j
+				break
+			}
+		}
+
+		if idx == -1 {
+			configs[i] = append(configs[i], yaml.MapItem{Key: "selectors", Value: []yaml.MapSlice{}})
+			idx = len(configs[i]) - 1
+		}
+
+		selectors := configs[i][idx].Value.([]yaml.MapSlice)
+		found := false
+		for j := range selectors {
+			if selectors[j][0].Value == role {
+				selectors[j] = append(selectors[j], yaml.MapItem{Key: key, Value: value})
+				found = true
+			}
+		}
+		if !found {
+			selectors = append(selectors, yaml.MapSlice{
+				{Key: "role", Value: role},
+				{Key: key, Value: value},
+			})
+		}
+		configs[i][idx].Value = selectors
+	}
+
+	return sdConfig
+}
+
+func (cg *configGenerator) generateAlertmanagerConfig(version semver.Version, p *v1.Prometheus, i int, am v1.AlertmanagerEndpoints, apiserverConfig *v1.APIServerConfig, basicAuthSecrets map[string]assets.BasicAuthCredentials, bearerTokens map[string]assets.BearerToken, sigv4Secrets map[string]assets.SigV4Credentials) yaml.MapSlice {
 	if am.Scheme == "" {
 		am.Scheme = "http"
 	}
@@ -1274,16 +2014,61 @@ func (cg *configGenerator) generateAlertmanagerConfig(version semver.Version, am
 		cfg = append(cfg, yaml.MapItem{Key: "timeout", Value: am.Timeout})
 	}
 
-	// TODO: If we want to support secret refs for alertmanager config tls
-	// config as well, make sure to path the right namespace here.
-	cfg = addTLStoYaml(cfg, "", am.TLSConfig)
+	cfg = addTLStoYaml(cfg, p.ObjectMeta.Namespace, am.TLSConfig)
 
 	cfg = append(cfg, cg.generateK8SSDConfig([]string{am.Namespace}, apiserverConfig, basicAuthSecrets, kubernetesSDRoleEndpoint))
 
+	authKey := fmt.Sprintf("alertmanager/auth/%d", i)
+
+	if am.BasicAuth != nil {
+		if s, ok := basicAuthSecrets[authKey]; ok {
+			cfg = append(cfg, yaml.MapItem{
+				Key: "basic_auth", Value: yaml.MapSlice{
+					{Key: "username", Value: s.Username},
+					{Key: "password", Value: s.Password},
+				},
+			})
+		}
+	}
+
 	if am.BearerTokenFile != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: am.BearerTokenFile})
 	}
 
+	if am.Authorization != nil {
+		if version.LT(semver.MustParse("2.26.0")) {
+			level.Warn(cg.logger).Log("msg", "Authorization is only supported for alertmanager endpoints in Prometheus versions 2.26.0 and newer, ignoring it", "version", version, "alertmanager", am.Namespace+"/"+am.Name)
+		} else if s, ok := bearerTokens[authKey]; ok {
+			authorization := yaml.MapSlice{}
+			if am.Authorization.Type != "" {
+				authorization = append(authorization, yaml.MapItem{Key: "type", Value: strings.TrimSpace(am.Authorization.Type)})
+			}
+			authorization = append(authorization, yaml.MapItem{Key: "credentials", Value: string(s)})
+
+			cfg = append(cfg, yaml.MapItem{Key: "authorization", Value: authorization})
+		}
+	}
+
+	if am.Sigv4 != nil {
+		if version.LT(semver.MustParse("2.48.0")) {
+			level.Warn(cg.logger).Log("msg", "Sigv4 is only supported for alertmanager endpoints in Prometheus versions 2.48.0 and newer, ignoring it", "version", version, "alertmanager", am.Namespace+"/"+am.Name)
+		} else {
+			cfg = append(cfg, yaml.MapItem{Key: "sigv4", Value: generateSigv4Config(am.Sigv4, sigv4Secrets[authKey])})
+		}
+	}
+
+	if am.ProxyURL != nil && *am.ProxyURL != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "proxy_url", Value: *am.ProxyURL})
+	}
+
+	if am.EnableHttp2 != nil {
+		if version.LT(semver.MustParse("2.35.0")) {
+			level.Warn(cg.logger).Log("msg", "enableHttp2 is only supported for alertmanager endpoints in Prometheus versions 2.35.0 and newer, ignoring it", "version", version, "alertmanager", am.Namespace+"/"+am.Name)
+		} else {
+			cfg = append(cfg, yaml.MapItem{Key: "enable_http2", Value: *am.EnableHttp2})
+		}
+	}
+
 	if version.Major > 2 || (version.Major == 2 && version.Minor >= 11) {
 		if am.APIVersion == "v1" || am.APIVersion == "v2" {
 			cfg = append(cfg, yaml.MapItem{Key: "api_version", Value: am.APIVersion})
@@ -1312,12 +2097,28 @@ func (cg *configGenerator) generateAlertmanagerConfig(version semver.Version, am
 		})
 	}
 
+	for i := range am.Relabelings {
+		relabelings = append(relabelings, generateRelabelConfig(&am.Relabelings[i]))
+	}
+
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
+	if len(am.AlertRelabelConfigs) > 0 {
+		if version.GTE(semver.MustParse("2.51.0")) {
+			var alertRelabelings []yaml.MapSlice
+			for i := range am.AlertRelabelConfigs {
+				alertRelabelings = append(alertRelabelings, generateRelabelConfig(&am.AlertRelabelConfigs[i]))
+			}
+			cfg = append(cfg, yaml.MapItem{Key: "alert_relabel_configs", Value: alertRelabelings})
+		} else {
+			level.Warn(cg.logger).Log("msg", "alertRelabelings is only supported in Prometheus versions 2.51.0 and newer, ignoring it", "version", version, "alertmanager", am.Namespace+"/"+am.Name)
+		}
+	}
+
 	return cfg
 }
 
-func (cg *configGenerator) generateRemoteReadConfig(version semver.Version, p *v1.Prometheus, basicAuthSecrets map[string]assets.BasicAuthCredentials) yaml.MapItem {
+func (cg *configGenerator) generateRemoteReadConfig(version semver.Version, p *v1.Prometheus, basicAuthSecrets map[string]assets.BasicAuthCredentials, bearerTokens map[string]assets.BearerToken, oauth2Secrets map[string]assets.OAuth2Credentials) yaml.MapItem {
 
 	cfgs := []yaml.MapSlice{}
 
@@ -1363,12 +2164,42 @@ func (cg *configGenerator) generateRemoteReadConfig(version semver.Version, p *v
 			cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: spec.BearerTokenFile})
 		}
 
+		if spec.Authorization != nil && version.GTE(semver.MustParse("2.26.0")) {
+			if s, ok := bearerTokens[fmt.Sprintf("remoteRead/auth/%d", i)]; ok {
+				authorization := yaml.MapSlice{}
+				if spec.Authorization.Type != "" {
+					authorization = append(authorization, yaml.MapItem{Key: "type", Value: strings.TrimSpace(spec.Authorization.Type)})
+				}
+				authorization = append(authorization, yaml.MapItem{Key: "credentials", Value: string(s)})
+
+				cfg = append(cfg, yaml.MapItem{Key: "authorization", Value: authorization})
+			}
+		}
+
+		if spec.OAuth2 != nil && version.GTE(semver.MustParse("2.27.0")) {
+			if s, ok := oauth2Secrets[fmt.Sprintf("remoteRead/%d", i)]; ok {
+				cfg = append(cfg, yaml.MapItem{Key: "oauth2", Value: cg.generateOAuth2Config(version, p.Namespace, spec.OAuth2, s)})
+			}
+		}
+
 		cfg = addTLStoYaml(cfg, p.ObjectMeta.Namespace, spec.TLSConfig)
 
 		if spec.ProxyURL != "" {
 			cfg = append(cfg, yaml.MapItem{Key: "proxy_url", Value: spec.ProxyURL})
 		}
 
+		if spec.FollowRedirects != nil && version.GTE(semver.MustParse("2.26.0")) {
+			cfg = append(cfg, yaml.MapItem{Key: "follow_redirects", Value: *spec.FollowRedirects})
+		}
+
+		if spec.FilterExternalLabels != nil && version.GTE(semver.MustParse("2.34.0")) {
+			cfg = append(cfg, yaml.MapItem{Key: "filter_external_labels", Value: *spec.FilterExternalLabels})
+		}
+
+		if len(spec.Headers) > 0 && version.GTE(semver.MustParse("2.26.0")) {
+			cfg = append(cfg, yaml.MapItem{Key: "headers", Value: stringMapToMapSlice(spec.Headers)})
+		}
+
 		cfgs = append(cfgs, cfg)
 
 	}
@@ -1379,7 +2210,7 @@ func (cg *configGenerator) generateRemoteReadConfig(version semver.Version, p *v
 	}
 }
 
-func (cg *configGenerator) generateRemoteWriteConfig(version semver.Version, p *v1.Prometheus, basicAuthSecrets map[string]assets.BasicAuthCredentials) yaml.MapItem {
+func (cg *configGenerator) generateRemoteWriteConfig(version semver.Version, p *v1.Prometheus, basicAuthSecrets map[string]assets.BasicAuthCredentials, oauth2Secrets map[string]assets.OAuth2Credentials, sigv4Secrets map[string]assets.SigV4Credentials, azureOAuthSecrets map[string]assets.AzureOAuthCredentials) yaml.MapItem {
 
 	cfgs := []yaml.MapSlice{}
 
@@ -1456,6 +2287,33 @@ func (cg *configGenerator) generateRemoteWriteConfig(version semver.Version, p *
 			cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: spec.BearerTokenFile})
 		}
 
+		if spec.OAuth2 != nil {
+			if !version.GTE(semver.MustParse("2.27.0")) {
+				level.Warn(cg.logger).Log("msg", "OAuth2 is only supported for remote write in Prometheus versions 2.27.0 and newer, ignoring it", "version", version)
+			} else if s, ok := oauth2Secrets[fmt.Sprintf("remoteWrite/%d", i)]; ok {
+				cfg = append(cfg, yaml.MapItem{Key: "oauth2", Value: cg.generateOAuth2Config(version, p.Namespace, spec.OAuth2, s)})
+			}
+		}
+
+		if spec.Sigv4 != nil {
+			if !version.GTE(semver.MustParse("2.26.0")) {
+				level.Warn(cg.logger).Log("msg", "Sigv4 is only supported for remote write in Prometheus versions 2.26.0 and newer, ignoring it", "version", version)
+			} else {
+				cfg = append(cfg, yaml.MapItem{Key: "sigv4", Value: generateSigv4Config(spec.Sigv4, sigv4Secrets[fmt.Sprintf("remoteWrite/%d", i)])})
+			}
+		}
+
+		if spec.AzureAD != nil {
+			switch {
+			case !version.GTE(semver.MustParse("2.45.0")):
+				level.Warn(cg.logger).Log("msg", "AzureAD is only supported for remote write in Prometheus versions 2.45.0 and newer, ignoring it", "version", version)
+			case spec.AzureAD.OAuth != nil && !version.GTE(semver.MustParse("2.48.0")):
+				level.Warn(cg.logger).Log("msg", "AzureAD OAuth is only supported for remote write in Prometheus versions 2.48.0 and newer, ignoring it", "version", version)
+			default:
+				cfg = append(cfg, yaml.MapItem{Key: "azuread", Value: generateAzureADConfig(spec.AzureAD, azureOAuthSecrets[fmt.Sprintf("remoteWrite/%d", i)])})
+			}
+		}
+
 		cfg = addTLStoYaml(cfg, p.ObjectMeta.Namespace, spec.TLSConfig)
 
 		if spec.ProxyURL != "" {
@@ -1499,9 +2357,41 @@ func (cg *configGenerator) generateRemoteWriteConfig(version semver.Version, p *
 				queueConfig = append(queueConfig, yaml.MapItem{Key: "max_backoff", Value: spec.QueueConfig.MaxBackoff})
 			}
 
+			if spec.QueueConfig.RetryOnRateLimit && version.GTE(semver.MustParse("2.26.0")) {
+				queueConfig = append(queueConfig, yaml.MapItem{Key: "retry_on_http_429", Value: spec.QueueConfig.RetryOnRateLimit})
+			}
+
+			if spec.QueueConfig.SampleAgeLimit != "" && version.GTE(semver.MustParse("2.50.0")) {
+				queueConfig = append(queueConfig, yaml.MapItem{Key: "sample_age_limit", Value: spec.QueueConfig.SampleAgeLimit})
+			}
+
 			cfg = append(cfg, yaml.MapItem{Key: "queue_config", Value: queueConfig})
 		}
 
+		if spec.MetadataConfig != nil && version.GTE(semver.MustParse("2.23.0")) {
+			metadataConfig := yaml.MapSlice{
+				{Key: "send", Value: spec.MetadataConfig.Send},
+			}
+
+			if spec.MetadataConfig.SendInterval != "" {
+				metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send_interval", Value: spec.MetadataConfig.SendInterval})
+			}
+
+			if spec.MetadataConfig.MaxSamplesPerSend != nil && version.GTE(semver.MustParse("2.29.0")) {
+				metadataConfig = append(metadataConfig, yaml.MapItem{Key: "max_samples_per_send", Value: *spec.MetadataConfig.MaxSamplesPerSend})
+			}
+
+			cfg = append(cfg, yaml.MapItem{Key: "metadata_config", Value: metadataConfig})
+		}
+
+		if spec.SendExemplars != nil && version.GTE(semver.MustParse("2.27.0")) {
+			cfg = append(cfg, yaml.MapItem{Key: "send_exemplars", Value: *spec.SendExemplars})
+		}
+
+		if spec.SendNativeHistograms != nil && version.GTE(semver.MustParse("2.40.0")) {
+			cfg = append(cfg, yaml.MapItem{Key: "send_native_histograms", Value: *spec.SendNativeHistograms})
+		}
+
 		cfgs = append(cfgs, cfg)
 	}
 
@@ -17,8 +17,10 @@ package prometheus
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/go-kit/kit/log"
 	"github.com/go-openapi/swag"
 	"github.com/google/go-cmp/cmp"
 	"github.com/kylelemons/godebug/pretty"
@@ -177,7 +179,26 @@ alerting:
   external_labels:
     prometheus: /
     prometheus_replica: $(POD_NAME)
-  query_log_file: test.log
+  query_log_file: /var/log/prometheus/test.log
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+`,
+		},
+		{
+			Version:      "v2.16.0",
+			QueryLogFile: "/dev/stdout",
+			Expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: /
+    prometheus_replica: $(POD_NAME)
+  query_log_file: /dev/stdout
 rule_files: []
 scrape_configs: []
 alerting:
@@ -212,6 +233,10 @@ alerting:
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			false,
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -432,6 +457,10 @@ func TestProbeStaticTargetsConfigGeneration(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 
 	if err != nil {
@@ -538,6 +567,10 @@ func TestProbeStaticTargetsConfigGenerationWithLabelEnforce(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 
 	if err != nil {
@@ -642,6 +675,10 @@ func TestProbeStaticTargetsConfigGenerationWithJobName(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 
 	if err != nil {
@@ -755,6 +792,10 @@ func TestProbeIngressSDConfigGeneration(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 
 	if err != nil {
@@ -882,6 +923,10 @@ func TestProbeIngressSDConfigGenerationWithLabelEnforce(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 
 	if err != nil {
@@ -1059,6 +1104,10 @@ func TestAlertmanagerBearerToken(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1138,6 +1187,10 @@ func TestAlertmanagerAPIVersion(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1218,6 +1271,10 @@ func TestAlertmanagerTimeoutConfig(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1293,6 +1350,9 @@ func TestAdditionalAlertRelabelConfigs(t *testing.T) {
 		map[string]assets.BasicAuthCredentials{},
 		map[string]assets.BearerToken{},
 		nil,
+		nil,
+		nil,
+		nil,
 		[]byte(`- action: drop
   source_labels: [__meta_kubernetes_node_name]
   regex: spot-(.+)
@@ -1300,6 +1360,7 @@ func TestAdditionalAlertRelabelConfigs(t *testing.T) {
 `),
 		nil,
 		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1409,6 +1470,10 @@ func TestNoEnforcedNamespaceLabelServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1563,6 +1628,10 @@ func TestEnforcedNamespaceLabelPodMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1589,6 +1658,10 @@ scrape_configs:
     source_labels:
     - __meta_kubernetes_pod_container_port_name
     regex: web
+  - action: drop
+    source_labels:
+    - __meta_kubernetes_pod_phase
+    regex: (Failed|Succeeded)
   - source_labels:
     - __meta_kubernetes_namespace
     target_label: namespace
@@ -1705,6 +1778,10 @@ func TestEnforcedNamespaceLabelServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1825,11 +1902,15 @@ func TestAdditionalAlertmanagers(t *testing.T) {
 		map[string]assets.BearerToken{},
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
 		[]byte(`- static_configs:
   - targets:
     - localhost
 `),
 		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1923,6 +2004,10 @@ func TestSettingHonorTimestampsInServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2062,6 +2147,10 @@ func TestSettingHonorTimestampsInPodMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2089,6 +2178,10 @@ scrape_configs:
     source_labels:
     - __meta_kubernetes_pod_container_port_name
     regex: web
+  - action: drop
+    source_labels:
+    - __meta_kubernetes_pod_phase
+    regex: (Failed|Succeeded)
   - source_labels:
     - __meta_kubernetes_namespace
     target_label: namespace
@@ -2183,6 +2276,10 @@ func TestHonorTimestampsOverriding(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2321,6 +2418,10 @@ func TestSettingHonorLabels(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2459,6 +2560,10 @@ func TestHonorLabelsOverriding(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2596,6 +2701,10 @@ func TestTargetLabels(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2732,6 +2841,10 @@ func TestPodTargetLabels(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2868,6 +2981,10 @@ func TestPodTargetLabelsFromPodMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -2894,6 +3011,10 @@ scrape_configs:
     source_labels:
     - __meta_kubernetes_pod_container_port_name
     regex: web
+  - action: drop
+    source_labels:
+    - __meta_kubernetes_pod_phase
+    regex: (Failed|Succeeded)
   - source_labels:
     - __meta_kubernetes_namespace
     target_label: namespace
@@ -2977,6 +3098,10 @@ func TestEmptyEndointPorts(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -3120,6 +3245,10 @@ func generateTestConfig(version string) ([]byte, error) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		false,
 	)
 }
 
@@ -3742,6 +3871,10 @@ alerting:
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				false,
 			)
 			if err != nil {
 				t.Fatal(err)
@@ -3950,6 +4083,12 @@ alerting:
 			limit:         500,
 			expected:      fmt.Sprintf(expectLimit, 500),
 		},
+		{
+			version:       "v3.0.0",
+			enforcedLimit: 1000,
+			limit:         500,
+			expected:      fmt.Sprintf(expectLimit, 500),
+		},
 	} {
 		t.Run(fmt.Sprintf("%s enforcedlimit(%d) limit(%d)", tc.version, tc.enforcedLimit, tc.limit), func(t *testing.T) {
 			cg := &configGenerator{}
@@ -4007,6 +4146,10 @@ alerting:
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				false,
 			)
 			if err != nil {
 				t.Fatal(err)
@@ -4020,3 +4163,2885 @@ alerting:
 		})
 	}
 }
+
+func TestRemoteWriteOAuth2(t *testing.T) {
+	for _, tc := range []struct {
+		version  string
+		expected string
+	}{
+		{
+			version: "v2.27.0",
+			expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+  oauth2:
+    client_id: client-id
+    client_secret: client-secret
+    token_url: https://example.com/token
+    scopes:
+    - write
+    endpoint_params:
+      audience: remote-write
+`,
+		},
+		{
+			version: "v2.26.0",
+			expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+`,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version: tc.version,
+						RemoteWrite: []monitoringv1.RemoteWriteSpec{{
+							URL: "https://example.com/remote_write",
+							OAuth2: &monitoringv1.OAuth2WithTLSConfig{
+								OAuth2: monitoringv1.OAuth2{
+									ClientID: monitoringv1.SecretOrConfigMap{
+										Secret: &v1.SecretKeySelector{
+											LocalObjectReference: v1.LocalObjectReference{Name: "oauth2"},
+											Key:                  "client-id",
+										},
+									},
+									ClientSecret: v1.SecretKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{Name: "oauth2"},
+										Key:                  "client-secret",
+									},
+									TokenURL:       "https://example.com/token",
+									Scopes:         []string{"write"},
+									EndpointParams: map[string]string{"audience": "remote-write"},
+								},
+							},
+						}},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				map[string]assets.OAuth2Credentials{
+					"remoteWrite/0": {
+						ClientID:     "client-id",
+						ClientSecret: "client-secret",
+					},
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected != result {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestRemoteWriteSigv4(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		version  string
+		sigv4    *monitoringv1.Sigv4
+		secrets  map[string]assets.SigV4Credentials
+		expected string
+	}{
+		{
+			name:    "with credentials",
+			version: "v2.26.0",
+			sigv4: &monitoringv1.Sigv4{
+				Region: "us-central-0",
+				AccessKey: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "sigv4"},
+					Key:                  "access-key",
+				},
+				SecretKey: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "sigv4"},
+					Key:                  "secret-key",
+				},
+				Profile: "profilename",
+				RoleArn: "arn:aws:iam::123456789012:role/prometheus",
+			},
+			secrets: map[string]assets.SigV4Credentials{
+				"remoteWrite/0": {
+					AccessKeyID: "access-key",
+					SecretKeyID: "secret-key",
+				},
+			},
+			expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+  sigv4:
+    region: us-central-0
+    access_key: access-key
+    secret_key: secret-key
+    profile: profilename
+    role_arn: arn:aws:iam::123456789012:role/prometheus
+`,
+		},
+		{
+			name:    "default credentials chain",
+			version: "v2.26.0",
+			sigv4:   &monitoringv1.Sigv4{},
+			expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+  sigv4: {}
+`,
+		},
+		{
+			name:    "unsupported version",
+			version: "v2.25.0",
+			sigv4: &monitoringv1.Sigv4{
+				Region: "us-central-0",
+			},
+			expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version: tc.version,
+						RemoteWrite: []monitoringv1.RemoteWriteSpec{{
+							URL:   "https://example.com/remote_write",
+							Sigv4: tc.sigv4,
+						}},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				tc.secrets,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected != result {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestRemoteWriteAzureAD(t *testing.T) {
+	header := `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+`
+
+	for _, tc := range []struct {
+		name     string
+		version  string
+		azureAD  *monitoringv1.AzureAD
+		expected string
+	}{
+		{
+			name:    "managed identity",
+			version: "v2.45.0",
+			azureAD: &monitoringv1.AzureAD{
+				Cloud:           pointer.StringPtr("AzurePublic"),
+				ManagedIdentity: &monitoringv1.ManagedIdentity{ClientID: "client-id"},
+			},
+			expected: header + `  azuread:
+    cloud: AzurePublic
+    managed_identity:
+      client_id: client-id
+`,
+		},
+		{
+			name:    "oauth",
+			version: "v2.48.0",
+			azureAD: &monitoringv1.AzureAD{
+				OAuth: &monitoringv1.AzureOAuth{
+					ClientID: "client-id",
+					ClientSecret: v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "azure"},
+						Key:                  "client-secret",
+					},
+					TenantID: "tenant-id",
+				},
+			},
+			expected: header + `  azuread:
+    oauth:
+      client_id: client-id
+      client_secret: client-secret
+      tenant_id: tenant-id
+`,
+		},
+		{
+			name:    "oauth with unsupported version",
+			version: "v2.47.0",
+			azureAD: &monitoringv1.AzureAD{
+				OAuth: &monitoringv1.AzureOAuth{
+					ClientID: "client-id",
+					ClientSecret: v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "azure"},
+						Key:                  "client-secret",
+					},
+					TenantID: "tenant-id",
+				},
+			},
+			expected: header,
+		},
+		{
+			name:    "unsupported version",
+			version: "v2.44.0",
+			azureAD: &monitoringv1.AzureAD{
+				ManagedIdentity: &monitoringv1.ManagedIdentity{ClientID: "client-id"},
+			},
+			expected: header,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version: tc.version,
+						RemoteWrite: []monitoringv1.RemoteWriteSpec{{
+							URL:     "https://example.com/remote_write",
+							AzureAD: tc.azureAD,
+						}},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				map[string]assets.AzureOAuthCredentials{
+					"remoteWrite/0": {
+						ClientSecret: "client-secret",
+					},
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected != result {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestRemoteWriteMetadataAndExemplars(t *testing.T) {
+	header := `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+`
+
+	for _, tc := range []struct {
+		version  string
+		expected string
+	}{
+		{
+			version: "v2.40.0",
+			expected: header + `  metadata_config:
+    send: true
+    send_interval: 1m
+    max_samples_per_send: 500
+  send_exemplars: true
+  send_native_histograms: true
+`,
+		},
+		{
+			version: "v2.29.0",
+			expected: header + `  metadata_config:
+    send: true
+    send_interval: 1m
+    max_samples_per_send: 500
+  send_exemplars: true
+`,
+		},
+		{
+			version: "v2.27.0",
+			expected: header + `  metadata_config:
+    send: true
+    send_interval: 1m
+  send_exemplars: true
+`,
+		},
+		{
+			version: "v2.23.0",
+			expected: header + `  metadata_config:
+    send: true
+    send_interval: 1m
+`,
+		},
+		{
+			version:  "v2.22.0",
+			expected: header,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version: tc.version,
+						RemoteWrite: []monitoringv1.RemoteWriteSpec{{
+							URL: "https://example.com/remote_write",
+							MetadataConfig: &monitoringv1.MetadataConfig{
+								Send:              true,
+								SendInterval:      "1m",
+								MaxSamplesPerSend: pointer.Int32Ptr(500),
+							},
+							SendExemplars:        pointer.BoolPtr(true),
+							SendNativeHistograms: pointer.BoolPtr(true),
+						}},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected != result {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestRemoteWriteQueueConfig(t *testing.T) {
+	header := `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_write:
+- url: https://example.com/remote_write
+  remote_timeout: 30s
+  queue_config:
+    capacity: 2500
+    min_shards: 1
+    max_shards: 200
+    max_samples_per_send: 500
+`
+
+	for _, tc := range []struct {
+		version  string
+		expected string
+	}{
+		{
+			version: "v2.50.0",
+			expected: header + `    retry_on_http_429: true
+    sample_age_limit: 1h
+`,
+		},
+		{
+			version:  "v2.26.0",
+			expected: header + "    retry_on_http_429: true\n",
+		},
+		{
+			version:  "v2.25.0",
+			expected: header,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version: tc.version,
+						RemoteWrite: []monitoringv1.RemoteWriteSpec{{
+							URL: "https://example.com/remote_write",
+							QueueConfig: &monitoringv1.QueueConfig{
+								Capacity:          2500,
+								MinShards:         1,
+								MaxShards:         200,
+								MaxSamplesPerSend: 500,
+								RetryOnRateLimit:  true,
+								SampleAgeLimit:    "1h",
+							},
+						}},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected != result {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestRemoteReadConfig(t *testing.T) {
+	header := `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+remote_read:
+- url: https://example.com/remote_read
+  remote_timeout: 30s
+`
+
+	for _, tc := range []struct {
+		name     string
+		version  string
+		spec     monitoringv1.RemoteReadSpec
+		expected string
+	}{
+		{
+			name:    "authorization and options",
+			version: "v2.34.0",
+			spec: monitoringv1.RemoteReadSpec{
+				URL: "https://example.com/remote_read",
+				Authorization: &monitoringv1.SafeAuthorization{
+					Type: "Bearer",
+					Credentials: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "auth"},
+						Key:                  "token",
+					},
+				},
+				FollowRedirects:      pointer.BoolPtr(false),
+				FilterExternalLabels: pointer.BoolPtr(false),
+				Headers:              map[string]string{"X-Scope-OrgID": "tenant"},
+			},
+			expected: header + `  authorization:
+    type: Bearer
+    credentials: secret-token
+  follow_redirects: false
+  filter_external_labels: false
+  headers:
+    X-Scope-OrgID: tenant
+`,
+		},
+		{
+			name:    "oauth2",
+			version: "v2.27.0",
+			spec: monitoringv1.RemoteReadSpec{
+				URL: "https://example.com/remote_read",
+				OAuth2: &monitoringv1.OAuth2WithTLSConfig{
+					OAuth2: monitoringv1.OAuth2{
+						ClientID: monitoringv1.SecretOrConfigMap{
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "oauth2"},
+								Key:                  "client-id",
+							},
+						},
+						ClientSecret: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "oauth2"},
+							Key:                  "client-secret",
+						},
+						TokenURL: "https://example.com/token",
+					},
+				},
+				FilterExternalLabels: pointer.BoolPtr(false),
+			},
+			expected: header + `  oauth2:
+    client_id: client-id
+    client_secret: client-secret
+    token_url: https://example.com/token
+`,
+		},
+		{
+			name:    "unsupported version",
+			version: "v2.25.0",
+			spec: monitoringv1.RemoteReadSpec{
+				URL: "https://example.com/remote_read",
+				Authorization: &monitoringv1.SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "auth"},
+						Key:                  "token",
+					},
+				},
+				FollowRedirects: pointer.BoolPtr(false),
+				Headers:         map[string]string{"X-Scope-OrgID": "tenant"},
+			},
+			expected: header,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version:    tc.version,
+						RemoteRead: []monitoringv1.RemoteReadSpec{tc.spec},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{
+					"remoteRead/auth/0": "secret-token",
+				},
+				map[string]assets.OAuth2Credentials{
+					"remoteRead/0": {
+						ClientID:     "client-id",
+						ClientSecret: "client-secret",
+					},
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected != result {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestScrapeClass(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			Version: "v2.37.0",
+			ScrapeClasses: []monitoringv1.ScrapeClass{
+				{
+					Name:    "default",
+					Default: pointer.BoolPtr(true),
+					TLSConfig: &monitoringv1.TLSConfig{
+						CAFile: "/etc/ca.crt",
+					},
+					Authorization: &monitoringv1.Authorization{
+						CredentialsFile: "/etc/token",
+					},
+					Relabelings: []*monitoringv1.RelabelConfig{
+						{
+							TargetLabel: "cluster",
+							Replacement: "prod",
+						},
+					},
+					MetricRelabelings: []*monitoringv1.RelabelConfig{
+						{
+							SourceLabels: []string{"__name__"},
+							Regex:        "go_.*",
+							Action:       "drop",
+						},
+					},
+				},
+				{
+					Name: "node",
+					AttachMetadata: &monitoringv1.AttachMetadata{
+						Node: true,
+					},
+					Authorization: &monitoringv1.Authorization{
+						SafeAuthorization: monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "auth"},
+								Key:                  "token",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		scrapeClass    *string
+		attachMetadata *monitoringv1.AttachMetadata
+		tlsConfig      *monitoringv1.PodMetricsEndpointTLSConfig
+		expected       []string
+		unexpected     []string
+	}{
+		{
+			name: "default scrape class",
+			expected: []string{
+				`  tls_config:
+    insecure_skip_verify: false
+    ca_file: /etc/ca.crt
+`,
+				`  authorization:
+    credentials_file: /etc/token
+`,
+				`  - target_label: cluster
+    replacement: prod
+`,
+				`  metric_relabel_configs:
+  - source_labels:
+    - __name__
+    regex: go_.*
+    action: drop
+`,
+			},
+			unexpected: []string{"attach_metadata"},
+		},
+		{
+			name:        "explicit scrape class",
+			scrapeClass: pointer.StringPtr("node"),
+			expected: []string{
+				`    attach_metadata:
+      node: true
+`,
+				`  authorization:
+    credentials: secret-token
+`,
+			},
+			unexpected: []string{"tls_config", "cluster", "metric_relabel_configs"},
+		},
+		{
+			name: "endpoint tls config takes precedence",
+			tlsConfig: &monitoringv1.PodMetricsEndpointTLSConfig{
+				SafeTLSConfig: monitoringv1.SafeTLSConfig{
+					InsecureSkipVerify: true,
+				},
+			},
+			expected: []string{
+				`  tls_config:
+    insecure_skip_verify: true
+`,
+			},
+			unexpected: []string{"/etc/ca.crt"},
+		},
+		{
+			name: "monitor attach metadata",
+			attachMetadata: &monitoringv1.AttachMetadata{
+				Node: true,
+			},
+			expected: []string{
+				`    attach_metadata:
+      node: true
+`,
+			},
+		},
+		{
+			name:        "monitor attach metadata takes precedence",
+			scrapeClass: pointer.StringPtr("node"),
+			attachMetadata: &monitoringv1.AttachMetadata{
+				Node: false,
+			},
+			unexpected: []string{"attach_metadata"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				map[string]*monitoringv1.PodMonitor{
+					"default/testpodmonitor1": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "testpodmonitor1",
+							Namespace: "default",
+						},
+						Spec: monitoringv1.PodMonitorSpec{
+							ScrapeClassName: tc.scrapeClass,
+							AttachMetadata:  tc.attachMetadata,
+							PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+								{
+									Port:      "web",
+									TLSConfig: tc.tlsConfig,
+								},
+							},
+						},
+					},
+				},
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{
+					scrapeClassAuthKey("node"): "secret-token",
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for _, s := range tc.expected {
+				if !strings.Contains(result, s) {
+					t.Fatalf("expected configuration to contain:\n%s\ngot:\n%s", s, result)
+				}
+			}
+			for _, s := range tc.unexpected {
+				if strings.Contains(result, s) {
+					t.Fatalf("expected configuration not to contain %q, got:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestEnforcedLimits(t *testing.T) {
+	for _, tc := range []struct {
+		version    string
+		expected   []string
+		unexpected []string
+	}{
+		{
+			version: "v2.47.0",
+			expected: []string{`  body_size_limit: 10MB
+  label_limit: 50
+  label_name_length_limit: 100
+  label_value_length_limit: 200
+  keep_dropped_targets: 10
+`},
+		},
+		{
+			version: "v2.27.0",
+			expected: []string{`  label_limit: 50
+  label_name_length_limit: 100
+  label_value_length_limit: 200
+`},
+			unexpected: []string{"body_size_limit", "keep_dropped_targets"},
+		},
+		{
+			version:    "v2.26.0",
+			unexpected: []string{"body_size_limit", "label_limit", "label_name_length_limit", "label_value_length_limit", "keep_dropped_targets"},
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			labelLimit, labelNameLengthLimit, labelValueLengthLimit, keepDroppedTargets := uint64(50), uint64(100), uint64(200), uint64(10)
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version:                       tc.version,
+					EnforcedBodySizeLimit:         "10MB",
+					EnforcedLabelLimit:            &labelLimit,
+					EnforcedLabelNameLengthLimit:  &labelNameLengthLimit,
+					EnforcedLabelValueLengthLimit: &labelValueLengthLimit,
+					EnforcedKeepDroppedTargets:    &keepDroppedTargets,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{
+					"default/testservicemonitor1": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "testservicemonitor1",
+							Namespace: "default",
+						},
+						Spec: monitoringv1.ServiceMonitorSpec{
+							Endpoints: []monitoringv1.Endpoint{
+								{
+									Port: "web",
+								},
+							},
+						},
+					},
+				},
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for _, s := range tc.expected {
+				if !strings.Contains(result, s) {
+					t.Fatalf("expected configuration to contain:\n%s\ngot:\n%s", s, result)
+				}
+			}
+			for _, s := range tc.unexpected {
+				if strings.Contains(result, s) {
+					t.Fatalf("expected configuration not to contain %q, got:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestScrapeLimits(t *testing.T) {
+	enforcedLabelLimit := uint64(50)
+
+	for _, tc := range []struct {
+		name       string
+		labelLimit uint64
+		enforced   *uint64
+		expected   string
+	}{
+		{
+			name:       "monitor limit only",
+			labelLimit: 100,
+			expected:   "  label_limit: 100\n",
+		},
+		{
+			name:       "monitor limit lower than the enforced limit",
+			labelLimit: 10,
+			enforced:   &enforcedLabelLimit,
+			expected:   "  label_limit: 10\n",
+		},
+		{
+			name:       "monitor limit higher than the enforced limit",
+			labelLimit: 100,
+			enforced:   &enforcedLabelLimit,
+			expected:   "  label_limit: 50\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version:            "v3.0.0",
+					EnforcedLabelLimit: tc.enforced,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				nil,
+				map[string]*monitoringv1.Probe{
+					"default/testprobe1": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "testprobe1",
+							Namespace: "default",
+						},
+						Spec: monitoringv1.ProbeSpec{
+							ProberSpec: monitoringv1.ProberSpec{
+								URL: "blackbox.exporter.io",
+							},
+							Targets: monitoringv1.ProbeTargets{
+								StaticConfig: &monitoringv1.ProbeTargetStaticConfig{
+									Targets: []string{"prometheus.io"},
+								},
+							},
+							SampleLimit:        1000,
+							TargetLimit:        20,
+							KeepDroppedTargets: 5,
+							LabelLimit:         tc.labelLimit,
+						},
+					},
+				},
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for _, s := range []string{"  sample_limit: 1000\n", "  target_limit: 20\n", tc.expected, "  keep_dropped_targets: 5\n"} {
+				if !strings.Contains(result, s) {
+					t.Fatalf("expected configuration to contain:\n%s\ngot:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestScrapeProtocols(t *testing.T) {
+	for _, tc := range []struct {
+		version    string
+		expected   []string
+		unexpected []string
+	}{
+		{
+			version: "v2.49.0",
+			expected: []string{
+				`global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+  scrape_protocols:
+  - PrometheusProto
+  - OpenMetricsText1.0.0
+`,
+				`  scrape_protocols:
+  - PrometheusText0.0.4
+`,
+			},
+		},
+		{
+			version:    "v2.48.0",
+			unexpected: []string{"scrape_protocols"},
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+					ScrapeProtocols: []monitoringv1.ScrapeProtocol{
+						monitoringv1.PrometheusProto,
+						monitoringv1.OpenMetricsText1_0_0,
+					},
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				map[string]*monitoringv1.PodMonitor{
+					"default/testpodmonitor1": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "testpodmonitor1",
+							Namespace: "default",
+						},
+						Spec: monitoringv1.PodMonitorSpec{
+							ScrapeProtocols: []monitoringv1.ScrapeProtocol{
+								monitoringv1.PrometheusText0_0_4,
+							},
+							PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+								{
+									Port: "web",
+								},
+							},
+						},
+					},
+				},
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for _, s := range tc.expected {
+				if !strings.Contains(result, s) {
+					t.Fatalf("expected configuration to contain:\n%s\ngot:\n%s", s, result)
+				}
+			}
+			for _, s := range tc.unexpected {
+				if strings.Contains(result, s) {
+					t.Fatalf("expected configuration not to contain %q, got:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestTSDBConfig(t *testing.T) {
+	for _, tc := range []struct {
+		version  string
+		expected string
+	}{
+		{
+			version: "v2.39.0",
+			expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+storage:
+  tsdb:
+    out_of_order_time_window: 10m
+`,
+		},
+		{
+			version: "v2.38.0",
+			expected: `global:
+  evaluation_interval: 30s
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+    prometheus_replica: $(POD_NAME)
+rule_files: []
+scrape_configs: []
+alerting:
+  alert_relabel_configs:
+  - action: labeldrop
+    regex: prometheus_replica
+  alertmanagers: []
+`,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+					TSDB: monitoringv1.TSDBSpec{
+						OutOfOrderTimeWindow: "10m",
+					},
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected != result {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestExemplarsConfig(t *testing.T) {
+	maxSize := int64(5000000)
+
+	for _, tc := range []struct {
+		name     string
+		version  string
+		tsdb     monitoringv1.TSDBSpec
+		expected string
+	}{
+		{
+			name:    "exemplars",
+			version: "v2.29.0",
+			expected: `storage:
+  exemplars:
+    max_exemplars: 5000000
+`,
+		},
+		{
+			name:    "tsdb and exemplars",
+			version: "v2.39.0",
+			tsdb:    monitoringv1.TSDBSpec{OutOfOrderTimeWindow: "10m"},
+			expected: `storage:
+  tsdb:
+    out_of_order_time_window: 10m
+  exemplars:
+    max_exemplars: 5000000
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+					TSDB:    tc.tsdb,
+					Exemplars: &monitoringv1.Exemplars{
+						MaxSize: &maxSize,
+					},
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if !strings.Contains(result, tc.expected) {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration to contain the storage section")
+			}
+		})
+	}
+}
+
+func TestExemplarsConfigUnsupportedVersion(t *testing.T) {
+	maxSize := int64(5000000)
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			Version: "v2.28.0",
+			Exemplars: &monitoringv1.Exemplars{
+				MaxSize: &maxSize,
+			},
+		},
+	}
+
+	cg := newConfigGenerator(log.NewNopLogger())
+	cfg, err := cg.generateConfig(
+		p,
+		nil,
+		nil,
+		nil,
+		map[string]assets.BasicAuthCredentials{},
+		map[string]assets.BearerToken{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(cfg), "storage:") {
+		t.Fatalf("expected no storage section for Prometheus v2.28.0, got:\n%s", string(cfg))
+	}
+}
+
+func TestScrapeFailureLogFile(t *testing.T) {
+	for _, tc := range []struct {
+		version  string
+		logFile  string
+		expected string
+	}{
+		{
+			version:  "v2.55.0",
+			logFile:  "scrape.log",
+			expected: "scrape_failure_log_file: /var/log/prometheus/scrape.log",
+		},
+		{
+			version:  "v2.55.0",
+			logFile:  "/prometheus/scrape.log",
+			expected: "scrape_failure_log_file: /prometheus/scrape.log",
+		},
+		{
+			version:  "v2.55.0",
+			logFile:  "/dev/stdout",
+			expected: "scrape_failure_log_file: /dev/stdout",
+		},
+		{
+			version: "v2.54.0",
+			logFile: "scrape.log",
+		},
+	} {
+		t.Run(tc.version+" "+tc.logFile, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version:              tc.version,
+					ScrapeFailureLogFile: tc.logFile,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected == "" {
+				if strings.Contains(result, "scrape_failure_log_file") {
+					t.Fatalf("expected no scrape_failure_log_file, got:\n%s", result)
+				}
+				return
+			}
+
+			if !strings.Contains(result, tc.expected) {
+				t.Fatalf("expected configuration to contain %q, got:\n%s", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestOTLPConfig(t *testing.T) {
+	translationStrategy := monitoringv1.UnderscoreEscapingWithSuffixes
+	keepIdentifyingResourceAttributes := true
+
+	for _, tc := range []struct {
+		version  string
+		expected string
+	}{
+		{
+			version: "v2.53.0",
+		},
+		{
+			version: "v2.54.0",
+			expected: `otlp:
+  promote_resource_attributes:
+  - service.instance.id
+  - k8s.namespace.name
+`,
+		},
+		{
+			version: "v3.1.0",
+			expected: `otlp:
+  promote_resource_attributes:
+  - service.instance.id
+  - k8s.namespace.name
+  translation_strategy: UnderscoreEscapingWithSuffixes
+  keep_identifying_resource_attributes: true
+`,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+					OTLP: &monitoringv1.OTLPConfig{
+						PromoteResourceAttributes:         []string{"service.instance.id", "k8s.namespace.name"},
+						TranslationStrategy:               &translationStrategy,
+						KeepIdentifyingResourceAttributes: &keepIdentifyingResourceAttributes,
+					},
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected == "" {
+				if strings.Contains(result, "otlp:") {
+					t.Fatalf("expected no otlp section, got:\n%s", result)
+				}
+				return
+			}
+
+			if !strings.Contains(result, tc.expected) {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration to contain the otlp section")
+			}
+		})
+	}
+}
+
+func TestTracingConfig(t *testing.T) {
+	samplingFraction := resource.MustParse("0.1")
+	clientType := "grpc"
+	compression := "gzip"
+
+	for _, tc := range []struct {
+		name          string
+		version       string
+		tracingConfig *monitoringv1.PrometheusTracingConfig
+		expected      string
+	}{
+		{
+			name:    "endpoint only",
+			version: "v2.34.0",
+			tracingConfig: &monitoringv1.PrometheusTracingConfig{
+				Endpoint: "otel-collector:4317",
+			},
+			expected: `tracing:
+  endpoint: otel-collector:4317
+`,
+		},
+		{
+			name:    "all fields",
+			version: "v2.34.0",
+			tracingConfig: &monitoringv1.PrometheusTracingConfig{
+				Endpoint:         "otel-collector:4317",
+				ClientType:       &clientType,
+				SamplingFraction: &samplingFraction,
+				Insecure:         swag.Bool(false),
+				Headers: map[string]string{
+					"x-tenant": "team-a",
+				},
+				Compression: &compression,
+				Timeout:     "10s",
+				TLSConfig: &monitoringv1.TLSConfig{
+					SafeTLSConfig: monitoringv1.SafeTLSConfig{
+						CA: monitoringv1.SecretOrConfigMap{
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "tracing-tls",
+								},
+								Key: "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			expected: `tracing:
+  endpoint: otel-collector:4317
+  client_type: grpc
+  sampling_fraction: 0.1
+  insecure: false
+  headers:
+    x-tenant: team-a
+  compression: gzip
+  timeout: 10s
+  tls_config:
+    insecure_skip_verify: false
+    ca_file: /etc/prometheus/certs/secret_default_tracing-tls_ca.crt
+`,
+		},
+		{
+			name:    "unsupported version",
+			version: "v2.33.0",
+			tracingConfig: &monitoringv1.PrometheusTracingConfig{
+				Endpoint: "otel-collector:4317",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version:       tc.version,
+					TracingConfig: tc.tracingConfig,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected == "" {
+				if strings.Contains(result, "tracing:") {
+					t.Fatalf("expected no tracing section, got:\n%s", result)
+				}
+				return
+			}
+
+			if !strings.Contains(result, tc.expected) {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration to contain the tracing section")
+			}
+		})
+	}
+}
+
+func TestExcludedFromEnforcement(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		excluded []monitoringv1.ObjectReference
+		enforced bool
+	}{
+		{
+			name:     "no exclusion",
+			enforced: true,
+		},
+		{
+			name: "excluded by name",
+			excluded: []monitoringv1.ObjectReference{
+				{Resource: monitoringv1.ServiceMonitorName, Namespace: "monitoring", Name: "kubelet"},
+			},
+		},
+		{
+			name: "excluded by namespace",
+			excluded: []monitoringv1.ObjectReference{
+				{Group: "monitoring.coreos.com", Resource: monitoringv1.ServiceMonitorName, Namespace: "monitoring"},
+			},
+		},
+		{
+			name: "other resource",
+			excluded: []monitoringv1.ObjectReference{
+				{Resource: monitoringv1.PodMonitorName, Namespace: "monitoring", Name: "kubelet"},
+			},
+			enforced: true,
+		},
+		{
+			name: "other name",
+			excluded: []monitoringv1.ObjectReference{
+				{Resource: monitoringv1.ServiceMonitorName, Namespace: "monitoring", Name: "node-exporter"},
+			},
+			enforced: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						EnforcedNamespaceLabel:  "ns-key",
+						ExcludedFromEnforcement: tc.excluded,
+					},
+				},
+				map[string]*monitoringv1.ServiceMonitor{
+					"monitoring/kubelet": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "kubelet",
+							Namespace: "monitoring",
+						},
+						Spec: monitoringv1.ServiceMonitorSpec{
+							Endpoints: []monitoringv1.Endpoint{
+								{Port: "https-metrics"},
+							},
+						},
+					},
+				},
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			enforced := strings.Contains(string(cfg), "target_label: ns-key")
+			if enforced != tc.enforced {
+				t.Fatalf("expected namespace label enforcement to be %v, got %v:\n%s", tc.enforced, enforced, cfg)
+			}
+		})
+	}
+}
+
+func TestAlertmanagerRelabelings(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:    "alert relabelings",
+			version: "v2.51.0",
+			expected: `    relabel_configs:
+    - action: keep
+      source_labels:
+      - __meta_kubernetes_service_name
+      regex: alertmanager-main
+    - action: keep
+      source_labels:
+      - __meta_kubernetes_endpoint_port_name
+      regex: web
+    - source_labels:
+      - __meta_kubernetes_pod_label_zone
+      target_label: zone
+    alert_relabel_configs:
+    - source_labels:
+      - severity
+      regex: info
+      action: drop
+`,
+		},
+		{
+			name:    "unsupported version",
+			version: "v2.50.0",
+			expected: `    relabel_configs:
+    - action: keep
+      source_labels:
+      - __meta_kubernetes_service_name
+      regex: alertmanager-main
+    - action: keep
+      source_labels:
+      - __meta_kubernetes_endpoint_port_name
+      regex: web
+    - source_labels:
+      - __meta_kubernetes_pod_label_zone
+      target_label: zone
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version: tc.version,
+						Alerting: &monitoringv1.AlertingSpec{
+							Alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+								{
+									Name:      "alertmanager-main",
+									Namespace: "default",
+									Port:      intstr.FromString("web"),
+									Relabelings: []monitoringv1.RelabelConfig{
+										{
+											SourceLabels: []string{"__meta_kubernetes_pod_label_zone"},
+											TargetLabel:  "zone",
+										},
+									},
+									AlertRelabelConfigs: []monitoringv1.RelabelConfig{
+										{
+											SourceLabels: []string{"severity"},
+											Regex:        "info",
+											Action:       "drop",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if !strings.HasSuffix(result, tc.expected) {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestAlertmanagerEndpointsAuthentication(t *testing.T) {
+	trueVal := true
+	proxyURL := "http://proxy.example.com:3128"
+
+	for _, tc := range []struct {
+		name         string
+		version      string
+		am           monitoringv1.AlertmanagerEndpoints
+		basicAuth    map[string]assets.BasicAuthCredentials
+		bearerTokens map[string]assets.BearerToken
+		sigv4        map[string]assets.SigV4Credentials
+		expected     string
+	}{
+		{
+			name:    "basic auth",
+			version: "v2.35.0",
+			am: monitoringv1.AlertmanagerEndpoints{
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "am-auth"}, Key: "username"},
+					Password: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "am-auth"}, Key: "password"},
+				},
+				ProxyURL:    &proxyURL,
+				EnableHttp2: &trueVal,
+			},
+			basicAuth: map[string]assets.BasicAuthCredentials{
+				"alertmanager/auth/0": {Username: "user", Password: "pass"},
+			},
+			expected: `    basic_auth:
+      username: user
+      password: pass
+    proxy_url: http://proxy.example.com:3128
+    enable_http2: true
+`,
+		},
+		{
+			name:    "authorization",
+			version: "v2.26.0",
+			am: monitoringv1.AlertmanagerEndpoints{
+				Authorization: &monitoringv1.SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "am-auth"}, Key: "token"},
+				},
+				EnableHttp2: &trueVal,
+			},
+			bearerTokens: map[string]assets.BearerToken{
+				"alertmanager/auth/0": "secret-token",
+			},
+			expected: `    authorization:
+      credentials: secret-token
+`,
+		},
+		{
+			name:    "authorization with unsupported version",
+			version: "v2.25.0",
+			am: monitoringv1.AlertmanagerEndpoints{
+				Authorization: &monitoringv1.SafeAuthorization{
+					Credentials: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "am-auth"}, Key: "token"},
+				},
+			},
+			bearerTokens: map[string]assets.BearerToken{
+				"alertmanager/auth/0": "secret-token",
+			},
+			expected: `        - default
+`,
+		},
+		{
+			name:    "sigv4",
+			version: "v2.48.0",
+			am: monitoringv1.AlertmanagerEndpoints{
+				Sigv4: &monitoringv1.Sigv4{
+					Region:    "us-east-1",
+					AccessKey: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "am-sigv4"}, Key: "access-key"},
+					SecretKey: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "am-sigv4"}, Key: "secret-key"},
+				},
+			},
+			sigv4: map[string]assets.SigV4Credentials{
+				"alertmanager/auth/0": {AccessKeyID: "access-key", SecretKeyID: "secret-key"},
+			},
+			expected: `    sigv4:
+      region: us-east-1
+      access_key: access-key
+      secret_key: secret-key
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := tc.am
+			am.Name = "alertmanager-main"
+			am.Namespace = "default"
+			am.Port = intstr.FromString("web")
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						Version: tc.version,
+						Alerting: &monitoringv1.AlertingSpec{
+							Alertmanagers: []monitoringv1.AlertmanagerEndpoints{am},
+						},
+					},
+				},
+				nil,
+				nil,
+				nil,
+				tc.basicAuth,
+				tc.bearerTokens,
+				nil,
+				tc.sigv4,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := tc.expected + `    relabel_configs:
+    - action: keep
+      source_labels:
+      - __meta_kubernetes_service_name
+      regex: alertmanager-main
+    - action: keep
+      source_labels:
+      - __meta_kubernetes_endpoint_port_name
+      regex: web
+`
+			result := string(cfg)
+			if !strings.HasSuffix(result, expected) {
+				fmt.Println(pretty.Compare(expected, result))
+				t.Fatal("expected Prometheus configuration and actual configuration do not match")
+			}
+		})
+	}
+}
+
+func TestRuleQueryOffset(t *testing.T) {
+	queryOffset := "30s"
+
+	for _, tc := range []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "v2.53.0",
+			expected: "rule_query_offset: 30s",
+		},
+		{
+			version: "v2.52.0",
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version:         tc.version,
+					RuleQueryOffset: &queryOffset,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected == "" {
+				if strings.Contains(result, "rule_query_offset") {
+					t.Fatalf("expected no rule_query_offset, got:\n%s", result)
+				}
+				return
+			}
+
+			if !strings.Contains(result, tc.expected) {
+				t.Fatalf("expected configuration to contain %q, got:\n%s", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceMonitorOAuth2(t *testing.T) {
+	oauth2 := &monitoringv1.OAuth2WithTLSConfig{
+		OAuth2: monitoringv1.OAuth2{
+			ClientID: monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "oauth2"},
+					Key:                  "client_id",
+				},
+			},
+			ClientSecret: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "oauth2"},
+				Key:                  "client_secret",
+			},
+			TokenURL:       "http://test.url",
+			Scopes:         []string{"scope1"},
+			EndpointParams: map[string]string{"param": "value"},
+		},
+		TLSConfig: &monitoringv1.SafeTLSConfig{
+			CA: monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "oauth2"},
+					Key:                  "ca.crt",
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		version  string
+		expected string
+		noTLS    bool
+	}{
+		{
+			version: "v2.43.0",
+			expected: `  oauth2:
+    client_id: client-id
+    client_secret: client-secret
+    token_url: http://test.url
+    scopes:
+    - scope1
+    endpoint_params:
+      param: value
+    tls_config:
+      insecure_skip_verify: false
+      ca_file: /etc/prometheus/certs/secret_default_oauth2_ca.crt
+`,
+		},
+		{
+			version: "v2.27.0",
+			expected: `  oauth2:
+    client_id: client-id
+    client_secret: client-secret
+    token_url: http://test.url
+    scopes:
+    - scope1
+    endpoint_params:
+      param: value
+`,
+			noTLS: true,
+		},
+		{
+			version: "v2.26.0",
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+				},
+			}
+
+			sm := &monitoringv1.ServiceMonitor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testservicemonitor1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{
+						{
+							Port:   "web",
+							OAuth2: oauth2,
+						},
+					},
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{"testservicemonitor1": sm},
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				map[string]assets.OAuth2Credentials{
+					"serviceMonitor/default/testservicemonitor1/0": {
+						ClientID:     "client-id",
+						ClientSecret: "client-secret",
+					},
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if tc.expected == "" {
+				if strings.Contains(result, "oauth2") {
+					t.Fatalf("expected no oauth2 configuration, got:\n%s", result)
+				}
+				return
+			}
+
+			if !strings.Contains(result, tc.expected) {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected the scrape configuration to contain the OAuth2 configuration")
+			}
+
+			if tc.noTLS && strings.Contains(result, "secret_default_oauth2_ca.crt") {
+				t.Fatalf("expected no OAuth2 TLS configuration, got:\n%s", result)
+			}
+		})
+	}
+}
+
+func TestMonitorAuthorization(t *testing.T) {
+	authorization := &monitoringv1.SafeAuthorization{
+		Type: "Bearer",
+		Credentials: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "auth"},
+			Key:                  "token",
+		},
+	}
+
+	for _, tc := range []struct {
+		version  string
+		expected bool
+	}{
+		{
+			version:  "v2.26.0",
+			expected: true,
+		},
+		{
+			version: "v2.25.0",
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{
+					"sm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: "default"},
+						Spec: monitoringv1.ServiceMonitorSpec{
+							Endpoints: []monitoringv1.Endpoint{{Port: "web", Authorization: authorization}},
+						},
+					},
+				},
+				map[string]*monitoringv1.PodMonitor{
+					"pm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "default"},
+						Spec: monitoringv1.PodMonitorSpec{
+							PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Port: "web", Authorization: authorization}},
+						},
+					},
+				},
+				map[string]*monitoringv1.Probe{
+					"probe": {
+						ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"},
+						Spec: monitoringv1.ProbeSpec{
+							ProberSpec: monitoringv1.ProberSpec{URL: "blackbox.exporter.io"},
+							Targets: monitoringv1.ProbeTargets{
+								StaticConfig: &monitoringv1.ProbeTargetStaticConfig{Targets: []string{"prometheus.io"}},
+							},
+							Authorization: authorization,
+						},
+					},
+				},
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{
+					"serviceMonitor/auth/default/sm/0": "sm-token",
+					"podMonitor/auth/default/pm/0":     "pm-token",
+					"probe/auth/default/probe":         "probe-token",
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for _, token := range []string{"sm-token", "pm-token", "probe-token"} {
+				expected := fmt.Sprintf(`  authorization:
+    type: Bearer
+    credentials: %s
+`, token)
+				if tc.expected != strings.Contains(result, expected) {
+					t.Fatalf("expected authorization with %q to be generated: %v, got:\n%s", token, tc.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceMonitorProxyConfig(t *testing.T) {
+	proxyURL := "http://proxy.example.com:3128"
+	noProxy := "0.0.0.0/0,localhost"
+
+	for _, tc := range []struct {
+		version  string
+		expected string
+	}{
+		{
+			version: "v2.43.0",
+			expected: `  proxy_url: http://proxy.example.com:3128
+  no_proxy: 0.0.0.0/0,localhost
+  proxy_connect_header:
+    Proxy-Authorization:
+    - Basic dXNlcjpwYXNz
+`,
+		},
+		{
+			version: "v2.42.0",
+			expected: `  proxy_url: http://proxy.example.com:3128
+  scheme: https
+`,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+				},
+			}
+
+			sm := &monitoringv1.ServiceMonitor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testservicemonitor1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{
+						{
+							Port:   "web",
+							Scheme: "https",
+							ProxyConfig: monitoringv1.ProxyConfig{
+								ProxyURL: &proxyURL,
+								NoProxy:  &noProxy,
+								ProxyConnectHeader: map[string]v1.SecretKeySelector{
+									"Proxy-Authorization": {
+										LocalObjectReference: v1.LocalObjectReference{Name: "proxy"},
+										Key:                  "auth",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{"testservicemonitor1": sm},
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{
+					assets.ProxyConnectHeaderKey("serviceMonitor/default/testservicemonitor1/0", "Proxy-Authorization"): "Basic dXNlcjpwYXNz",
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			if !strings.Contains(result, tc.expected) {
+				fmt.Println(pretty.Compare(tc.expected, result))
+				t.Fatal("expected the scrape configuration to contain the proxy configuration")
+			}
+		})
+	}
+}
+
+func TestMonitorHTTPClientOptions(t *testing.T) {
+	falseVal := false
+
+	for _, tc := range []struct {
+		version         string
+		followRedirects bool
+		enableHTTP2     bool
+	}{
+		{
+			version:         "v2.35.0",
+			followRedirects: true,
+			enableHTTP2:     true,
+		},
+		{
+			version:         "v2.26.0",
+			followRedirects: true,
+		},
+		{
+			version: "v2.25.0",
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{
+					"sm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: "default"},
+						Spec: monitoringv1.ServiceMonitorSpec{
+							Endpoints: []monitoringv1.Endpoint{{Port: "web", FollowRedirects: &falseVal, EnableHttp2: &falseVal}},
+						},
+					},
+				},
+				map[string]*monitoringv1.PodMonitor{
+					"pm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "default"},
+						Spec: monitoringv1.PodMonitorSpec{
+							PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Port: "web", FollowRedirects: &falseVal, EnableHttp2: &falseVal}},
+						},
+					},
+				},
+				map[string]*monitoringv1.Probe{
+					"probe": {
+						ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"},
+						Spec: monitoringv1.ProbeSpec{
+							ProberSpec: monitoringv1.ProberSpec{URL: "blackbox.exporter.io"},
+							Targets: monitoringv1.ProbeTargets{
+								StaticConfig: &monitoringv1.ProbeTargetStaticConfig{Targets: []string{"prometheus.io"}},
+							},
+							FollowRedirects: &falseVal,
+							EnableHttp2:     &falseVal,
+						},
+					},
+				},
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for key, expected := range map[string]bool{
+				"follow_redirects: false": tc.followRedirects,
+				"enable_http2: false":     tc.enableHTTP2,
+			} {
+				if n := strings.Count(result, key); (n == 3) != expected || (n != 0 && n != 3) {
+					t.Fatalf("expected %q in the 3 scrape configs: %v, got:\n%s", key, expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectorMechanism(t *testing.T) {
+	roleSelector := monitoringv1.SelectorMechanismRole
+	relabelConfig := monitoringv1.SelectorMechanismRelabel
+	selector := metav1.LabelSelector{
+		MatchLabels: map[string]string{"group": "group1"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      "k8s-app",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"a", "b"},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		version       string
+		mechanism     *monitoringv1.SelectorMechanism
+		localNodeOnly bool
+		expected      []string
+		unexpected    []string
+	}{
+		{
+			name:    "default",
+			version: "v2.17.0",
+			expected: []string{
+				"__meta_kubernetes_service_label_group",
+				"__meta_kubernetes_pod_label_group",
+			},
+			unexpected: []string{"selectors"},
+		},
+		{
+			name:      "relabel config",
+			version:   "v2.17.0",
+			mechanism: &relabelConfig,
+			expected: []string{
+				"__meta_kubernetes_service_label_group",
+				"__meta_kubernetes_pod_label_group",
+			},
+			unexpected: []string{"selectors"},
+		},
+		{
+			name:      "role selector",
+			version:   "v2.17.0",
+			mechanism: &roleSelector,
+			expected: []string{
+				`    selectors:
+    - role: endpoints
+      label: group=group1,k8s-app in (a,b)
+`,
+				`    selectors:
+    - role: pod
+      label: group=group1,k8s-app in (a,b)
+`,
+			},
+			unexpected: []string{
+				"__meta_kubernetes_service_label_group",
+				"__meta_kubernetes_pod_label_group",
+				"__meta_kubernetes_service_label_k8s_app",
+				"__meta_kubernetes_pod_label_k8s_app",
+			},
+		},
+		{
+			name:          "role selector restricted to the local node",
+			version:       "v2.17.0",
+			mechanism:     &roleSelector,
+			localNodeOnly: true,
+			expected: []string{
+				`    selectors:
+    - role: pod
+      label: group=group1,k8s-app in (a,b)
+      field: spec.nodeName=$(NODE_NAME)
+`,
+			},
+		},
+		{
+			name:      "role selector with unsupported version",
+			version:   "v2.16.0",
+			mechanism: &roleSelector,
+			expected: []string{
+				"__meta_kubernetes_service_label_group",
+				"__meta_kubernetes_pod_label_group",
+			},
+			unexpected: []string{"selectors"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version: tc.version,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{
+					"sm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: "default"},
+						Spec: monitoringv1.ServiceMonitorSpec{
+							Selector:          selector,
+							SelectorMechanism: tc.mechanism,
+							Endpoints:         []monitoringv1.Endpoint{{Port: "web"}},
+						},
+					},
+				},
+				map[string]*monitoringv1.PodMonitor{
+					"pm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "default"},
+						Spec: monitoringv1.PodMonitorSpec{
+							Selector:            selector,
+							SelectorMechanism:   tc.mechanism,
+							PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Port: "web"}},
+						},
+					},
+				},
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				tc.localNodeOnly,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for _, s := range tc.expected {
+				if !strings.Contains(result, s) {
+					t.Fatalf("expected configuration to contain:\n%s\ngot:\n%s", s, result)
+				}
+			}
+			for _, s := range tc.unexpected {
+				if strings.Contains(result, s) {
+					t.Fatalf("expected configuration not to contain %q, got:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestNumericPorts(t *testing.T) {
+	portNumber := int32(8080)
+	targetPort := intstr.FromInt(8080)
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			Version: operator.DefaultPrometheusVersion,
+		},
+	}
+
+	cg := newConfigGenerator(log.NewNopLogger())
+	cfg, err := cg.generateConfig(
+		p,
+		map[string]*monitoringv1.ServiceMonitor{
+			"sm": {
+				ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: "default"},
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{{TargetPort: &targetPort}},
+				},
+			},
+		},
+		map[string]*monitoringv1.PodMonitor{
+			"pm": {
+				ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "default"},
+				Spec: monitoringv1.PodMonitorSpec{
+					PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{PortNumber: &portNumber}},
+				},
+			},
+		},
+		nil,
+		map[string]assets.BasicAuthCredentials{},
+		map[string]assets.BearerToken{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := string(cfg)
+	for _, expected := range []string{
+		`  - action: keep
+    source_labels:
+    - __meta_kubernetes_pod_container_port_number
+    regex: "8080"
+`,
+		`  - target_label: endpoint
+    replacement: "8080"
+`,
+	} {
+		// Both the ServiceMonitor and the PodMonitor select the port by number.
+		if n := strings.Count(result, expected); n != 2 {
+			t.Fatalf("expected configuration to contain twice:\n%s\ngot:\n%s", expected, result)
+		}
+	}
+}
+
+func TestPodMonitorFilterRunning(t *testing.T) {
+	falseVal := false
+	trueVal := true
+
+	for _, tc := range []struct {
+		name          string
+		filterRunning *bool
+		expected      bool
+	}{
+		{
+			name:     "default",
+			expected: true,
+		},
+		{
+			name:          "enabled",
+			filterRunning: &trueVal,
+			expected:      true,
+		},
+		{
+			name:          "disabled",
+			filterRunning: &falseVal,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				nil,
+				map[string]*monitoringv1.PodMonitor{
+					"pm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "default"},
+						Spec: monitoringv1.PodMonitorSpec{
+							PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Port: "web", FilterRunning: tc.filterRunning}},
+						},
+					},
+				},
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			filter := `  - action: drop
+    source_labels:
+    - __meta_kubernetes_pod_phase
+    regex: (Failed|Succeeded)
+`
+			if found := strings.Contains(string(cfg), filter); found != tc.expected {
+				t.Fatalf("expected the phase filter to be present: %v, got:\n%s", tc.expected, string(cfg))
+			}
+		})
+	}
+}
+
+func TestServiceDiscoveryRole(t *testing.T) {
+	endpointSlice := monitoringv1.EndpointSliceRole
+
+	for _, tc := range []struct {
+		name       string
+		version    string
+		role       *monitoringv1.ServiceDiscoveryRole
+		expected   []string
+		unexpected []string
+	}{
+		{
+			name:    "default",
+			version: "v2.21.0",
+			expected: []string{
+				"  - role: endpoints\n",
+				"__meta_kubernetes_endpoint_port_name",
+				"__meta_kubernetes_endpoint_address_target_kind",
+			},
+			unexpected: []string{"endpointslice"},
+		},
+		{
+			name:    "endpointslice",
+			version: "v2.21.0",
+			role:    &endpointSlice,
+			expected: []string{
+				"  - role: endpointslice\n",
+				"__meta_kubernetes_endpointslice_port_name",
+				"__meta_kubernetes_endpointslice_address_target_kind",
+				"__meta_kubernetes_endpointslice_address_target_name",
+			},
+			unexpected: []string{"__meta_kubernetes_endpoint_"},
+		},
+		{
+			name:    "endpointslice with unsupported version",
+			version: "v2.20.0",
+			role:    &endpointSlice,
+			expected: []string{
+				"  - role: endpoints\n",
+				"__meta_kubernetes_endpoint_port_name",
+			},
+			unexpected: []string{"endpointslice"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					Version:              tc.version,
+					ServiceDiscoveryRole: tc.role,
+				},
+			}
+
+			cg := newConfigGenerator(log.NewNopLogger())
+			cfg, err := cg.generateConfig(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{
+					"sm": {
+						ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: "default"},
+						Spec: monitoringv1.ServiceMonitorSpec{
+							Endpoints: []monitoringv1.Endpoint{{Port: "web"}},
+						},
+					},
+				},
+				nil,
+				nil,
+				map[string]assets.BasicAuthCredentials{},
+				map[string]assets.BearerToken{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := string(cfg)
+			for _, s := range tc.expected {
+				if !strings.Contains(result, s) {
+					t.Fatalf("expected configuration to contain:\n%s\ngot:\n%s", s, result)
+				}
+			}
+			for _, s := range tc.unexpected {
+				if strings.Contains(result, s) {
+					t.Fatalf("expected configuration not to contain %q, got:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
@@ -24,10 +24,12 @@ import (
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	namespacelabeler "github.com/prometheus-operator/prometheus-operator/pkg/namespace-labeler"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/blang/semver/v4"
 	"github.com/ghodss/yaml"
 	"github.com/go-kit/kit/log/level"
 	"github.com/pkg/errors"
@@ -170,6 +172,11 @@ func (c *Operator) selectRules(p *monitoringv1.Prometheus, namespaces []string)
 		return rules, errors.Wrap(err, "convert rule label selector to selector")
 	}
 
+	version, err := semver.ParseTolerant(operator.StringValOrDefault(p.Spec.Version, operator.DefaultPrometheusVersion))
+	if err != nil {
+		return rules, errors.Wrap(err, "failed to parse prometheus version")
+	}
+
 	nsLabeler := namespacelabeler.New(
 		p.Spec.EnforcedNamespaceLabel,
 		p.Spec.PrometheusRulesExcludedFromEnforce,
@@ -181,9 +188,19 @@ func (c *Operator) selectRules(p *monitoringv1.Prometheus, namespaces []string)
 		err := c.ruleInfs.ListAllByNamespace(ns, ruleSelector, func(obj interface{}) {
 			promRule := obj.(*monitoringv1.PrometheusRule).DeepCopy()
 
-			if err := nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
-				marshalErr = err
-				return
+			if !namespacelabeler.IsExcludedFromEnforcement(p.Spec.ExcludedFromEnforcement, monitoringv1.PrometheusRuleName, promRule.Namespace, promRule.Name) {
+				if err := nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
+					marshalErr = err
+					return
+				}
+			}
+
+			if removeUnsupportedRuleGroupFields(&promRule.Spec, version) {
+				level.Warn(c.logger).Log(
+					"msg", "query_offset is only supported in Prometheus versions 2.53.0 and newer, ignoring it",
+					"version", version,
+					"prometheusrule", promRule.Namespace+"/"+promRule.Name,
+				)
 			}
 
 			content, err := generateContent(promRule.Spec)
@@ -221,6 +238,25 @@ func (c *Operator) selectRules(p *monitoringv1.Prometheus, namespaces []string)
 	return rules, nil
 }
 
+// removeUnsupportedRuleGroupFields removes the rule group fields which aren't
+// supported by the given Prometheus version since Prometheus refuses to load
+// rule files with unknown fields. It returns true if any field was removed.
+func removeUnsupportedRuleGroupFields(spec *monitoringv1.PrometheusRuleSpec, version semver.Version) bool {
+	if version.GTE(semver.MustParse("2.53.0")) {
+		return false
+	}
+
+	var removed bool
+	for i := range spec.Groups {
+		if spec.Groups[i].QueryOffset != nil {
+			spec.Groups[i].QueryOffset = nil
+			removed = true
+		}
+	}
+
+	return removed
+}
+
 func generateContent(promRule monitoringv1.PrometheusRuleSpec) (string, error) {
 	content, err := yaml.Marshal(promRule)
 	if err != nil {
@@ -18,8 +18,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/blang/semver/v4"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestMakeRulesConfigMaps(t *testing.T) {
@@ -79,3 +81,64 @@ func shouldSplitUpLargeSmallIntoTwo(t *testing.T) {
 		t.Fatal("expected ConfigMap data to match rule file content")
 	}
 }
+
+func TestRemoveUnsupportedRuleGroupFields(t *testing.T) {
+	queryOffset := "1m"
+
+	for _, tc := range []struct {
+		version  string
+		removed  bool
+		expected string
+	}{
+		{
+			version: "2.53.0",
+			expected: `groups:
+- name: group
+  query_offset: 1m
+  rules:
+  - expr: "1"
+    record: rule
+`,
+		},
+		{
+			version: "2.52.0",
+			removed: true,
+			expected: `groups:
+- name: group
+  rules:
+  - expr: "1"
+    record: rule
+`,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			spec := monitoringv1.PrometheusRuleSpec{
+				Groups: []monitoringv1.RuleGroup{
+					{
+						Name:        "group",
+						QueryOffset: &queryOffset,
+						Rules: []monitoringv1.Rule{
+							{
+								Record: "rule",
+								Expr:   intstr.FromString("1"),
+							},
+						},
+					},
+				},
+			}
+
+			removed := removeUnsupportedRuleGroupFields(&spec, semver.MustParse(tc.version))
+			if removed != tc.removed {
+				t.Fatalf("expected removed to be %v, got %v", tc.removed, removed)
+			}
+
+			content, err := generateContent(spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if content != tc.expected {
+				t.Fatalf("expected rule file:\n%s\ngot:\n%s", tc.expected, content)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -29,13 +30,18 @@ import (
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus/common/model"
 )
 
 const (
 	governingServiceName            = "prometheus-operated"
+	agentGoverningServiceName       = "prometheus-agent-operated"
 	defaultRetention                = "24h"
+	defaultBlockDuration            = "2h"
 	defaultReplicaExternalLabelName = "prometheus_replica"
 	storageDir                      = "/prometheus"
 	confDir                         = "/etc/prometheus/config"
@@ -44,9 +50,11 @@ const (
 	rulesDir                        = "/etc/prometheus/rules"
 	secretsDir                      = "/etc/prometheus/secrets/"
 	configmapsDir                   = "/etc/prometheus/configmaps/"
+	defaultLogDirectory             = "/var/log/prometheus"
 	configFilename                  = "prometheus.yaml.gz"
 	configEnvsubstFilename          = "prometheus.env.yaml"
 	sSetInputHashName               = "prometheus-operator-input-hash"
+	shardRetainedUntilAnnotation    = "operator.prometheus.io/retained-until"
 	defaultPortName                 = "web"
 )
 
@@ -64,6 +72,30 @@ var (
 	probeTimeoutSeconds     int32 = 3
 )
 
+// isAgent returns true if the given Prometheus is the view of a
+// PrometheusAgent resource, see prometheusFromAgent().
+func isAgent(p *monitoringv1.Prometheus) bool {
+	return p.Kind == monitoringv1alpha1.PrometheusAgentsKind
+}
+
+// appLabelValue returns the value of the "app" label of the pods managed for
+// the given Prometheus.
+func appLabelValue(p *monitoringv1.Prometheus) string {
+	if isAgent(p) {
+		return "prometheus-agent"
+	}
+	return "prometheus"
+}
+
+// governingServiceNameFor returns the name of the governing service of the
+// statefulsets managed for the given Prometheus.
+func governingServiceNameFor(p *monitoringv1.Prometheus) string {
+	if isAgent(p) {
+		return agentGoverningServiceName
+	}
+	return governingServiceName
+}
+
 func expectedStatefulSetShardNames(
 	p *monitoringv1.Prometheus,
 ) []string {
@@ -74,14 +106,14 @@ func expectedStatefulSetShardNames(
 	}
 
 	for i := int32(0); i < shards; i++ {
-		res = append(res, prometheusNameByShard(p.Name, i))
+		res = append(res, prometheusNameByShard(p, i))
 	}
 
 	return res
 }
 
-func prometheusNameByShard(name string, shard int32) string {
-	base := prefixedName(name)
+func prometheusNameByShard(p *monitoringv1.Prometheus, shard int32) string {
+	base := prefixedName(p)
 	if shard == 0 {
 		return base
 	}
@@ -119,7 +151,7 @@ func makeStatefulSet(
 	if p.Spec.Replicas != nil && *p.Spec.Replicas < 0 {
 		p.Spec.Replicas = &intZero
 	}
-	if p.Spec.Retention == "" {
+	if p.Spec.Retention == "" && p.Spec.RetentionSize == "" {
 		p.Spec.Retention = defaultRetention
 	}
 
@@ -195,7 +227,7 @@ func makeStatefulSet(
 	storageSpec := p.Spec.Storage
 	if storageSpec == nil {
 		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
-			Name: volumeName(p.Name),
+			Name: volumeName(&p),
 			VolumeSource: v1.VolumeSource{
 				EmptyDir: &v1.EmptyDirVolumeSource{},
 			},
@@ -203,7 +235,7 @@ func makeStatefulSet(
 	} else if storageSpec.EmptyDir != nil {
 		emptyDir := storageSpec.EmptyDir
 		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
-			Name: volumeName(p.Name),
+			Name: volumeName(&p),
 			VolumeSource: v1.VolumeSource{
 				EmptyDir: emptyDir,
 			},
@@ -211,7 +243,7 @@ func makeStatefulSet(
 	} else {
 		pvcTemplate := operator.MakeVolumeClaimTemplate(storageSpec.VolumeClaimTemplate)
 		if pvcTemplate.Name == "" {
-			pvcTemplate.Name = volumeName(p.Name)
+			pvcTemplate.Name = volumeName(&p)
 		}
 		if storageSpec.VolumeClaimTemplate.Spec.AccessModes == nil {
 			pvcTemplate.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
@@ -244,7 +276,7 @@ func makeConfigSecret(p *monitoringv1.Prometheus, config operator.Config) *v1.Se
 	boolTrue := true
 	return &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   configSecretName(p.Name),
+			Name:   configSecretName(p),
 			Labels: config.Labels.Merge(managedByOperatorLabels),
 			OwnerReferences: []metav1.OwnerReference{
 				{
@@ -263,6 +295,45 @@ func makeConfigSecret(p *monitoringv1.Prometheus, config operator.Config) *v1.Se
 	}
 }
 
+// makePodDisruptionBudget returns the PodDisruptionBudget of the pods of the
+// given Prometheus shard.
+func makePodDisruptionBudget(name string, p monitoringv1.Prometheus, config *operator.Config, shard int32) (*policyv1beta1.PodDisruptionBudget, error) {
+	spec := p.Spec.PodDisruptionBudget
+	if (spec.MinAvailable == nil) == (spec.MaxUnavailable == nil) {
+		return nil, errors.New("exactly one of minAvailable and maxUnavailable must be set in podDisruptionBudget")
+	}
+
+	return &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: config.Labels.Merge(map[string]string{
+				shardLabelName:          fmt.Sprintf("%d", shard),
+				prometheusNameLabelName: p.Name,
+			}),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Name:       p.GetName(),
+					Kind:       p.Kind,
+					APIVersion: p.APIVersion,
+					UID:        p.GetUID(),
+				},
+			},
+		},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable:   spec.MinAvailable,
+			MaxUnavailable: spec.MaxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: config.Labels.Merge(map[string]string{
+					"app":                   "prometheus",
+					"prometheus":            p.Name,
+					shardLabelName:          fmt.Sprintf("%d", shard),
+					prometheusNameLabelName: p.Name,
+				}),
+			},
+		},
+	}, nil
+}
+
 func makeStatefulSetService(p *monitoringv1.Prometheus, config operator.Config) *v1.Service {
 	p = p.DeepCopy()
 
@@ -270,9 +341,14 @@ func makeStatefulSetService(p *monitoringv1.Prometheus, config operator.Config)
 		p.Spec.PortName = defaultPortName
 	}
 
+	operatedLabel := "operated-prometheus"
+	if isAgent(p) {
+		operatedLabel = "operated-prometheus-agent"
+	}
+
 	svc := &v1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: governingServiceName,
+			Name: governingServiceNameFor(p),
 			OwnerReferences: []metav1.OwnerReference{
 				metav1.OwnerReference{
 					Name:       p.GetName(),
@@ -282,7 +358,7 @@ func makeStatefulSetService(p *monitoringv1.Prometheus, config operator.Config)
 				},
 			},
 			Labels: config.Labels.Merge(map[string]string{
-				"operated-prometheus": "true",
+				operatedLabel: "true",
 			}),
 		},
 		Spec: v1.ServiceSpec{
@@ -295,7 +371,7 @@ func makeStatefulSetService(p *monitoringv1.Prometheus, config operator.Config)
 				},
 			},
 			Selector: map[string]string{
-				"app": "prometheus",
+				"app": appLabelValue(p),
 			},
 		},
 	}
@@ -332,67 +408,117 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		return nil, errors.Errorf("unsupported Prometheus major version %s", version)
 	}
 
-	promArgs := []string{
-		"-web.console.templates=/etc/prometheus/consoles",
-		"-web.console.libraries=/etc/prometheus/console_libraries",
-	}
+	var promArgs []string
+	if isAgent(&p) {
+		if !version.GTE(semver.MustParse("2.32.0")) {
+			return nil, errors.Errorf("the agent mode requires Prometheus >= 2.32.0, got %s", version)
+		}
 
-	retentionTimeFlag := "-storage.tsdb.retention="
-	if version.Minor >= 7 {
-		retentionTimeFlag = "-storage.tsdb.retention.time="
-		if p.Spec.RetentionSize != "" {
-			promArgs = append(promArgs,
-				fmt.Sprintf("-storage.tsdb.retention.size=%s", p.Spec.RetentionSize),
-			)
+		promArgs = []string{
+			"-enable-feature=agent",
+			fmt.Sprintf("-config.file=%s", path.Join(confOutDir, configEnvsubstFilename)),
+			fmt.Sprintf("-storage.agent.path=%s", storageDir),
+		}
+		if !processSignalReloadEnabled(p) {
+			promArgs = append(promArgs, "-web.enable-lifecycle")
+		}
+	} else {
+		promArgs = []string{
+			"-web.console.templates=/etc/prometheus/consoles",
+			"-web.console.libraries=/etc/prometheus/console_libraries",
+		}
+
+		if p.Spec.Retention != "" {
+			if _, err := model.ParseDuration(p.Spec.Retention); err != nil {
+				return nil, errors.Wrap(err, "invalid retention")
+			}
 		}
-	}
-	promArgs = append(promArgs,
-		fmt.Sprintf("-config.file=%s", path.Join(confOutDir, configEnvsubstFilename)),
-		fmt.Sprintf("-storage.tsdb.path=%s", storageDir),
-		retentionTimeFlag+p.Spec.Retention,
-		"-web.enable-lifecycle",
-		"-storage.tsdb.no-lockfile",
-	)
 
-	if p.Spec.Query != nil && p.Spec.Query.LookbackDelta != nil {
+		retention := p.Spec.Retention
+		retentionTimeFlag := "-storage.tsdb.retention="
+		if version.Minor >= 7 {
+			retentionTimeFlag = "-storage.tsdb.retention.time="
+			if p.Spec.RetentionSize != "" {
+				promArgs = append(promArgs,
+					fmt.Sprintf("-storage.tsdb.retention.size=%s", p.Spec.RetentionSize),
+				)
+			}
+		} else if retention == "" {
+			// The size-based retention isn't supported, fall back to the
+			// default time-based retention.
+			retention = defaultRetention
+		}
 		promArgs = append(promArgs,
-			fmt.Sprintf("-query.lookback-delta=%s", *p.Spec.Query.LookbackDelta),
+			fmt.Sprintf("-config.file=%s", path.Join(confOutDir, configEnvsubstFilename)),
+			fmt.Sprintf("-storage.tsdb.path=%s", storageDir),
 		)
-	}
-
-	if version.Minor >= 4 {
-		if p.Spec.Rules.Alert.ForOutageTolerance != "" {
-			promArgs = append(promArgs, "-rules.alert.for-outage-tolerance="+p.Spec.Rules.Alert.ForOutageTolerance)
+		// Without time-based retention, the blocks are only deleted once the
+		// retention size is reached.
+		if retention != "" {
+			promArgs = append(promArgs, retentionTimeFlag+retention)
 		}
-		if p.Spec.Rules.Alert.ForGracePeriod != "" {
-			promArgs = append(promArgs, "-rules.alert.for-grace-period="+p.Spec.Rules.Alert.ForGracePeriod)
+		if !processSignalReloadEnabled(p) {
+			promArgs = append(promArgs, "-web.enable-lifecycle")
 		}
-		if p.Spec.Rules.Alert.ResendDelay != "" {
-			promArgs = append(promArgs, "-rules.alert.resend-delay="+p.Spec.Rules.Alert.ResendDelay)
+		promArgs = append(promArgs, "-storage.tsdb.no-lockfile")
+
+		if p.Spec.Query != nil {
+			if p.Spec.Query.LookbackDelta != nil {
+				if _, err := model.ParseDuration(*p.Spec.Query.LookbackDelta); err != nil {
+					return nil, errors.Wrap(err, "invalid query lookback delta")
+				}
+			}
+			if p.Spec.Query.Timeout != nil {
+				if _, err := model.ParseDuration(*p.Spec.Query.Timeout); err != nil {
+					return nil, errors.Wrap(err, "invalid query timeout")
+				}
+			}
 		}
-	}
 
-	if version.Minor >= 5 {
-		if p.Spec.Query != nil && p.Spec.Query.MaxSamples != nil {
+		if p.Spec.Query != nil && p.Spec.Query.LookbackDelta != nil {
 			promArgs = append(promArgs,
-				fmt.Sprintf("-query.max-samples=%d", *p.Spec.Query.MaxSamples),
+				fmt.Sprintf("-query.lookback-delta=%s", *p.Spec.Query.LookbackDelta),
 			)
 		}
-	}
 
-	if p.Spec.Query != nil {
-		if p.Spec.Query.MaxConcurrency != nil {
-			if *p.Spec.Query.MaxConcurrency < 1 {
-				p.Spec.Query.MaxConcurrency = &defaultMaxConcurrency
+		if version.Minor >= 4 {
+			if p.Spec.Rules.Alert.ForOutageTolerance != "" {
+				promArgs = append(promArgs, "-rules.alert.for-outage-tolerance="+p.Spec.Rules.Alert.ForOutageTolerance)
+			}
+			if p.Spec.Rules.Alert.ForGracePeriod != "" {
+				promArgs = append(promArgs, "-rules.alert.for-grace-period="+p.Spec.Rules.Alert.ForGracePeriod)
+			}
+			if p.Spec.Rules.Alert.ResendDelay != "" {
+				promArgs = append(promArgs, "-rules.alert.resend-delay="+p.Spec.Rules.Alert.ResendDelay)
 			}
-			promArgs = append(promArgs,
-				fmt.Sprintf("-query.max-concurrency=%d", *p.Spec.Query.MaxConcurrency),
-			)
 		}
-		if p.Spec.Query.Timeout != nil {
-			promArgs = append(promArgs,
-				fmt.Sprintf("-query.timeout=%s", *p.Spec.Query.Timeout),
-			)
+
+		if p.Spec.RuleConcurrentEval != nil && version.GTE(semver.MustParse("2.52.0")) {
+			promArgs = append(promArgs, fmt.Sprintf("-rules.max-concurrent-evals=%d", *p.Spec.RuleConcurrentEval))
+		}
+
+		if version.Minor >= 5 {
+			if p.Spec.Query != nil && p.Spec.Query.MaxSamples != nil {
+				promArgs = append(promArgs,
+					fmt.Sprintf("-query.max-samples=%d", *p.Spec.Query.MaxSamples),
+				)
+			}
+		}
+
+		if p.Spec.Query != nil {
+			if p.Spec.Query.MaxConcurrency != nil {
+				if *p.Spec.Query.MaxConcurrency < 1 {
+					p.Spec.Query.MaxConcurrency = &defaultMaxConcurrency
+				}
+				promArgs = append(promArgs,
+					fmt.Sprintf("-query.max-concurrency=%d", *p.Spec.Query.MaxConcurrency),
+				)
+			}
+			if p.Spec.Query.Timeout != nil {
+				promArgs = append(promArgs,
+					fmt.Sprintf("-query.timeout=%s", *p.Spec.Query.Timeout),
+				)
+			}
 		}
 	}
 
@@ -402,6 +528,16 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		)
 	}
 
+	if p.Spec.Web != nil && p.Spec.Web.MaxConnections != nil {
+		promArgs = append(promArgs,
+			fmt.Sprintf("-web.max-connections=%d", *p.Spec.Web.MaxConnections),
+		)
+	}
+
+	if webConfigEnabled(&p) {
+		promArgs = append(promArgs, "-web.config.file="+path.Join(webConfigDir, webConfigFile))
+	}
+
 	if p.Spec.EnableAdminAPI {
 		promArgs = append(promArgs, "-web.enable-admin-api")
 	}
@@ -425,18 +561,68 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		}
 	}
 
+	// The write-ahead log flags of the agent live under a different prefix.
+	storageFlagPrefix := "storage.tsdb"
+	if isAgent(&p) {
+		storageFlagPrefix = "storage.agent"
+	}
+
 	if version.GTE(semver.MustParse("2.11.0")) && p.Spec.WALCompression != nil {
 		if *p.Spec.WALCompression {
-			promArgs = append(promArgs, "-storage.tsdb.wal-compression")
+			promArgs = append(promArgs, fmt.Sprintf("-%s.wal-compression", storageFlagPrefix))
 		} else {
-			promArgs = append(promArgs, "-no-storage.tsdb.wal-compression")
+			promArgs = append(promArgs, fmt.Sprintf("-no-%s.wal-compression", storageFlagPrefix))
 		}
 	}
 
+	if version.GTE(semver.MustParse("2.6.0")) && p.Spec.WALSegmentSize != "" {
+		promArgs = append(promArgs, fmt.Sprintf("-%s.wal-segment-size=%s", storageFlagPrefix, p.Spec.WALSegmentSize))
+	}
+
+	if version.GTE(semver.MustParse("2.4.0")) && p.Spec.RemoteWriteFlushDeadline != "" {
+		promArgs = append(promArgs, fmt.Sprintf("-storage.remote.flush-deadline=%s", p.Spec.RemoteWriteFlushDeadline))
+	}
+
 	if version.GTE(semver.MustParse("2.8.0")) && p.Spec.AllowOverlappingBlocks {
 		promArgs = append(promArgs, "-storage.tsdb.allow-overlapping-blocks")
 	}
 
+	features := append([]string{}, p.Spec.EnableFeatures...)
+
+	// The exemplar storage is enabled automatically when the exemplars
+	// configuration is set.
+	if p.Spec.Exemplars != nil && version.GTE(semver.MustParse("2.26.0")) {
+		features = appendFeature(features, "exemplar-storage")
+	}
+
+	// The OTLP receiver is enabled automatically when the OTLP configuration
+	// is set.
+	if p.Spec.OTLP != nil && !isAgent(&p) && version.GTE(semver.MustParse("2.47.0")) {
+		features = appendFeature(features, "otlp-write-receiver")
+	}
+
+	// The remote write receiver was a feature flag before v2.33.0.
+	if p.Spec.EnableRemoteWriteReceiver && !isAgent(&p) {
+		switch {
+		case version.GTE(semver.MustParse("2.33.0")):
+			promArgs = append(promArgs, "-web.enable-remote-write-receiver")
+		case version.GTE(semver.MustParse("2.25.0")):
+			features = appendFeature(features, "remote-write-receiver")
+		}
+
+		if len(p.Spec.RemoteWriteReceiverMessageVersions) > 0 && version.GTE(semver.MustParse("2.54.0")) {
+			messages := make([]string, 0, len(p.Spec.RemoteWriteReceiverMessageVersions))
+			for _, v := range p.Spec.RemoteWriteReceiverMessageVersions {
+				messages = append(messages, remoteWriteProtobufMessage(v))
+			}
+			promArgs = append(promArgs, fmt.Sprintf("-web.remote-write-receiver.accepted-protobuf-messages=%s", strings.Join(messages, ",")))
+		}
+	}
+
+	if version.GTE(semver.MustParse("2.25.0")) && len(features) > 0 {
+		promArgs = append(promArgs, fmt.Sprintf("-enable-feature=%s", strings.Join(features, ",")))
+	}
+
 	var ports []v1.ContainerPort
 	if p.Spec.ListenLocal {
 		promArgs = append(promArgs, "-web.listen-address=127.0.0.1:9090")
@@ -454,12 +640,17 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		promArgs[i] = "-" + a
 	}
 
+	promArgs, err = operator.BuildArgs(promArgs, p.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
 	volumes := []v1.Volume{
 		{
 			Name: "config",
 			VolumeSource: v1.VolumeSource{
 				Secret: &v1.SecretVolumeSource{
-					SecretName: configSecretName(p.Name),
+					SecretName: configSecretName(&p),
 				},
 			},
 		},
@@ -467,7 +658,7 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 			Name: "tls-assets",
 			VolumeSource: v1.VolumeSource{
 				Secret: &v1.SecretVolumeSource{
-					SecretName: tlsAssetsSecretName(p.Name),
+					SecretName: tlsAssetsSecretName(&p),
 				},
 			},
 		},
@@ -492,7 +683,7 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		})
 	}
 
-	volName := volumeName(p.Name)
+	volName := volumeName(&p)
 	if p.Spec.Storage != nil {
 		if p.Spec.Storage.VolumeClaimTemplate.Name != "" {
 			volName = p.Spec.Storage.VolumeClaimTemplate.Name
@@ -559,14 +750,49 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		})
 	}
 
-	const localProbe = `if [ -x "$(command -v curl)" ]; then exec curl %s; elif [ -x "$(command -v wget)" ]; then exec wget -q -O /dev/null %s; else exit 1; fi`
+	// Log files without a directory are written to an emptyDir volume.
+	if (!isAgent(&p) && usesDefaultLogDirectory(p.Spec.QueryLogFile)) || usesDefaultLogDirectory(p.Spec.ScrapeFailureLogFile) {
+		volumes = append(volumes, v1.Volume{
+			Name: "log-files",
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+		promVolumeMounts = append(promVolumeMounts, v1.VolumeMount{
+			Name:      "log-files",
+			MountPath: defaultLogDirectory,
+		})
+	}
+
+	if webConfigEnabled(&p) {
+		volumes = append(volumes, v1.Volume{
+			Name: "web-config",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: webConfigSecretName(&p),
+				},
+			},
+		})
+		promVolumeMounts = append(promVolumeMounts, v1.VolumeMount{
+			Name:      "web-config",
+			ReadOnly:  true,
+			MountPath: webConfigDir,
+		})
+	}
+
+	localProbe := `if [ -x "$(command -v curl)" ]; then exec curl %s; elif [ -x "$(command -v wget)" ]; then exec wget -q -O /dev/null %s; else exit 1; fi`
+	scheme := webScheme(&p)
+	if scheme == v1.URISchemeHTTPS {
+		// The server certificate is usually not issued for localhost.
+		localProbe = `if [ -x "$(command -v curl)" ]; then exec curl -k %s; elif [ -x "$(command -v wget)" ]; then exec wget -q -O /dev/null --no-check-certificate %s; else exit 1; fi`
+	}
 
-	var readinessProbeHandler v1.Handler
+	var livenessProbeHandler v1.Handler
 	{
 		healthyPath := path.Clean(webRoutePrefix + "/-/healthy")
 		if p.Spec.ListenLocal {
-			localHealthyPath := fmt.Sprintf("http://localhost:9090%s", healthyPath)
-			readinessProbeHandler.Exec = &v1.ExecAction{
+			localHealthyPath := fmt.Sprintf("%s://localhost:9090%s", strings.ToLower(string(scheme)), healthyPath)
+			livenessProbeHandler.Exec = &v1.ExecAction{
 				Command: []string{
 					"sh",
 					"-c",
@@ -574,16 +800,19 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 				},
 			}
 		} else {
-			readinessProbeHandler.HTTPGet = &v1.HTTPGetAction{
-				Path: healthyPath,
-				Port: intstr.FromString(p.Spec.PortName),
+			livenessProbeHandler.HTTPGet = &v1.HTTPGetAction{
+				Path:   healthyPath,
+				Port:   intstr.FromString(p.Spec.PortName),
+				Scheme: scheme,
 			}
 		}
 	}
+
+	var readinessProbeHandler v1.Handler
 	{
 		readyPath := path.Clean(webRoutePrefix + "/-/ready")
 		if p.Spec.ListenLocal {
-			localReadyPath := fmt.Sprintf("http://localhost:9090%s", readyPath)
+			localReadyPath := fmt.Sprintf("%s://localhost:9090%s", strings.ToLower(string(scheme)), readyPath)
 			readinessProbeHandler.Exec = &v1.ExecAction{
 				Command: []string{
 					"sh",
@@ -594,25 +823,46 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 
 		} else {
 			readinessProbeHandler.HTTPGet = &v1.HTTPGetAction{
-				Path: readyPath,
-				Port: intstr.FromString(p.Spec.PortName),
+				Path:   readyPath,
+				Port:   intstr.FromString(p.Spec.PortName),
+				Scheme: scheme,
 			}
 		}
 	}
 
-	// TODO(paulfantom): Re-add livenessProbe and add startupProbe when kubernetes 1.21 is available.
+	// TODO(paulfantom): Re-add livenessProbe and add startupProbe by default when kubernetes 1.21 is available.
 	// This would be a follow-up to https://github.com/prometheus-operator/prometheus-operator/pull/3502
-	readinessProbe := &v1.Probe{
+	readinessProbe := operator.MakeProbe(&v1.Probe{
 		Handler:          readinessProbeHandler,
 		TimeoutSeconds:   probeTimeoutSeconds,
 		PeriodSeconds:    5,
 		FailureThreshold: 120, // Allow up to 10m on startup for data recovery
+	}, p.Spec.ReadinessProbe)
+
+	// The liveness and startup probes are only configured when explicitly
+	// requested by the user.
+	var livenessProbe, startupProbe *v1.Probe
+	if p.Spec.LivenessProbe != nil {
+		livenessProbe = operator.MakeProbe(&v1.Probe{
+			Handler:          livenessProbeHandler,
+			TimeoutSeconds:   probeTimeoutSeconds,
+			PeriodSeconds:    5,
+			FailureThreshold: 6,
+		}, p.Spec.LivenessProbe)
+	}
+	if p.Spec.StartupProbe != nil {
+		startupProbe = operator.MakeProbe(&v1.Probe{
+			Handler:          readinessProbeHandler,
+			TimeoutSeconds:   probeTimeoutSeconds,
+			PeriodSeconds:    15,
+			FailureThreshold: 60, // Allow up to 15m on startup for data recovery
+		}, p.Spec.StartupProbe)
 	}
 
 	podAnnotations := map[string]string{}
 	podLabels := map[string]string{}
 	podSelectorLabels := map[string]string{
-		"app":                   "prometheus",
+		"app":                   appLabelValue(&p),
 		"prometheus":            p.Name,
 		shardLabelName:          fmt.Sprintf("%d", shard),
 		prometheusNameLabelName: p.Name,
@@ -652,28 +902,34 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 			return nil, errors.Wrap(err, "failed to build image path")
 		}
 
-		bindAddress := "[$(POD_IP)]"
-		if p.Spec.Thanos.ListenLocal {
-			bindAddress = "127.0.0.1"
+		grpcBindAddress, httpBindAddress := "[$(POD_IP)]", "[$(POD_IP)]"
+		if p.Spec.Thanos.ListenLocal || p.Spec.Thanos.GRPCListenLocal {
+			grpcBindAddress = "127.0.0.1"
+		}
+		if p.Spec.Thanos.ListenLocal || p.Spec.Thanos.HTTPListenLocal {
+			httpBindAddress = "127.0.0.1"
 		}
 
 		thanosArgs := []string{"sidecar",
-			fmt.Sprintf("--prometheus.url=http://%s:9090%s", c.LocalHost, path.Clean(webRoutePrefix)),
-			fmt.Sprintf("--grpc-address=%s:10901", bindAddress),
-			fmt.Sprintf("--http-address=%s:10902", bindAddress),
+			fmt.Sprintf("--prometheus.url=%s://%s:9090%s", strings.ToLower(string(scheme)), c.LocalHost, path.Clean(webRoutePrefix)),
+			fmt.Sprintf("--grpc-address=%s:10901", grpcBindAddress),
+			fmt.Sprintf("--http-address=%s:10902", httpBindAddress),
 		}
 
+		if webTLSEnabled(&p) {
+			// The sidecar talks to Prometheus over the loopback interface
+			// which isn't covered by the server certificate.
+			thanosArgs = append(thanosArgs, `--prometheus.http-client={"tls_config": {"insecure_skip_verify": true}}`)
+		}
+
+		var thanosVolumeMounts []v1.VolumeMount
 		if p.Spec.Thanos.GRPCServerTLSConfig != nil {
-			tls := p.Spec.Thanos.GRPCServerTLSConfig
-			if tls.CertFile != "" {
-				thanosArgs = append(thanosArgs, "--grpc-server-tls-cert="+tls.CertFile)
-			}
-			if tls.KeyFile != "" {
-				thanosArgs = append(thanosArgs, "--grpc-server-tls-key="+tls.KeyFile)
-			}
-			if tls.CAFile != "" {
-				thanosArgs = append(thanosArgs, "--grpc-server-tls-client-ca="+tls.CAFile)
-			}
+			thanosArgs = append(thanosArgs, makeThanosGRPCServerTLSArgs(p.Namespace, p.Spec.Thanos.GRPCServerTLSConfig)...)
+			thanosVolumeMounts = append(thanosVolumeMounts, v1.VolumeMount{
+				Name:      "tls-assets",
+				ReadOnly:  true,
+				MountPath: tlsAssetsDir,
+			})
 		}
 
 		container := v1.Container{
@@ -701,10 +957,11 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 					ContainerPort: 10901,
 				},
 			},
-			Resources: p.Spec.Thanos.Resources,
+			VolumeMounts: thanosVolumeMounts,
+			Resources:    p.Spec.Thanos.Resources,
 		}
 
-		if p.Spec.Thanos.ObjectStorageConfig != nil || p.Spec.Thanos.ObjectStorageConfigFile != nil {
+		if thanosUploadEnabled(p.Spec.Thanos) {
 			if p.Spec.Thanos.ObjectStorageConfigFile != nil {
 				container.Args = append(container.Args, "--objstore.config-file="+*p.Spec.Thanos.ObjectStorageConfigFile)
 			} else {
@@ -759,11 +1016,21 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		if p.Spec.Thanos.MinTime != "" {
 			container.Args = append(container.Args, "--min-time="+p.Spec.Thanos.MinTime)
 		}
+
+		container.Args, err = operator.BuildArgs(container.Args, p.Spec.Thanos.AdditionalArgs)
+		if err != nil {
+			return nil, errors.Wrap(err, "thanos sidecar")
+		}
+
 		additionalContainers = append(additionalContainers, container)
 	}
 	if disableCompaction {
-		promArgs = append(promArgs, "--storage.tsdb.max-block-duration=2h")
-		promArgs = append(promArgs, "--storage.tsdb.min-block-duration=2h")
+		blockDuration := defaultBlockDuration
+		if p.Spec.Thanos != nil && p.Spec.Thanos.BlockDuration != "" {
+			blockDuration = p.Spec.Thanos.BlockDuration
+		}
+		promArgs = append(promArgs, "--storage.tsdb.max-block-duration="+blockDuration)
+		promArgs = append(promArgs, "--storage.tsdb.min-block-duration="+blockDuration)
 	}
 
 	configReloaderArgs := []string{
@@ -791,6 +1058,28 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		}
 	}
 
+	reloaderConfig, err := c.ReloaderConfig.WithThrottling(p.Spec.ReloadDelayInterval, p.Spec.MaxConcurrentReloads)
+	if err != nil {
+		return nil, err
+	}
+
+	reloadURL := url.URL{
+		Scheme: strings.ToLower(string(scheme)),
+		Host:   c.LocalHost + ":9090",
+		Path:   path.Clean(webRoutePrefix + "/-/reload"),
+	}
+	var shareProcessNamespace *bool
+	if processSignalReloadEnabled(p) {
+		// The reloader needs to see the Prometheus process to signal it.
+		reloadURL = url.URL{}
+		configReloaderArgs = append(configReloaderArgs,
+			"--reload-method=signal",
+			"--process-executable-name=prometheus",
+		)
+		boolTrue := true
+		shareProcessNamespace = &boolTrue
+	}
+
 	operatorContainers := append([]v1.Container{
 		{
 			Name:                     "prometheus",
@@ -798,17 +1087,16 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 			Ports:                    ports,
 			Args:                     promArgs,
 			VolumeMounts:             promVolumeMounts,
+			LivenessProbe:            livenessProbe,
 			ReadinessProbe:           readinessProbe,
+			StartupProbe:             startupProbe,
 			Resources:                p.Spec.Resources,
 			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 		},
 		operator.CreateConfigReloader(
-			c.ReloaderConfig,
-			url.URL{
-				Scheme: "http",
-				Host:   c.LocalHost + ":9090",
-				Path:   path.Clean(webRoutePrefix + "/-/reload"),
-			},
+			reloaderConfig,
+			p.Spec.ConfigReloaderResources,
+			reloadURL,
 			p.Spec.ListenLocal,
 			c.LocalHost,
 			p.Spec.LogFormat,
@@ -819,6 +1107,10 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 		),
 	}, additionalContainers...)
 
+	for i := range operatorContainers {
+		operatorContainers[i].ImagePullPolicy = p.Spec.ImagePullPolicy
+	}
+
 	containers, err := k8sutil.MergePatchContainers(operatorContainers, p.Spec.Containers)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to merge containers spec")
@@ -826,12 +1118,10 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 	// PodManagementPolicy is set to Parallel to mitigate issues in kubernetes: https://github.com/kubernetes/kubernetes/issues/60164
 	// This is also mentioned as one of limitations of StatefulSets: https://kubernetes.io/docs/concepts/workloads/controllers/statefulset/#limitations
 	return &appsv1.StatefulSetSpec{
-		ServiceName:         governingServiceName,
+		ServiceName:         governingServiceNameFor(&p),
 		Replicas:            p.Spec.Replicas,
 		PodManagementPolicy: appsv1.ParallelPodManagement,
-		UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
-			Type: appsv1.RollingUpdateStatefulSetStrategyType,
-		},
+		UpdateStrategy:      makeStatefulSetUpdateStrategy(p),
 		Selector: &metav1.LabelSelector{
 			MatchLabels: finalSelectorLabels,
 		},
@@ -845,6 +1135,7 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 				InitContainers:                p.Spec.InitContainers,
 				SecurityContext:               p.Spec.SecurityContext,
 				ServiceAccountName:            p.Spec.ServiceAccountName,
+				AutomountServiceAccountToken:  p.Spec.AutomountServiceAccountToken,
 				NodeSelector:                  p.Spec.NodeSelector,
 				PriorityClassName:             p.Spec.PriorityClassName,
 				TerminationGracePeriodSeconds: &terminationGracePeriod,
@@ -852,25 +1143,161 @@ func makeStatefulSetSpec(p monitoringv1.Prometheus, c *operator.Config, shard in
 				Tolerations:                   p.Spec.Tolerations,
 				Affinity:                      p.Spec.Affinity,
 				TopologySpreadConstraints:     p.Spec.TopologySpreadConstraints,
+				HostAliases:                   p.Spec.HostAliases,
+				HostNetwork:                   p.Spec.HostNetwork,
+				DNSPolicy:                     operator.MakeDNSPolicy(p.Spec.HostNetwork, p.Spec.DNSPolicy),
+				DNSConfig:                     p.Spec.DNSConfig,
+				ShareProcessNamespace:         shareProcessNamespace,
 			},
 		},
 	}, nil
 }
 
-func configSecretName(name string) string {
-	return prefixedName(name)
+// processSignalReloadEnabled returns true if the configuration of Prometheus
+// is reloaded by sending a signal to the process.
+func processSignalReloadEnabled(p monitoringv1.Prometheus) bool {
+	return p.Spec.ReloadStrategy != nil && *p.Spec.ReloadStrategy == monitoringv1.ProcessSignalReloadStrategyType
+}
+
+// makeStatefulSetUpdateStrategy returns the update strategy of the
+// statefulsets. With the OnDelete type, the pods are deleted by the operator
+// (see rollOutPods).
+func makeStatefulSetUpdateStrategy(p monitoringv1.Prometheus) appsv1.StatefulSetUpdateStrategy {
+	strategy := p.Spec.UpdateStrategy
+	if strategy != nil && strategy.Type == monitoringv1.OnDeleteStatefulSetUpdateStrategyType {
+		return appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.OnDeleteStatefulSetStrategyType,
+		}
+	}
+
+	out := appsv1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType,
+	}
+	if strategy == nil {
+		return out
+	}
+
+	var partition *int32
+	switch {
+	case strategy.Paused:
+		// No pod has an ordinal greater than or equal to the number of
+		// replicas.
+		replicas := int32(1)
+		if p.Spec.Replicas != nil {
+			replicas = *p.Spec.Replicas
+		}
+		partition = &replicas
+	case strategy.Partition != nil:
+		partition = strategy.Partition
+	}
+
+	if partition != nil {
+		out.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{
+			Partition: partition,
+		}
+	}
+
+	return out
+}
+
+func configSecretName(p *monitoringv1.Prometheus) string {
+	return prefixedName(p)
 }
 
-func tlsAssetsSecretName(name string) string {
-	return fmt.Sprintf("%s-tls-assets", prefixedName(name))
+func tlsAssetsSecretName(p *monitoringv1.Prometheus) string {
+	return fmt.Sprintf("%s-tls-assets", prefixedName(p))
 }
 
-func volumeName(name string) string {
-	return fmt.Sprintf("%s-db", prefixedName(name))
+func volumeName(p *monitoringv1.Prometheus) string {
+	return fmt.Sprintf("%s-db", prefixedName(p))
+}
+
+// prefixedName returns the name from which the names of the objects managed
+// for the given Prometheus are derived. The objects of a PrometheusAgent use
+// a different prefix to avoid conflicting with a Prometheus of the same name.
+func prefixedName(p *monitoringv1.Prometheus) string {
+	if isAgent(p) {
+		return fmt.Sprintf("prom-agent-%s", p.Name)
+	}
+	return fmt.Sprintf("prometheus-%s", p.Name)
+}
+
+// remoteWriteProtobufMessage returns the name of the protobuf message
+// matching the remote write message version.
+func remoteWriteProtobufMessage(v monitoringv1.RemoteWriteMessageVersion) string {
+	switch v {
+	case monitoringv1.RemoteWriteMessageVersion2_0:
+		return "io.prometheus.write.v2.Request"
+	default:
+		return "prometheus.WriteRequest"
+	}
 }
 
-func prefixedName(name string) string {
-	return fmt.Sprintf("prometheus-%s", name)
+// appendFeature adds the feature to the list if it isn't already present.
+func appendFeature(features []string, feature string) []string {
+	for _, f := range features {
+		if f == feature {
+			return features
+		}
+	}
+
+	return append(features, feature)
+}
+
+// usesDefaultLogDirectory returns true if the log file is a filename without
+// directory which should be stored in the default log directory.
+func usesDefaultLogDirectory(logFile string) bool {
+	return logFile != "" && path.Dir(logFile) == "."
+}
+
+// logFilePath returns the path of the log file as seen by Prometheus.
+func logFilePath(logFile string) string {
+	if !usesDefaultLogDirectory(logFile) {
+		return logFile
+	}
+
+	return path.Join(defaultLogDirectory, logFile)
+}
+
+// makeThanosGRPCServerTLSArgs returns the Thanos sidecar arguments
+// configuring TLS for the gRPC server. The file paths take precedence over the
+// Secret and ConfigMap references which are read from the TLS assets
+// directory.
+func makeThanosGRPCServerTLSArgs(namespace string, tls *monitoringv1.TLSConfig) []string {
+	pathForSelector := func(sel monitoringv1.SecretOrConfigMap) string {
+		return path.Join(tlsAssetsDir, assets.TLSAssetKeyFromSelector(namespace, sel).String())
+	}
+
+	var args []string
+	if tls.CertFile != "" {
+		args = append(args, "--grpc-server-tls-cert="+tls.CertFile)
+	} else if tls.Cert.Secret != nil || tls.Cert.ConfigMap != nil {
+		args = append(args, "--grpc-server-tls-cert="+pathForSelector(tls.Cert))
+	}
+
+	if tls.KeyFile != "" {
+		args = append(args, "--grpc-server-tls-key="+tls.KeyFile)
+	} else if tls.KeySecret != nil {
+		args = append(args, "--grpc-server-tls-key="+pathForSelector(monitoringv1.SecretOrConfigMap{Secret: tls.KeySecret}))
+	}
+
+	if tls.CAFile != "" {
+		args = append(args, "--grpc-server-tls-client-ca="+tls.CAFile)
+	} else if tls.CA.Secret != nil || tls.CA.ConfigMap != nil {
+		args = append(args, "--grpc-server-tls-client-ca="+pathForSelector(tls.CA))
+	}
+
+	return args
+}
+
+// thanosUploadEnabled returns true if the Thanos sidecar should upload the
+// TSDB blocks to object storage.
+func thanosUploadEnabled(thanos *monitoringv1.ThanosSpec) bool {
+	if thanos == nil || thanos.DisableUpload {
+		return false
+	}
+
+	return thanos.ObjectStorageConfig != nil || thanos.ObjectStorageConfigFile != nil
 }
 
 func subPathForStorage(s *monitoringv1.StorageSpec) string {
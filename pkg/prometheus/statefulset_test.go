@@ -27,6 +27,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kylelemons/godebug/pretty"
 )
@@ -260,7 +261,7 @@ func TestStatefulSetVolumeInitial(t *testing.T) {
 							Name: "config",
 							VolumeSource: v1.VolumeSource{
 								Secret: &v1.SecretVolumeSource{
-									SecretName: configSecretName("volume-init-test"),
+									SecretName: "prometheus-volume-init-test",
 								},
 							},
 						},
@@ -268,7 +269,7 @@ func TestStatefulSetVolumeInitial(t *testing.T) {
 							Name: "tls-assets",
 							VolumeSource: v1.VolumeSource{
 								Secret: &v1.SecretVolumeSource{
-									SecretName: tlsAssetsSecretName("volume-init-test"),
+									SecretName: "prometheus-volume-init-test-tls-assets",
 								},
 							},
 						},
@@ -995,6 +996,82 @@ func TestThanosObjectStorageFile(t *testing.T) {
 	}
 }
 
+func TestThanosObjectStorageUpload(t *testing.T) {
+	testKey := "thanos-config-secret-test"
+
+	for _, tc := range []struct {
+		name           string
+		thanos         *monitoringv1.ThanosSpec
+		expectedArgs   []string
+		expectedUpload bool
+	}{
+		{
+			name: "custom block duration",
+			thanos: &monitoringv1.ThanosSpec{
+				ObjectStorageConfig: &v1.SecretKeySelector{Key: testKey},
+				BlockDuration:       "1h",
+			},
+			expectedArgs: []string{
+				"--storage.tsdb.max-block-duration=1h",
+				"--storage.tsdb.min-block-duration=1h",
+			},
+			expectedUpload: true,
+		},
+		{
+			name: "upload disabled",
+			thanos: &monitoringv1.ThanosSpec{
+				ObjectStorageConfig: &v1.SecretKeySelector{Key: testKey},
+				BlockDuration:       "1h",
+				DisableUpload:       true,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					Thanos: tc.thanos,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			if err != nil {
+				t.Fatalf("Unexpected error while making StatefulSet: %v", err)
+			}
+
+			promArgs := sset.Spec.Template.Spec.Containers[0].Args
+			for _, expected := range tc.expectedArgs {
+				var found bool
+				for _, arg := range promArgs {
+					if arg == expected {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Fatalf("Prometheus is missing expected argument %s, got %v", expected, promArgs)
+				}
+			}
+
+			if len(tc.expectedArgs) == 0 {
+				for _, arg := range promArgs {
+					if strings.HasPrefix(arg, "--storage.tsdb.max-block-duration=") {
+						t.Fatalf("Prometheus compaction should not be disabled, got %v", promArgs)
+					}
+				}
+			}
+
+			var objstore bool
+			for _, arg := range sset.Spec.Template.Spec.Containers[2].Args {
+				if strings.HasPrefix(arg, "--objstore.config") {
+					objstore = true
+					break
+				}
+			}
+			if objstore != tc.expectedUpload {
+				t.Fatalf("expected object storage configuration in the Thanos sidecar to be %v, got %v", tc.expectedUpload, objstore)
+			}
+		})
+	}
+}
+
 func TestThanosTracing(t *testing.T) {
 	testKey := "thanos-config-secret-test"
 
@@ -1090,6 +1167,99 @@ func TestRetentionSize(t *testing.T) {
 	}
 }
 
+func TestQuerySpec(t *testing.T) {
+	var (
+		lookbackDelta  = "2m"
+		maxConcurrency = int32(10)
+		maxSamples     = int32(5000)
+		timeout        = "1m30s"
+		invalid        = "2 minutes"
+	)
+
+	for _, tc := range []struct {
+		name         string
+		query        *monitoringv1.QuerySpec
+		expectedArgs []string
+		wantErr      bool
+	}{
+		{
+			name: "all fields",
+			query: &monitoringv1.QuerySpec{
+				LookbackDelta:  &lookbackDelta,
+				MaxConcurrency: &maxConcurrency,
+				MaxSamples:     &maxSamples,
+				Timeout:        &timeout,
+			},
+			expectedArgs: []string{
+				"--query.lookback-delta=2m",
+				"--query.max-concurrency=10",
+				"--query.max-samples=5000",
+				"--query.timeout=1m30s",
+			},
+		},
+		{
+			name: "invalid lookback delta",
+			query: &monitoringv1.QuerySpec{
+				LookbackDelta: &invalid,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid timeout",
+			query: &monitoringv1.QuerySpec{
+				Timeout: &invalid,
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					Query: tc.query,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, arg := range tc.expectedArgs {
+				require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args, arg)
+			}
+		})
+	}
+}
+
+func TestRuleConcurrentEval(t *testing.T) {
+	concurrency := int32(4)
+
+	for _, tc := range []struct {
+		version       string
+		shouldContain bool
+	}{
+		{"v2.52.0", true},
+		{"v2.51.0", false},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					Version:            tc.version,
+					RuleConcurrentEval: &concurrency,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			require.NoError(t, err)
+
+			const expectedArg = "--rules.max-concurrent-evals=4"
+			if tc.shouldContain {
+				require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args, expectedArg)
+			} else {
+				require.NotContains(t, sset.Spec.Template.Spec.Containers[0].Args, expectedArg)
+			}
+		})
+	}
+}
+
 func TestRetention(t *testing.T) {
 	tests := []struct {
 		version              string
@@ -1128,6 +1298,65 @@ func TestRetention(t *testing.T) {
 	}
 }
 
+func TestRetentionAndRetentionSize(t *testing.T) {
+	tests := []struct {
+		version           string
+		specRetention     string
+		specRetentionSize string
+		expectedArgs      []string
+		unexpectedArgs    []string
+	}{
+		{"v2.5.0", "", "1GB", []string{"--storage.tsdb.retention=24h"}, []string{"--storage.tsdb.retention.size=1GB"}},
+		{"v2.7.0", "", "1GB", []string{"--storage.tsdb.retention.size=1GB"}, []string{"--storage.tsdb.retention.time=24h"}},
+		{"v2.7.0", "1d", "1GB", []string{"--storage.tsdb.retention.time=1d", "--storage.tsdb.retention.size=1GB"}, nil},
+	}
+
+	for _, test := range tests {
+		sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+			Spec: monitoringv1.PrometheusSpec{
+				Version:       test.version,
+				Retention:     test.specRetention,
+				RetentionSize: test.specRetentionSize,
+			},
+		}, defaultTestConfig, nil, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		promArgs := sset.Spec.Template.Spec.Containers[0].Args
+		contains := func(arg string) bool {
+			for _, flag := range promArgs {
+				if flag == arg {
+					return true
+				}
+			}
+			return false
+		}
+
+		for _, arg := range test.expectedArgs {
+			if !contains(arg) {
+				t.Fatalf("expected Prometheus args to contain %v, but got %v", arg, promArgs)
+			}
+		}
+		for _, arg := range test.unexpectedArgs {
+			if contains(arg) {
+				t.Fatalf("expected Prometheus args to NOT contain %v, but got %v", arg, promArgs)
+			}
+		}
+	}
+}
+
+func TestInvalidRetention(t *testing.T) {
+	_, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			Retention: "1x",
+		},
+	}, defaultTestConfig, nil, "", 0)
+	if err == nil {
+		t.Fatal("expected an error for an invalid retention")
+	}
+}
+
 func TestSidecarsNoCPULimits(t *testing.T) {
 	testConfig := &operator.Config{
 		ReloaderConfig: operator.ReloaderConfig{
@@ -1233,6 +1462,139 @@ func TestSidecarsNoMemoryLimits(t *testing.T) {
 	}
 }
 
+func TestConfigReloaderResources(t *testing.T) {
+	expectedResources := v1.ResourceRequirements{
+		Limits: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("200m"),
+			v1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("50m"),
+			v1.ResourceMemory: resource.MustParse("50Mi"),
+		},
+	}
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			ConfigReloaderResources: &expectedResources,
+		},
+	}, defaultTestConfig, nil, "", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error while making StatefulSet: %v", err)
+	}
+
+	var found bool
+	for _, c := range sset.Spec.Template.Spec.Containers {
+		if c.Name != "config-reloader" {
+			continue
+		}
+		found = true
+		if !reflect.DeepEqual(c.Resources, expectedResources) {
+			t.Fatalf("Expected resource requests/limits:\n\n%s\n\nGot:\n\n%s", expectedResources.String(), c.Resources.String())
+		}
+	}
+	if !found {
+		t.Fatal("expected to find the config-reloader container")
+	}
+}
+
+func TestReloadStrategy(t *testing.T) {
+	httpStrategy := monitoringv1.HTTPReloadStrategyType
+	signalStrategy := monitoringv1.ProcessSignalReloadStrategyType
+
+	for _, tc := range []struct {
+		name     string
+		strategy *monitoringv1.ReloadStrategyType
+		signal   bool
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:     "http",
+			strategy: &httpStrategy,
+		},
+		{
+			name:     "process signal",
+			strategy: &signalStrategy,
+			signal:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					ReloadStrategy: tc.strategy,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			require.NoError(t, err)
+
+			spec := sset.Spec.Template.Spec
+			if tc.signal {
+				require.NotNil(t, spec.ShareProcessNamespace)
+				require.True(t, *spec.ShareProcessNamespace)
+			} else {
+				require.Nil(t, spec.ShareProcessNamespace)
+			}
+
+			var lifecycle bool
+			for _, arg := range spec.Containers[0].Args {
+				if arg == "--web.enable-lifecycle" {
+					lifecycle = true
+				}
+			}
+			require.Equal(t, !tc.signal, lifecycle)
+
+			var reloadURL, reloadMethod bool
+			for _, c := range spec.Containers {
+				if c.Name != "config-reloader" {
+					continue
+				}
+				for _, arg := range c.Args {
+					if strings.HasPrefix(arg, "--reload-url=") {
+						reloadURL = true
+					}
+					if arg == "--reload-method=signal" {
+						reloadMethod = true
+					}
+				}
+			}
+			require.Equal(t, !tc.signal, reloadURL)
+			require.Equal(t, tc.signal, reloadMethod)
+		})
+	}
+}
+
+func TestConfigReloaderThrottling(t *testing.T) {
+	delay := "30s"
+	maxConcurrentReloads := int32(2)
+
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			ReloadDelayInterval:  &delay,
+			MaxConcurrentReloads: &maxConcurrentReloads,
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+
+	var found bool
+	for _, c := range sset.Spec.Template.Spec.Containers {
+		if c.Name != "config-reloader" {
+			continue
+		}
+		found = true
+		require.Contains(t, c.Args, "--delay-interval=30s")
+		require.Contains(t, c.Args, "--max-concurrent-reloads=2")
+	}
+	require.True(t, found, "expected to find the config-reloader container")
+
+	invalid := "30 seconds"
+	_, err = makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			ReloadDelayInterval: &invalid,
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.Error(t, err)
+}
+
 func TestAdditionalContainers(t *testing.T) {
 	// The base to compare everything against
 	baseSet, err := makeStatefulSet("test", monitoringv1.Prometheus{}, defaultTestConfig, nil, "", 0)
@@ -1333,6 +1695,354 @@ func TestWALCompression(t *testing.T) {
 	}
 }
 
+func TestEnableFeatures(t *testing.T) {
+	tests := []struct {
+		version       string
+		features      []string
+		expectedArg   string
+		shouldContain bool
+	}{
+		{"v2.24.0", []string{"exemplar-storage"}, "--enable-feature=exemplar-storage", false},
+		{"v2.25.0", nil, "--enable-feature=", false},
+		{"v2.25.0", []string{"exemplar-storage"}, "--enable-feature=exemplar-storage", true},
+		{"v2.40.0", []string{"exemplar-storage", "native-histograms"}, "--enable-feature=exemplar-storage,native-histograms", true},
+	}
+
+	for _, test := range tests {
+		sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+			Spec: monitoringv1.PrometheusSpec{
+				Version:        test.version,
+				EnableFeatures: test.features,
+			},
+		}, defaultTestConfig, nil, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		promArgs := sset.Spec.Template.Spec.Containers[0].Args
+		found := false
+		for _, flag := range promArgs {
+			if strings.HasPrefix(flag, test.expectedArg) {
+				found = true
+				break
+			}
+		}
+
+		if found != test.shouldContain {
+			if test.shouldContain {
+				t.Fatalf("expected Prometheus args to contain %v, but got %v", test.expectedArg, promArgs)
+			} else {
+				t.Fatalf("expected Prometheus args to NOT contain %v, but got %v", test.expectedArg, promArgs)
+			}
+		}
+	}
+}
+
+func TestExemplarsEnableFeature(t *testing.T) {
+	maxSize := int64(100000)
+
+	tests := []struct {
+		version     string
+		features    []string
+		exemplars   *monitoringv1.Exemplars
+		expectedArg string
+	}{
+		{"v2.26.0", nil, &monitoringv1.Exemplars{MaxSize: &maxSize}, "--enable-feature=exemplar-storage"},
+		{"v2.26.0", nil, &monitoringv1.Exemplars{}, "--enable-feature=exemplar-storage"},
+		{"v2.30.0", []string{"exemplar-storage"}, &monitoringv1.Exemplars{MaxSize: &maxSize}, "--enable-feature=exemplar-storage"},
+		{"v2.30.0", []string{"native-histograms"}, &monitoringv1.Exemplars{MaxSize: &maxSize}, "--enable-feature=native-histograms,exemplar-storage"},
+		{"v2.25.0", nil, &monitoringv1.Exemplars{MaxSize: &maxSize}, ""},
+		{"v2.30.0", nil, nil, ""},
+	}
+
+	for _, test := range tests {
+		sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+			Spec: monitoringv1.PrometheusSpec{
+				Version:        test.version,
+				EnableFeatures: test.features,
+				Exemplars:      test.exemplars,
+			},
+		}, defaultTestConfig, nil, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var featureArg string
+		for _, flag := range sset.Spec.Template.Spec.Containers[0].Args {
+			if strings.HasPrefix(flag, "--enable-feature=") {
+				featureArg = flag
+			}
+		}
+
+		if featureArg != test.expectedArg {
+			t.Fatalf("version %s: expected feature flag %q, got %q", test.version, test.expectedArg, featureArg)
+		}
+	}
+}
+
+func TestOTLPEnableFeature(t *testing.T) {
+	tests := []struct {
+		version     string
+		features    []string
+		otlp        *monitoringv1.OTLPConfig
+		expectedArg string
+	}{
+		{"v2.47.0", nil, &monitoringv1.OTLPConfig{}, "--enable-feature=otlp-write-receiver"},
+		{"v2.47.0", []string{"otlp-write-receiver"}, &monitoringv1.OTLPConfig{}, "--enable-feature=otlp-write-receiver"},
+		{"v2.54.0", []string{"native-histograms"}, &monitoringv1.OTLPConfig{}, "--enable-feature=native-histograms,otlp-write-receiver"},
+		{"v2.46.0", nil, &monitoringv1.OTLPConfig{}, ""},
+		{"v2.54.0", nil, nil, ""},
+	}
+
+	for _, test := range tests {
+		sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+			Spec: monitoringv1.PrometheusSpec{
+				Version:        test.version,
+				EnableFeatures: test.features,
+				OTLP:           test.otlp,
+			},
+		}, defaultTestConfig, nil, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var featureArg string
+		for _, flag := range sset.Spec.Template.Spec.Containers[0].Args {
+			if strings.HasPrefix(flag, "--enable-feature=") {
+				featureArg = flag
+			}
+		}
+
+		if featureArg != test.expectedArg {
+			t.Fatalf("version %s: expected feature flag %q, got %q", test.version, test.expectedArg, featureArg)
+		}
+	}
+}
+
+func TestRemoteWriteReceiver(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		version          string
+		messageVersions  []monitoringv1.RemoteWriteMessageVersion
+		expectedArgs     []string
+		unexpectedPrefix []string
+	}{
+		{
+			name:             "feature flag",
+			version:          "v2.25.0",
+			expectedArgs:     []string{"--enable-feature=remote-write-receiver"},
+			unexpectedPrefix: []string{"--web.enable-remote-write-receiver"},
+		},
+		{
+			name:             "flag",
+			version:          "v2.33.0",
+			messageVersions:  []monitoringv1.RemoteWriteMessageVersion{monitoringv1.RemoteWriteMessageVersion2_0},
+			expectedArgs:     []string{"--web.enable-remote-write-receiver"},
+			unexpectedPrefix: []string{"--enable-feature=", "--web.remote-write-receiver.accepted-protobuf-messages="},
+		},
+		{
+			name:            "message versions",
+			version:         "v2.54.0",
+			messageVersions: []monitoringv1.RemoteWriteMessageVersion{monitoringv1.RemoteWriteMessageVersion1_0, monitoringv1.RemoteWriteMessageVersion2_0},
+			expectedArgs: []string{
+				"--web.enable-remote-write-receiver",
+				"--web.remote-write-receiver.accepted-protobuf-messages=prometheus.WriteRequest,io.prometheus.write.v2.Request",
+			},
+		},
+		{
+			name:             "unsupported version",
+			version:          "v2.24.0",
+			unexpectedPrefix: []string{"--enable-feature=", "--web.enable-remote-write-receiver"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					Version:                            tc.version,
+					EnableRemoteWriteReceiver:          true,
+					RemoteWriteReceiverMessageVersions: tc.messageVersions,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			promArgs := sset.Spec.Template.Spec.Containers[0].Args
+			for _, expected := range tc.expectedArgs {
+				found := false
+				for _, flag := range promArgs {
+					if flag == expected {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Fatalf("expected Prometheus args to contain %v, but got %v", expected, promArgs)
+				}
+			}
+
+			for _, prefix := range tc.unexpectedPrefix {
+				for _, flag := range promArgs {
+					if strings.HasPrefix(flag, prefix) {
+						t.Fatalf("expected Prometheus args to NOT contain %v, but got %v", prefix, promArgs)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestAdditionalArgs(t *testing.T) {
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			AdditionalArgs: []monitoringv1.Argument{
+				{Name: "scrape.discovery-reload-interval", Value: "30s"},
+			},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	promArgs := sset.Spec.Template.Spec.Containers[0].Args
+	if last := promArgs[len(promArgs)-1]; last != "--scrape.discovery-reload-interval=30s" {
+		t.Fatalf("expected the additional argument to be appended, got %v", promArgs)
+	}
+
+	_, err = makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			AdditionalArgs: []monitoringv1.Argument{
+				{Name: "config.file", Value: "/tmp/prometheus.yaml"},
+			},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	if err == nil {
+		t.Fatal("expected an error for an argument managed by the operator")
+	}
+}
+
+func TestLogFileVolumes(t *testing.T) {
+	for _, tc := range []struct {
+		name                 string
+		queryLogFile         string
+		scrapeFailureLogFile string
+		expectVolume         bool
+	}{
+		{name: "no log files"},
+		{name: "query log filename", queryLogFile: "query.log", expectVolume: true},
+		{name: "scrape failure log filename", scrapeFailureLogFile: "scrape.log", expectVolume: true},
+		{name: "both filenames", queryLogFile: "query.log", scrapeFailureLogFile: "scrape.log", expectVolume: true},
+		{name: "storage path", queryLogFile: "/prometheus/query.log"},
+		{name: "stdout", queryLogFile: "/dev/stdout", scrapeFailureLogFile: "/dev/stdout"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					QueryLogFile:         tc.queryLogFile,
+					ScrapeFailureLogFile: tc.scrapeFailureLogFile,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			volumes := 0
+			for _, v := range sset.Spec.Template.Spec.Volumes {
+				if v.Name == "log-files" {
+					if v.EmptyDir == nil {
+						t.Fatalf("expected log-files volume to be an emptyDir, got %v", v.VolumeSource)
+					}
+					volumes++
+				}
+			}
+
+			mounts := 0
+			for _, vm := range sset.Spec.Template.Spec.Containers[0].VolumeMounts {
+				if vm.Name == "log-files" {
+					if vm.MountPath != "/var/log/prometheus" {
+						t.Fatalf("expected log-files to be mounted at /var/log/prometheus, got %q", vm.MountPath)
+					}
+					mounts++
+				}
+			}
+
+			expected := 0
+			if tc.expectVolume {
+				expected = 1
+			}
+			if volumes != expected || mounts != expected {
+				t.Fatalf("expected %d log-files volume and mount, got %d volumes and %d mounts", expected, volumes, mounts)
+			}
+		})
+	}
+}
+
+func TestRemoteWriteFlags(t *testing.T) {
+	tests := []struct {
+		version        string
+		walSegmentSize string
+		flushDeadline  string
+		expectedArgs   []string
+		unexpectedArgs []string
+	}{
+		{
+			version:        "v2.3.0",
+			walSegmentSize: "64MB",
+			flushDeadline:  "2m",
+			unexpectedArgs: []string{"--storage.tsdb.wal-segment-size=64MB", "--storage.remote.flush-deadline=2m"},
+		},
+		{
+			version:        "v2.4.0",
+			walSegmentSize: "64MB",
+			flushDeadline:  "2m",
+			expectedArgs:   []string{"--storage.remote.flush-deadline=2m"},
+			unexpectedArgs: []string{"--storage.tsdb.wal-segment-size=64MB"},
+		},
+		{
+			version:        "v2.6.0",
+			walSegmentSize: "64MB",
+			flushDeadline:  "2m",
+			expectedArgs:   []string{"--storage.tsdb.wal-segment-size=64MB", "--storage.remote.flush-deadline=2m"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					Version:                  test.version,
+					WALSegmentSize:           test.walSegmentSize,
+					RemoteWriteFlushDeadline: test.flushDeadline,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			promArgs := sset.Spec.Template.Spec.Containers[0].Args
+			contains := func(arg string) bool {
+				for _, flag := range promArgs {
+					if flag == arg {
+						return true
+					}
+				}
+				return false
+			}
+
+			for _, arg := range test.expectedArgs {
+				if !contains(arg) {
+					t.Fatalf("expected Prometheus args to contain %v, but got %v", arg, promArgs)
+				}
+			}
+			for _, arg := range test.unexpectedArgs {
+				if contains(arg) {
+					t.Fatalf("expected Prometheus args to NOT contain %v, but got %v", arg, promArgs)
+				}
+			}
+		})
+	}
+}
+
 func TestThanosListenLocal(t *testing.T) {
 	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
 		Spec: monitoringv1.PrometheusSpec{
@@ -1360,6 +2070,86 @@ func TestThanosListenLocal(t *testing.T) {
 	}
 }
 
+func TestThanosGRPCListenLocal(t *testing.T) {
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			Thanos: &monitoringv1.ThanosSpec{
+				GRPCListenLocal: true,
+			},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error while making StatefulSet: %v", err)
+	}
+
+	args := sset.Spec.Template.Spec.Containers[2].Args
+	require.Contains(t, args, "--grpc-address=127.0.0.1:10901")
+	require.Contains(t, args, "--http-address=[$(POD_IP)]:10902")
+}
+
+func TestThanosGRPCServerTLSConfig(t *testing.T) {
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns1",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			Thanos: &monitoringv1.ThanosSpec{
+				GRPCServerTLSConfig: &monitoringv1.TLSConfig{
+					SafeTLSConfig: monitoringv1.SafeTLSConfig{
+						Cert: monitoringv1.SecretOrConfigMap{
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "thanos-tls"},
+								Key:                  "tls.crt",
+							},
+						},
+						KeySecret: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "thanos-tls"},
+							Key:                  "tls.key",
+						},
+					},
+					CAFile: "/etc/thanos/ca.crt",
+				},
+				AdditionalArgs: []monitoringv1.Argument{
+					{Name: "shipper.upload-compacted"},
+				},
+			},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error while making StatefulSet: %v", err)
+	}
+
+	sidecar := sset.Spec.Template.Spec.Containers[2]
+	for _, arg := range []string{
+		"--grpc-server-tls-cert=/etc/prometheus/certs/secret_ns1_thanos-tls_tls.crt",
+		"--grpc-server-tls-key=/etc/prometheus/certs/secret_ns1_thanos-tls_tls.key",
+		"--grpc-server-tls-client-ca=/etc/thanos/ca.crt",
+		"--shipper.upload-compacted",
+	} {
+		require.Contains(t, sidecar.Args, arg)
+	}
+
+	require.Contains(t, sidecar.VolumeMounts, v1.VolumeMount{
+		Name:      "tls-assets",
+		ReadOnly:  true,
+		MountPath: tlsAssetsDir,
+	})
+}
+
+func TestThanosAdditionalArgsConflict(t *testing.T) {
+	_, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			Thanos: &monitoringv1.ThanosSpec{
+				AdditionalArgs: []monitoringv1.Argument{
+					{Name: "grpc-address", Value: "0.0.0.0:10901"},
+				},
+			},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.Error(t, err)
+}
+
 func TestTerminationPolicy(t *testing.T) {
 	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{Spec: monitoringv1.PrometheusSpec{}}, defaultTestConfig, nil, "", 0)
 	if err != nil {
@@ -1424,3 +2214,293 @@ func TestExpectedStatefulSetShardNames(t *testing.T) {
 		}
 	}
 }
+
+func TestMakePodDisruptionBudget(t *testing.T) {
+	maxUnavailable := intstr.FromInt(1)
+	p := monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns1",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			PodDisruptionBudget: &monitoringv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+			},
+		},
+	}
+
+	pdb, err := makePodDisruptionBudget("prometheus-test-shard-1", p, defaultTestConfig, 1)
+	require.NoError(t, err)
+
+	require.Equal(t, "prometheus-test-shard-1", pdb.Name)
+	require.Equal(t, &maxUnavailable, pdb.Spec.MaxUnavailable)
+	require.Nil(t, pdb.Spec.MinAvailable)
+	require.Equal(t, map[string]string{
+		"app":                   "prometheus",
+		"prometheus":            "test",
+		shardLabelName:          "1",
+		prometheusNameLabelName: "test",
+	}, pdb.Spec.Selector.MatchLabels)
+	require.Equal(t, "test", pdb.Labels[prometheusNameLabelName])
+
+	minAvailable := intstr.FromString("50%")
+	p.Spec.PodDisruptionBudget.MinAvailable = &minAvailable
+	_, err = makePodDisruptionBudget("prometheus-test", p, defaultTestConfig, 0)
+	require.Error(t, err)
+
+	p.Spec.PodDisruptionBudget = &monitoringv1.PodDisruptionBudgetSpec{}
+	_, err = makePodDisruptionBudget("prometheus-test", p, defaultTestConfig, 0)
+	require.Error(t, err)
+}
+
+func TestTopologySpreadConstraints(t *testing.T) {
+	tsc := []v1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: v1.DoNotSchedule,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "prometheus"},
+			},
+		},
+	}
+
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			TopologySpreadConstraints: tsc,
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	require.Equal(t, tsc, sset.Spec.Template.Spec.TopologySpreadConstraints)
+}
+
+func TestHostAliases(t *testing.T) {
+	hostAliases := []v1.HostAlias{
+		{
+			IP:        "10.0.0.1",
+			Hostnames: []string{"remote-write.example.com"},
+		},
+	}
+
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			HostAliases: hostAliases,
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	require.Equal(t, hostAliases, sset.Spec.Template.Spec.HostAliases)
+}
+
+func TestImagePullPolicyAndAutomountServiceAccountToken(t *testing.T) {
+	automount := false
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			ImagePullPolicy:              v1.PullNever,
+			AutomountServiceAccountToken: &automount,
+			Thanos:                       &monitoringv1.ThanosSpec{},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+
+	require.Equal(t, &automount, sset.Spec.Template.Spec.AutomountServiceAccountToken)
+	for _, c := range sset.Spec.Template.Spec.Containers {
+		require.Equal(t, v1.PullNever, c.ImagePullPolicy, "unexpected image pull policy for container %s", c.Name)
+	}
+}
+
+func TestProbes(t *testing.T) {
+	baseSet, err := makeStatefulSet("test", monitoringv1.Prometheus{}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	baseContainer := baseSet.Spec.Template.Spec.Containers[0]
+	require.NotNil(t, baseContainer.ReadinessProbe)
+	require.Nil(t, baseContainer.LivenessProbe)
+	require.Nil(t, baseContainer.StartupProbe)
+
+	failureThreshold := int32(3)
+	initialDelaySeconds := int32(30)
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			LivenessProbe: &monitoringv1.ProbeParameters{
+				FailureThreshold: &failureThreshold,
+			},
+			ReadinessProbe: &monitoringv1.ProbeParameters{
+				InitialDelaySeconds: &initialDelaySeconds,
+			},
+			StartupProbe: &monitoringv1.ProbeParameters{},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	container := sset.Spec.Template.Spec.Containers[0]
+
+	require.NotNil(t, container.LivenessProbe)
+	require.Equal(t, "/-/healthy", container.LivenessProbe.HTTPGet.Path)
+	require.Equal(t, failureThreshold, container.LivenessProbe.FailureThreshold)
+
+	require.Equal(t, initialDelaySeconds, container.ReadinessProbe.InitialDelaySeconds)
+	require.Equal(t, baseContainer.ReadinessProbe.FailureThreshold, container.ReadinessProbe.FailureThreshold)
+
+	require.NotNil(t, container.StartupProbe)
+	require.Equal(t, "/-/ready", container.StartupProbe.HTTPGet.Path)
+
+	sset, err = makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			ReadinessProbe: &monitoringv1.ProbeParameters{
+				Disabled: true,
+			},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	require.Nil(t, sset.Spec.Template.Spec.Containers[0].ReadinessProbe)
+}
+
+func TestWebTLSConfig(t *testing.T) {
+	maxConnections := int32(100)
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			Version: "v2.24.0",
+			Web: &monitoringv1.WebSpec{
+				TLSConfig:      makeTestWebTLSConfig(),
+				MaxConnections: &maxConnections,
+			},
+			Thanos: &monitoringv1.ThanosSpec{},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+
+	prom := sset.Spec.Template.Spec.Containers[0]
+	require.Contains(t, prom.Args, "--web.config.file=/etc/prometheus/web_config/web-config.yaml")
+	require.Contains(t, prom.Args, "--web.max-connections=100")
+	require.Equal(t, v1.URISchemeHTTPS, prom.ReadinessProbe.HTTPGet.Scheme)
+
+	require.Contains(t, prom.VolumeMounts, v1.VolumeMount{
+		Name:      "web-config",
+		ReadOnly:  true,
+		MountPath: "/etc/prometheus/web_config",
+	})
+
+	volumeFound := false
+	for _, v := range sset.Spec.Template.Spec.Volumes {
+		if v.Name == "web-config" && v.Secret != nil && v.Secret.SecretName == "prometheus-test-web-config" {
+			volumeFound = true
+		}
+	}
+	if !volumeFound {
+		t.Fatal("web-config volume not found")
+	}
+
+	var found int
+	for _, c := range sset.Spec.Template.Spec.Containers[1:] {
+		for _, arg := range c.Args {
+			if strings.HasPrefix(arg, "--reload-url=https://") || strings.HasPrefix(arg, "--prometheus.url=https://") {
+				found++
+			}
+		}
+	}
+	if found != 2 {
+		t.Fatal("expected the config reloader and the Thanos sidecar to use HTTPS")
+	}
+
+	sset, err = makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			Version:     "v2.24.0",
+			ListenLocal: true,
+			Web: &monitoringv1.WebSpec{
+				TLSConfig: makeTestWebTLSConfig(),
+			},
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	require.Contains(t, sset.Spec.Template.Spec.Containers[0].ReadinessProbe.Exec.Command[2], "curl -k https://localhost:9090/-/ready")
+}
+
+func TestUpdateStrategy(t *testing.T) {
+	replicas := int32(3)
+	partition := int32(2)
+
+	for _, tc := range []struct {
+		name     string
+		strategy *monitoringv1.StatefulSetUpdateStrategy
+		expected appsv1.StatefulSetUpdateStrategy
+	}{
+		{
+			name:     "default",
+			expected: appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType},
+		},
+		{
+			name: "partition",
+			strategy: &monitoringv1.StatefulSetUpdateStrategy{
+				Type:      monitoringv1.RollingUpdateStatefulSetUpdateStrategyType,
+				Partition: &partition,
+			},
+			expected: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+		},
+		{
+			name: "paused",
+			strategy: &monitoringv1.StatefulSetUpdateStrategy{
+				Partition: &partition,
+				Paused:    true,
+			},
+			expected: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &replicas},
+			},
+		},
+		{
+			name: "on delete",
+			strategy: &monitoringv1.StatefulSetUpdateStrategy{
+				Type:      monitoringv1.OnDeleteStatefulSetUpdateStrategyType,
+				Partition: &partition,
+			},
+			expected: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet("test", monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					Replicas:       &replicas,
+					UpdateStrategy: tc.strategy,
+				},
+			}, defaultTestConfig, nil, "", 0)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, sset.Spec.UpdateStrategy)
+		})
+	}
+}
+
+func TestDNSPolicyAndHostNetwork(t *testing.T) {
+	sset, err := makeStatefulSet("test", monitoringv1.Prometheus{}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	require.False(t, sset.Spec.Template.Spec.HostNetwork)
+	require.Equal(t, v1.DNSPolicy(""), sset.Spec.Template.Spec.DNSPolicy)
+
+	sset, err = makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			HostNetwork: true,
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	require.True(t, sset.Spec.Template.Spec.HostNetwork)
+	require.Equal(t, v1.DNSClusterFirstWithHostNet, sset.Spec.Template.Spec.DNSPolicy)
+
+	dnsPolicy := v1.DNSNone
+	dnsConfig := &v1.PodDNSConfig{
+		Nameservers: []string{"10.0.0.10"},
+		Searches:    []string{"example.com"},
+	}
+	sset, err = makeStatefulSet("test", monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			HostNetwork: true,
+			DNSPolicy:   &dnsPolicy,
+			DNSConfig:   dnsConfig,
+		},
+	}, defaultTestConfig, nil, "", 0)
+	require.NoError(t, err)
+	require.Equal(t, v1.DNSNone, sset.Spec.Template.Spec.DNSPolicy)
+	require.Equal(t, dnsConfig, sset.Spec.Template.Spec.DNSConfig)
+}
@@ -181,9 +181,11 @@ func (o *Operator) selectRules(t *monitoringv1.ThanosRuler, namespaces []string)
 		err := o.ruleInfs.ListAllByNamespace(ns, ruleSelector, func(obj interface{}) {
 			promRule := obj.(*monitoringv1.PrometheusRule).DeepCopy()
 
-			if err := nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
-				marshalErr = err
-				return
+			if !namespacelabeler.IsExcludedFromEnforcement(t.Spec.ExcludedFromEnforcement, monitoringv1.PrometheusRuleName, promRule.Namespace, promRule.Name) {
+				if err := nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
+					marshalErr = err
+					return
+				}
 			}
 
 			content, err := generateContent(promRule.Spec)
@@ -303,6 +303,16 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		trCLIArgs = append(trCLIArgs, fmt.Sprintf("--alert.query-url=%s", tr.Spec.AlertQueryURL))
 	}
 
+	trCLIArgs, err = operator.BuildArgs(trCLIArgs, tr.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	reloaderConfig, err := config.ReloaderConfig.WithThrottling(tr.Spec.ReloadDelayInterval, tr.Spec.MaxConcurrentReloads)
+	if err != nil {
+		return nil, err
+	}
+
 	var additionalContainers []v1.Container
 	if len(ruleConfigMapNames) != 0 {
 		var (
@@ -322,7 +332,8 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		additionalContainers = append(
 			additionalContainers,
 			operator.CreateConfigReloader(
-				config.ReloaderConfig,
+				reloaderConfig,
+				tr.Spec.ConfigReloaderResources,
 				url.URL{
 					Scheme: "http",
 					Host:   config.LocalHost + ":10902",
@@ -401,6 +412,10 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		},
 	}, additionalContainers...)
 
+	for i := range operatorContainers {
+		operatorContainers[i].ImagePullPolicy = tr.Spec.ImagePullPolicy
+	}
+
 	containers, err := k8sutil.MergePatchContainers(operatorContainers, tr.Spec.Containers)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to merge containers spec")
@@ -428,6 +443,7 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 				NodeSelector:                  tr.Spec.NodeSelector,
 				PriorityClassName:             tr.Spec.PriorityClassName,
 				ServiceAccountName:            tr.Spec.ServiceAccountName,
+				AutomountServiceAccountToken:  tr.Spec.AutomountServiceAccountToken,
 				TerminationGracePeriodSeconds: &terminationGracePeriod,
 				Containers:                    containers,
 				InitContainers:                tr.Spec.InitContainers,
@@ -436,6 +452,9 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 				Tolerations:                   tr.Spec.Tolerations,
 				Affinity:                      tr.Spec.Affinity,
 				TopologySpreadConstraints:     tr.Spec.TopologySpreadConstraints,
+				HostNetwork:                   tr.Spec.HostNetwork,
+				DNSPolicy:                     operator.MakeDNSPolicy(tr.Spec.HostNetwork, tr.Spec.DNSPolicy),
+				DNSConfig:                     tr.Spec.DNSConfig,
 			},
 		},
 	}, nil
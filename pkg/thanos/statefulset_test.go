@@ -593,3 +593,55 @@ func TestExternalQueryURL(t *testing.T) {
 	}
 	t.Fatalf("Thanos ruler is missing expected argument: %s", expectedArg)
 }
+
+func TestImagePullPolicyAndAutomountServiceAccountToken(t *testing.T) {
+	automount := false
+	sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints:               emptyQueryEndpoints,
+			ImagePullPolicy:              v1.PullNever,
+			AutomountServiceAccountToken: &automount,
+		},
+	}, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+
+	require.Equal(t, &automount, sset.Spec.Template.Spec.AutomountServiceAccountToken)
+	for _, c := range sset.Spec.Template.Spec.Containers {
+		require.Equal(t, v1.PullNever, c.ImagePullPolicy, "unexpected image pull policy for container %s", c.Name)
+	}
+}
+
+func TestAdditionalArgs(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+			AdditionalArgs: []monitoringv1.Argument{
+				{Name: "for-grace-period", Value: "5m"},
+			},
+		},
+	}, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+	require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args, "--for-grace-period=5m")
+
+	_, err = makeStatefulSet(&monitoringv1.ThanosRuler{
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+			AdditionalArgs: []monitoringv1.Argument{
+				{Name: "data-dir", Value: "/tmp"},
+			},
+		},
+	}, defaultTestConfig, nil, "")
+	require.Error(t, err)
+}
+
+func TestDNSPolicyAndHostNetwork(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+			HostNetwork:    true,
+		},
+	}, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+	require.True(t, sset.Spec.Template.Spec.HostNetwork)
+	require.Equal(t, v1.DNSClusterFirstWithHostNet, sset.Spec.Template.Spec.DNSPolicy)
+}
@@ -39,7 +39,10 @@ type crdGenerator struct {
 	CRDNames          []crdName
 	CRDAPIGroup       string
 	ControllerPath    string
-	CustomizeYAML     func(crdGenerator) error
+	// Paths is the value of the controller-gen paths option, relative to
+	// ControllerPath. It defaults to ".".
+	Paths         string
+	CustomizeYAML func(crdGenerator) error
 }
 
 var (
@@ -71,22 +74,68 @@ var (
 			YAMLDir:           "./example/prometheus-operator-crd",
 			JsonnetDir:        "./jsonnet/prometheus-operator",
 			CRDAPIGroup:       "monitoring.coreos.com",
-			ControllerPath:    "./pkg/apis/monitoring/v1alpha1",
+			ControllerPath:    "./pkg/apis/monitoring",
+			Paths:             "./v1alpha1;./v1beta1",
 			CRDNames: []crdName{
 				{"alertmanagerconfig", "alertmanagerconfigs"},
+				{"clusteralertmanagerconfig", "clusteralertmanagerconfigs"},
+				{"prometheusagent", "prometheusagents"},
+			},
+			CustomizeYAML: func(generator crdGenerator) error {
+				return addConversionWebhook(fmt.Sprintf("%s/%s_alertmanagerconfigs.yaml", generator.YAMLDir, generator.CRDAPIGroup))
 			},
 		},
 	}
 )
 
+// conversionWebhook is the conversion configuration of the AlertmanagerConfig
+// CRD which is served in the v1alpha1 and v1beta1 versions. The CA bundle is a
+// placeholder which needs to be replaced by the CA certificate which signed
+// the certificate of the operator's web server.
+const conversionWebhook = `  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        caBundle: Cg==
+        service:
+          name: prometheus-operator
+          namespace: default
+          path: /convert
+          port: 8080
+      conversionReviewVersions:
+      - v1
+`
+
+// addConversionWebhook inserts the conversion configuration at the top of the
+// spec of the given CRD manifest. The manifest is modified as text to keep the
+// formatting of controller-gen.
+func addConversionWebhook(yamlFile string) error {
+	b, err := ioutil.ReadFile(yamlFile)
+	if err != nil {
+		return errors.Wrapf(err, "reading %s", yamlFile)
+	}
+
+	spec := []byte("\nspec:\n")
+	if !bytes.Contains(b, spec) {
+		return errors.Errorf("%s: spec not found", yamlFile)
+	}
+	b = bytes.Replace(b, spec, append(spec, conversionWebhook...), 1)
+
+	return ioutil.WriteFile(yamlFile, b, 0644)
+}
+
 func (generator crdGenerator) generateYAMLManifests() error {
 	outputDir, err := filepath.Abs(generator.YAMLDir)
 	if err != nil {
 		return errors.Wrapf(err, "absolute CRD output path %s", generator.YAMLDir)
 	}
+	paths := generator.Paths
+	if paths == "" {
+		paths = "."
+	}
 	cmd := exec.Command(controllergen,
 		generator.ControllerGenOpts,
-		"paths=.",
+		"paths="+paths,
 		"output:crd:dir="+outputDir,
 	)
 	cmd.Dir, err = filepath.Abs(generator.ControllerPath)
@@ -15,8 +15,11 @@
 package e2e
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
 	"testing"
@@ -1027,11 +1030,16 @@ func testAlertmanagerConfigCRD(t *testing.T) {
 			return false, err
 		}
 
-		if cfgSecret.Data["alertmanager.yaml"] == nil {
-			lastErr = errors.New("'alertmanager.yaml' key is missing")
+		if cfgSecret.Data["alertmanager.yaml.gz"] == nil {
+			lastErr = errors.New("'alertmanager.yaml.gz' key is missing")
 			return false, nil
 		}
 
+		generatedConfig, err := gunzipConfig(cfgSecret.Data["alertmanager.yaml.gz"])
+		if err != nil {
+			return false, err
+		}
+
 		expected := fmt.Sprintf(`global:
   resolve_timeout: 5m
 route:
@@ -1108,7 +1116,7 @@ receivers:
 templates: []
 `, ns, ns, ns, ns, ns, ns, ns, ns, ns)
 
-		if diff := cmp.Diff(string(cfgSecret.Data["alertmanager.yaml"]), expected); diff != "" {
+		if diff := cmp.Diff(generatedConfig, expected); diff != "" {
 			t.Log("got(-), want(+):\n" + diff)
 			return false, nil
 		}
@@ -1170,13 +1178,18 @@ receivers:
 			return false, nil
 		}
 
-		if cfgSecret.Data["alertmanager.yaml"] == nil {
-			lastErr = errors.New("'alertmanager.yaml' key is missing")
+		if cfgSecret.Data["alertmanager.yaml.gz"] == nil {
+			lastErr = errors.New("'alertmanager.yaml.gz' key is missing")
 			return false, nil
 		}
 
-		if string(cfgSecret.Data["alertmanager.yaml"]) != yamlConfig {
-			lastErr = errors.Errorf("expected Alertmanager configuration %q, got %q", yamlConfig, cfgSecret.Data["alertmanager.yaml"])
+		generatedConfig, err := gunzipConfig(cfgSecret.Data["alertmanager.yaml.gz"])
+		if err != nil {
+			return false, err
+		}
+
+		if generatedConfig != yamlConfig {
+			lastErr = errors.Errorf("expected Alertmanager configuration %q, got %q", yamlConfig, generatedConfig)
 			return false, nil
 		}
 
@@ -1186,3 +1199,17 @@ receivers:
 		t.Fatalf("%v: %v", err, lastErr)
 	}
 }
+
+func gunzipConfig(b []byte) (string, error) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	conf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(conf), nil
+}
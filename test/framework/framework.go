@@ -279,6 +279,20 @@ func (f *Framework) CreatePrometheusOperator(ns, opImage string, namespaceAllowl
 		return nil, errors.Wrap(err, "initialize AlertmanagerConfig CRD")
 	}
 
+	err = f.CreateCRDAndWaitUntilReady(monitoringv1alpha1.ClusterAlertmanagerConfigName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ClusterAlertmanagerConfigs().List(context.TODO(), opts)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "initialize ClusterAlertmanagerConfig CRD")
+	}
+
+	err = f.CreateCRDAndWaitUntilReady(monitoringv1alpha1.PrometheusAgentName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.PrometheusAgents(v1.NamespaceAll).List(context.TODO(), opts)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "initialize PrometheusAgent CRD")
+	}
+
 	deploy, err := MakeDeployment("../../example/rbac/prometheus-operator/prometheus-operator-deployment.yaml")
 	if err != nil {
 		return nil, err